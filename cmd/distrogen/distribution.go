@@ -23,6 +23,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -238,6 +239,23 @@ type DistributionComponents struct {
 	Providers  []string `yaml:"providers,omitempty"`
 }
 
+// Merge adds the components of another DistributionComponents that
+// aren't already in this one, keeping each list sorted.
+func (c *DistributionComponents) Merge(c2 *DistributionComponents) {
+	c.Receivers = mergeSorted(c.Receivers, c2.Receivers)
+	c.Processors = mergeSorted(c.Processors, c2.Processors)
+	c.Exporters = mergeSorted(c.Exporters, c2.Exporters)
+	c.Connectors = mergeSorted(c.Connectors, c2.Connectors)
+	c.Extensions = mergeSorted(c.Extensions, c2.Extensions)
+	c.Providers = mergeSorted(c.Providers, c2.Providers)
+}
+
+func mergeSorted(a []string, b []string) []string {
+	merged := slices.Concat(a, b)
+	slices.Sort(merged)
+	return slices.Compact(merged)
+}
+
 // DistributionGenerator contains all the facilities to generate a distribution
 // from a DistributionSpec.
 type DistributionGenerator struct {
@@ -0,0 +1,195 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	DefaultInitOpenTelemetryVersion       = "0.156.0"
+	DefaultInitOpenTelemetryStableVersion = "1.62.0"
+	DefaultInitGoVersion                  = "1.26.4"
+)
+
+// DefaultInitProviders are the config providers included in every
+// distribution created with the init command, in addition to the ones
+// referenced in the collector configs, since collector configs don't
+// declare the providers that are used to load them.
+var DefaultInitProviders = []string{"env", "file", "http", "https", "yaml"}
+
+// maxComponentSuggestions is the maximum number of registry components
+// suggested for a component that is not in the registry.
+const maxComponentSuggestions = 3
+
+// InitSpecOptions are the values of the spec created by the init command
+// that don't come from the collector configs.
+type InitSpecOptions struct {
+	Name                       string
+	OpenTelemetryVersion       string
+	OpenTelemetryStableVersion string
+	GoVersion                  string
+}
+
+// InitSpec is the minimal DistributionSpec written by the init command.
+// It only holds the fields init sets so the written spec isn't padded with
+// empty values, and loads as a DistributionSpec.
+type InitSpec struct {
+	Name                       string                  `yaml:"name"`
+	DisplayName                string                  `yaml:"display_name"`
+	Version                    string                  `yaml:"version"`
+	Description                string                  `yaml:"description"`
+	Blurb                      string                  `yaml:"blurb"`
+	OpenTelemetryVersion       string                  `yaml:"opentelemetry_version"`
+	OpenTelemetryStableVersion string                  `yaml:"opentelemetry_stable_version"`
+	BinaryName                 string                  `yaml:"binary_name"`
+	GoVersion                  string                  `yaml:"go_version"`
+	Components                 *DistributionComponents `yaml:"components"`
+}
+
+// NewInitSpec creates the spec for a distribution containing the
+// given components.
+func NewInitSpec(opts InitSpecOptions, components *DistributionComponents) *InitSpec {
+	components.Providers = mergeSorted(DefaultInitProviders, components.Providers)
+	return &InitSpec{
+		Name:                       opts.Name,
+		DisplayName:                opts.Name,
+		Version:                    opts.OpenTelemetryVersion,
+		Description:                "An OpenTelemetry Collector distribution",
+		Blurb:                      "An OpenTelemetry Collector distribution",
+		OpenTelemetryVersion:       opts.OpenTelemetryVersion,
+		OpenTelemetryStableVersion: opts.OpenTelemetryStableVersion,
+		BinaryName:                 opts.Name,
+		GoVersion:                  opts.GoVersion,
+		Components:                 components,
+	}
+}
+
+// ComponentsFromOTelConfigFiles reads the collector configs at the given
+// paths and returns the components used by any of them, and the providers
+// of the references in them.
+func ComponentsFromOTelConfigFiles(paths []string) (*DistributionComponents, error) {
+	components := &DistributionComponents{}
+	for _, path := range paths {
+		otelConfig, providers, err := ReadOTelConfigFile(path)
+		if err != nil {
+			return nil, err
+		}
+		configComponents, err := ComponentsFromOTelConfig(otelConfig)
+		if err != nil {
+			return nil, fmt.Errorf("reading components from %s: %w", path, err)
+		}
+		configComponents.Providers = providers
+		components.Merge(configComponents)
+	}
+	return components, nil
+}
+
+// ResolveComponents checks that all the components are in the registry,
+// and replaces component types with their registry names. Components
+// that are not in the registry are reported with the names of the
+// closest registry components of the same type.
+func (r *Registry) ResolveComponents(components *DistributionComponents) error {
+	errs := make(CollectionError)
+	resolve := func(componentType ComponentType, names []string, registryComponents RegistryComponents) []string {
+		resolved := make([]string, 0, len(names))
+		for _, name := range names {
+			if _, ok := registryComponents[name]; ok {
+				resolved = append(resolved, name)
+				continue
+			}
+			if registryName := registryNameOf(name); registryComponents[registryName] != nil {
+				resolved = append(resolved, registryName)
+				continue
+			}
+
+			err := ErrComponentNotFound
+			if suggestions := suggestComponents(name, registryComponents); len(suggestions) > 0 {
+				err = fmt.Errorf("%w, did you mean %s?", ErrComponentNotFound, strings.Join(suggestions, ", "))
+			}
+			errs[fmt.Sprintf("%s %s", componentType, name)] = err
+		}
+		slices.Sort(resolved)
+		return slices.Compact(resolved)
+	}
+
+	components.Receivers = resolve(Receiver, components.Receivers, r.Receivers)
+	components.Processors = resolve(Processor, components.Processors, r.Processors)
+	components.Exporters = resolve(Exporter, components.Exporters, r.Exporters)
+	components.Connectors = resolve(Connector, components.Connectors, r.Connectors)
+	components.Extensions = resolve(Extension, components.Extensions, r.Extensions)
+	components.Providers = resolve(Provider, components.Providers, r.Providers)
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// suggestComponents returns the registry components whose names are
+// close to name, closest first.
+func suggestComponents(name string, registryComponents RegistryComponents) []string {
+	type suggestion struct {
+		name     string
+		distance int
+	}
+
+	// Allow roughly one edit for every three characters, so short names
+	// don't match everything and long names still tolerate a few typos.
+	maxDistance := max(2, len(name)/3)
+	var suggestions []suggestion
+	for candidate := range registryComponents {
+		distance := levenshteinDistance(registryNameOf(name), candidate)
+		if distance <= maxDistance || strings.HasPrefix(candidate, name) {
+			suggestions = append(suggestions, suggestion{candidate, distance})
+		}
+	}
+	slices.SortFunc(suggestions, func(a, b suggestion) int {
+		if a.distance != b.distance {
+			return a.distance - b.distance
+		}
+		return strings.Compare(a.name, b.name)
+	})
+
+	names := make([]string, 0, maxComponentSuggestions)
+	for _, s := range suggestions[:min(len(suggestions), maxComponentSuggestions)] {
+		names = append(names, s.name)
+	}
+	return names
+}
+
+// levenshteinDistance returns the number of single character insertions,
+// deletions and substitutions needed to turn a into b.
+func levenshteinDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
@@ -0,0 +1,165 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestComponentsFromOTelConfigFiles(t *testing.T) {
+	components, err := ComponentsFromOTelConfigFiles([]string{
+		filepath.Join("testdata", "init", "metrics.yaml"),
+		filepath.Join("testdata", "init", "logs.yaml"),
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, components, &DistributionComponents{
+		Receivers:  []string{"filelog", "hostmetrics", "otlp", "prometheus"},
+		Processors: []string{"batch", "memory_limiter"},
+		Exporters:  []string{"googlecloud"},
+		Connectors: []string{"forward"},
+		Extensions: []string{"health_check"},
+		Providers:  []string{"env", "googlesecretmanager"},
+	})
+}
+
+func TestNewInitSpecProviders(t *testing.T) {
+	spec := NewInitSpec(InitSpecOptions{Name: "otelcol-init"}, &DistributionComponents{
+		Providers: []string{"env", "googlesecretmanager"},
+	})
+	assert.DeepEqual(t, spec.Components.Providers, []string{"env", "file", "googlesecretmanager", "http", "https", "yaml"})
+
+	spec = NewInitSpec(InitSpecOptions{Name: "otelcol-init"}, &DistributionComponents{})
+	assert.DeepEqual(t, spec.Components.Providers, DefaultInitProviders)
+}
+
+func TestComponentsFromOTelConfigWithoutService(t *testing.T) {
+	components, err := ComponentsFromOTelConfig(map[string]any{
+		"receivers": map[string]any{
+			"otlp":          nil,
+			"otlp/internal": nil,
+		},
+		"exporters": map[string]any{
+			"debug": nil,
+		},
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, components, &DistributionComponents{
+		Receivers: []string{"otlp"},
+		Exporters: []string{"debug"},
+	})
+}
+
+func TestComponentsFromOTelConfigUndeclaredComponents(t *testing.T) {
+	_, err := ComponentsFromOTelConfigFiles([]string{filepath.Join("testdata", "init", "undeclared_components.yaml")})
+	assert.ErrorContains(t, err, "receiver otlp/2 in pipeline traces: "+ErrUndeclaredComponent.Error())
+	assert.ErrorContains(t, err, "extension zpages: "+ErrUndeclaredComponent.Error())
+}
+
+func TestResolveComponents(t *testing.T) {
+	registry, err := LoadEmbeddedRegistry()
+	assert.NilError(t, err)
+
+	components := &DistributionComponents{
+		Receivers:  []string{"otlp"},
+		Processors: []string{"batch", "memory_limiter"},
+		Extensions: []string{"health_check"},
+	}
+	assert.NilError(t, registry.ResolveComponents(components))
+	assert.DeepEqual(t, components, &DistributionComponents{
+		Receivers:  []string{"otlp"},
+		Processors: []string{"batch", "memorylimiter"},
+		Exporters:  []string{},
+		Connectors: []string{},
+		Extensions: []string{"healthcheck"},
+		Providers:  []string{},
+	})
+}
+
+func TestResolveComponentsNotFound(t *testing.T) {
+	registry, err := LoadEmbeddedRegistry()
+	assert.NilError(t, err)
+
+	components, err := ComponentsFromOTelConfigFiles([]string{filepath.Join("testdata", "init", "unknown_components.yaml")})
+	assert.NilError(t, err)
+
+	err = registry.ResolveComponents(components)
+	collectionErr, ok := err.(CollectionError)
+	assert.Assert(t, ok)
+	assert.Equal(t, len(collectionErr), 2)
+	assert.ErrorIs(t, collectionErr["receiver otlpp"], ErrComponentNotFound)
+	assert.ErrorContains(t, collectionErr["receiver otlpp"], "did you mean otlp")
+	assert.ErrorContains(t, collectionErr["exporter googlecloudd"], "did you mean googlecloud")
+}
+
+func TestInitSpecGenerates(t *testing.T) {
+	registry, err := LoadEmbeddedRegistry()
+	assert.NilError(t, err)
+
+	components, err := ComponentsFromOTelConfigFiles([]string{filepath.Join("testdata", "init", "metrics.yaml")})
+	assert.NilError(t, err)
+	spec := NewInitSpec(InitSpecOptions{
+		Name:                       "otelcol-init",
+		OpenTelemetryVersion:       DefaultInitOpenTelemetryVersion,
+		OpenTelemetryStableVersion: DefaultInitOpenTelemetryStableVersion,
+		GoVersion:                  DefaultInitGoVersion,
+	}, components)
+	assert.NilError(t, registry.ResolveComponents(spec.Components))
+
+	specPath := filepath.Join(t.TempDir(), "spec.yaml")
+	assert.NilError(t, yamlMarshalToFile(spec, specPath, DefaultFileMode))
+
+	distributionSpec, err := NewDistributionSpec(specPath)
+	assert.NilError(t, err)
+	assert.Equal(t, distributionSpec.Name, "otelcol-init")
+	assert.DeepEqual(t, distributionSpec.Components, &DistributionComponents{
+		Receivers:  []string{"hostmetrics", "otlp", "prometheus"},
+		Processors: []string{"batch", "memorylimiter"},
+		Exporters:  []string{"googlecloud"},
+		Connectors: []string{"forward"},
+		Extensions: []string{"healthcheck"},
+		Providers:  DefaultInitProviders,
+	})
+
+	generator, err := NewDistributionGenerator(distributionSpec, registry, true)
+	assert.NilError(t, err)
+	t.Cleanup(func() {
+		generator.Clean()
+	})
+	assert.NilError(t, generator.Generate())
+	_, err = os.Stat(filepath.Join(generator.GeneratePath, "manifest.yaml"))
+	assert.NilError(t, err)
+}
+
+func TestLevenshteinDistance(t *testing.T) {
+	testCases := []struct {
+		a, b     string
+		expected int
+	}{
+		{"", "", 0},
+		{"otlp", "otlp", 0},
+		{"otlpp", "otlp", 1},
+		{"healthcheck", "health_check", 1},
+		{"kafka", "", 5},
+		{"jaeger", "zipkin", 6},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, levenshteinDistance(tc.a, tc.b), tc.expected, "%s -> %s", tc.a, tc.b)
+	}
+}
@@ -107,6 +107,8 @@ func createCommandRunner() *command.Runner {
 	runner := command.NewRunner()
 
 	runner.Register("generate", newGenerateCommand())
+	runner.Register("init", newInitCommand())
+	runner.Register("validate", newValidateCommand())
 	runner.Register("query", newQueryCommand())
 	runner.Register("otel_component_versions", newOtelComponentVersionsCommand())
 	runner.Register("project", newProjectCommand())
@@ -161,19 +163,11 @@ func (cmd *generateCommand) Run() error {
 		return err
 	}
 
-	registry, err := LoadEmbeddedRegistry()
+	registry, err := loadRegistries(*cmd.registries)
 	if err != nil {
 		return err
 	}
 
-	for _, registryPath := range *cmd.registries {
-		additionalRegistry, err := LoadRegistry(registryPath)
-		if err != nil {
-			return err
-		}
-		registry.Merge(additionalRegistry)
-	}
-
 	generator, err := NewDistributionGenerator(spec, registry, *cmd.force)
 	if err != nil {
 		return err
@@ -193,6 +187,148 @@ func (cmd *generateCommand) Run() error {
 	return generator.MoveGeneratedDirToWd()
 }
 
+// loadRegistries loads the embedded registry merged with the
+// additional registries at the given paths.
+func loadRegistries(paths []string) (*Registry, error) {
+	registry, err := LoadEmbeddedRegistry()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, registryPath := range paths {
+		additionalRegistry, err := LoadRegistry(registryPath)
+		if err != nil {
+			return nil, err
+		}
+		registry.Merge(additionalRegistry)
+	}
+	return registry, nil
+}
+
+type initCommand struct {
+	flags flag.FlagSet
+
+	fromConfig        *[]string
+	registries        *[]string
+	output            *string
+	name              *string
+	otelVersion       *string
+	otelStableVersion *string
+	goVersion         *string
+	force             *bool
+}
+
+func newInitCommand() *initCommand {
+	cmd := &initCommand{}
+	cmd.fromConfig = cmd.flags.StringArray("from-config", []string{}, "Collector config to derive the components from, can be repeated")
+	cmd.registries = cmd.flags.StringArray("registry", []string{}, "Provide additional component registries")
+	cmd.output = cmd.flags.StringP("output", "o", "spec.yaml", "Path to write the distribution specification to")
+	cmd.name = cmd.flags.String("name", "otelcol-custom", "Name and binary name of the distribution")
+	cmd.otelVersion = cmd.flags.String("opentelemetry_version", DefaultInitOpenTelemetryVersion, "The OpenTelemetry version of the distribution")
+	cmd.otelStableVersion = cmd.flags.String("opentelemetry_stable_version", DefaultInitOpenTelemetryStableVersion, "The OpenTelemetry stable version of the distribution")
+	cmd.goVersion = cmd.flags.String("go_version", DefaultInitGoVersion, "The Go version to build the distribution with")
+	cmd.force = cmd.flags.BoolP("force", "f", false, "Overwrite the output file if it exists")
+	return cmd
+}
+
+func (cmd *initCommand) ParseArgs(args []string) error {
+	return cmd.flags.Parse(args)
+}
+
+func (cmd *initCommand) Usage() string {
+	return cmd.flags.FlagUsages()
+}
+
+func (cmd *initCommand) Run() error {
+	if len(*cmd.fromConfig) == 0 {
+		return errors.New("missing --from-config flag")
+	}
+	if !*cmd.force {
+		if _, err := os.Stat(*cmd.output); err == nil {
+			return fmt.Errorf("%s already exists, use --force to overwrite it", *cmd.output)
+		}
+	}
+
+	components, err := ComponentsFromOTelConfigFiles(*cmd.fromConfig)
+	if err != nil {
+		return err
+	}
+
+	registry, err := loadRegistries(*cmd.registries)
+	if err != nil {
+		return err
+	}
+
+	spec := NewInitSpec(InitSpecOptions{
+		Name:                       *cmd.name,
+		OpenTelemetryVersion:       *cmd.otelVersion,
+		OpenTelemetryStableVersion: *cmd.otelStableVersion,
+		GoVersion:                  *cmd.goVersion,
+	}, components)
+	if err := registry.ResolveComponents(spec.Components); err != nil {
+		return err
+	}
+
+	if err := yamlMarshalToFile(spec, *cmd.output, DefaultFileMode); err != nil {
+		return err
+	}
+	logger.Info(fmt.Sprintf("wrote distribution spec to %s", *cmd.output))
+	return nil
+}
+
+type validateCommand struct {
+	flags flag.FlagSet
+
+	spec    *string
+	configs *[]string
+	strict  *bool
+}
+
+func newValidateCommand() *validateCommand {
+	cmd := &validateCommand{}
+	cmd.spec = setSpecFlag(&cmd.flags)
+	cmd.configs = cmd.flags.StringArray("config", []string{}, "Collector config to validate against the spec, can be repeated")
+	cmd.strict = cmd.flags.Bool("strict", false, "Also fail if the spec has components that none of the configs use")
+	return cmd
+}
+
+func (cmd *validateCommand) ParseArgs(args []string) error {
+	return cmd.flags.Parse(args)
+}
+
+func (cmd *validateCommand) Usage() string {
+	return cmd.flags.FlagUsages()
+}
+
+func (cmd *validateCommand) Run() error {
+	if *cmd.spec == "" {
+		return errNoSpecFlag
+	}
+	if len(*cmd.configs) == 0 {
+		return errors.New("missing --config flag")
+	}
+
+	spec, err := NewDistributionSpec(*cmd.spec)
+	if err != nil {
+		return err
+	}
+
+	report, err := ValidateOTelConfigFiles(spec, *cmd.configs)
+	if err != nil {
+		return err
+	}
+
+	// Writing to stdout instead of logger since the report
+	// may be collected by CI.
+	if err := report.Write(os.Stdout); err != nil {
+		return err
+	}
+	if len(report.Missing) > 0 || (*cmd.strict && report.HasUnused()) {
+		return ErrConfigValidation
+	}
+	return nil
+}
+
 type queryCommand struct {
 	flags flag.FlagSet
 
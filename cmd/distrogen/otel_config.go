@@ -15,16 +15,105 @@
 package main
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
 )
 
 var ErrSectionNotFound = errors.New("could not find section")
+var ErrUndeclaredComponent = errors.New("component used in service but not declared")
+
+// configPlaceholderRegex matches the ${scheme:value} and ${ENV} references
+// that the collector expands with its config providers, along with their
+// $${...} escaped form.
+var configPlaceholderRegex = regexp.MustCompile(`\$?\$\{(?:([a-zA-Z][a-zA-Z0-9+.-]*):)?[^}]*\}`)
+
+// configPlaceholderValue is the value config references are replaced with
+// when reading a collector config.
+const configPlaceholderValue = "placeholder"
+
+// envProviderScheme is the scheme of the provider that expands references
+// without a scheme, such as ${ENV}.
+const envProviderScheme = "env"
+
+// ReadOTelConfigFile reads a collector config from a yaml file. Config
+// provider references are replaced with a placeholder value, since they
+// can't be expanded without the collector. It returns the config along
+// with the sorted schemes of the providers the references use.
+func ReadOTelConfigFile(path string) (map[string]any, []string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var providers []string
+	content = configPlaceholderRegex.ReplaceAllFunc(content, func(reference []byte) []byte {
+		// $${...} is an escaped reference that the collector leaves as ${...}.
+		if bytes.HasPrefix(reference, []byte("$$")) {
+			return reference
+		}
+		scheme := envProviderScheme
+		if match := configPlaceholderRegex.FindSubmatch(reference); len(match[1]) > 0 {
+			scheme = string(match[1])
+		}
+		if !slices.Contains(providers, scheme) {
+			providers = append(providers, scheme)
+		}
+		return []byte(configPlaceholderValue)
+	})
+	slices.Sort(providers)
+
+	otelConfig := map[string]any{}
+	if err := yaml.Unmarshal(content, &otelConfig); err != nil {
+		return nil, nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+	return otelConfig, providers, nil
+}
 
 // ComponentsFromOTelConfig will take an OpenTelemetry Collector
 // configuration map and load the component names that would
 // be loaded from a registry on distribution generation.
+// Component IDs of the form `type/name` are reduced to their type.
+// If the config has a `service` section, only the components used
+// in its pipelines and extensions are returned.
 func ComponentsFromOTelConfig(otelConfig map[string]any) (*DistributionComponents, error) {
+	components, err := readDeclaredComponents(otelConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	service, err := readServiceSection(otelConfig)
+	if err != nil && !errors.Is(err, ErrSectionNotFound) {
+		return nil, err
+	}
+	if service != nil {
+		components, err = service.usedComponents(components)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return componentTypes(components), nil
+}
+
+// DeclaredComponentsFromOTelConfig will take an OpenTelemetry Collector
+// configuration map and load the types of all the components it declares,
+// whether or not they are used in the service.
+func DeclaredComponentsFromOTelConfig(otelConfig map[string]any) (*DistributionComponents, error) {
+	components, err := readDeclaredComponents(otelConfig)
+	if err != nil {
+		return nil, err
+	}
+	return componentTypes(components), nil
+}
+
+func readDeclaredComponents(otelConfig map[string]any) (*DistributionComponents, error) {
 	components := &DistributionComponents{}
 	var err error
 	components.Receivers, err = readComponentsFromSection("receivers", otelConfig)
@@ -62,3 +151,167 @@ func readComponentsFromSection(sectionName string, otelConfig map[string]any) ([
 	}
 	return mapKeys(section), nil
 }
+
+// otelServiceConfig is the part of the `service` section of a
+// collector config that references components.
+type otelServiceConfig struct {
+	Extensions []string
+	Pipelines  map[string]otelPipelineConfig
+}
+
+// otelPipelineConfig is a pipeline in the `service::pipelines`
+// section of a collector config.
+type otelPipelineConfig struct {
+	Receivers  []string
+	Processors []string
+	Exporters  []string
+}
+
+func readServiceSection(otelConfig map[string]any) (*otelServiceConfig, error) {
+	rawSection, ok := otelConfig["service"]
+	if !ok {
+		return nil, fmt.Errorf("reading section service: %w", ErrSectionNotFound)
+	}
+	section, ok := rawSection.(map[string]any)
+	if !ok {
+		return nil, errors.New("reading section service: invalid section data")
+	}
+
+	service := &otelServiceConfig{Pipelines: map[string]otelPipelineConfig{}}
+	var err error
+	service.Extensions, err = readStringList(section, "extensions")
+	if err != nil {
+		return nil, fmt.Errorf("reading section service: %w", err)
+	}
+
+	rawPipelines, ok := section["pipelines"]
+	if !ok || rawPipelines == nil {
+		return service, nil
+	}
+	pipelines, ok := rawPipelines.(map[string]any)
+	if !ok {
+		return nil, errors.New("reading section service::pipelines: invalid section data")
+	}
+	for name, rawPipeline := range pipelines {
+		pipeline, ok := rawPipeline.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("reading pipeline %s: invalid pipeline data", name)
+		}
+		var p otelPipelineConfig
+		if p.Receivers, err = readStringList(pipeline, "receivers"); err != nil {
+			return nil, fmt.Errorf("reading pipeline %s: %w", name, err)
+		}
+		if p.Processors, err = readStringList(pipeline, "processors"); err != nil {
+			return nil, fmt.Errorf("reading pipeline %s: %w", name, err)
+		}
+		if p.Exporters, err = readStringList(pipeline, "exporters"); err != nil {
+			return nil, fmt.Errorf("reading pipeline %s: %w", name, err)
+		}
+		service.Pipelines[name] = p
+	}
+	return service, nil
+}
+
+func readStringList(section map[string]any, key string) ([]string, error) {
+	rawList, ok := section[key]
+	if !ok || rawList == nil {
+		return nil, nil
+	}
+	list, ok := rawList.([]any)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a list", key)
+	}
+	values := make([]string, 0, len(list))
+	for _, rawValue := range list {
+		value, ok := rawValue.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s contains a non-string value %v", key, rawValue)
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// usedComponents returns the declared components that are used by
+// the service. Connectors are used as exporters of one pipeline and
+// receivers of another, so they are told apart from receivers and
+// exporters by being declared in the connectors section.
+func (s *otelServiceConfig) usedComponents(declared *DistributionComponents) (*DistributionComponents, error) {
+	used := &DistributionComponents{}
+	errs := make(CollectionError)
+
+	for _, id := range s.Extensions {
+		if !slices.Contains(declared.Extensions, id) {
+			errs["extension "+id] = ErrUndeclaredComponent
+			continue
+		}
+		used.Extensions = append(used.Extensions, id)
+	}
+
+	for name, pipeline := range s.Pipelines {
+		for _, id := range pipeline.Receivers {
+			switch {
+			case slices.Contains(declared.Connectors, id):
+				used.Connectors = append(used.Connectors, id)
+			case slices.Contains(declared.Receivers, id):
+				used.Receivers = append(used.Receivers, id)
+			default:
+				errs[fmt.Sprintf("receiver %s in pipeline %s", id, name)] = ErrUndeclaredComponent
+			}
+		}
+		for _, id := range pipeline.Processors {
+			if !slices.Contains(declared.Processors, id) {
+				errs[fmt.Sprintf("processor %s in pipeline %s", id, name)] = ErrUndeclaredComponent
+				continue
+			}
+			used.Processors = append(used.Processors, id)
+		}
+		for _, id := range pipeline.Exporters {
+			switch {
+			case slices.Contains(declared.Connectors, id):
+				used.Connectors = append(used.Connectors, id)
+			case slices.Contains(declared.Exporters, id):
+				used.Exporters = append(used.Exporters, id)
+			default:
+				errs[fmt.Sprintf("exporter %s in pipeline %s", id, name)] = ErrUndeclaredComponent
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return used, nil
+}
+
+// componentTypes reduces the component IDs to their sorted,
+// deduplicated component types.
+func componentTypes(components *DistributionComponents) *DistributionComponents {
+	return &DistributionComponents{
+		Receivers:  componentTypesOf(components.Receivers),
+		Processors: componentTypesOf(components.Processors),
+		Exporters:  componentTypesOf(components.Exporters),
+		Connectors: componentTypesOf(components.Connectors),
+		Extensions: componentTypesOf(components.Extensions),
+		Providers:  componentTypesOf(components.Providers),
+	}
+}
+
+func componentTypesOf(ids []string) []string {
+	var types []string
+	for _, id := range ids {
+		componentType, _, _ := strings.Cut(id, "/")
+		if !slices.Contains(types, componentType) {
+			types = append(types, componentType)
+		}
+	}
+	slices.Sort(types)
+	return types
+}
+
+// registryNameOf returns the registry name of a component type. Registry
+// names come from the Go module of the component, so they lack the
+// underscores of types such as memory_limiter.
+func registryNameOf(componentType string) string {
+	return strings.ReplaceAll(componentType, "_", "")
+}
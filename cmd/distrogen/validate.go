@@ -0,0 +1,162 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+var ErrConfigValidation = errors.New("collector configs failed validation against the distribution spec")
+
+// MissingComponent is a component a collector config needs that
+// is not in the distribution spec.
+type MissingComponent struct {
+	ConfigPath string
+	Type       ComponentType
+	Name       string
+}
+
+// ConfigValidationReport is the result of checking collector configs
+// against the components of a distribution spec.
+type ConfigValidationReport struct {
+	// Missing are the components declared in the configs, and the
+	// providers their references use, that are not in the spec.
+	Missing []MissingComponent
+	// Unused are the components in the spec that none of the configs
+	// declare. Providers are not included, since configs are loaded
+	// by providers without declaring them.
+	Unused *DistributionComponents
+}
+
+// ValidateOTelConfigFiles checks the collector configs at the given paths
+// against the components of the spec. Every declared component is checked,
+// used in the service or not, since the collector fails to start on any
+// component type it doesn't have.
+func ValidateOTelConfigFiles(spec *DistributionSpec, paths []string) (*ConfigValidationReport, error) {
+	specComponents := spec.Components
+	if specComponents == nil {
+		specComponents = &DistributionComponents{}
+	}
+
+	report := &ConfigValidationReport{}
+	used := &DistributionComponents{}
+	for _, path := range paths {
+		otelConfig, providers, err := ReadOTelConfigFile(path)
+		if err != nil {
+			return nil, err
+		}
+		declared, err := DeclaredComponentsFromOTelConfig(otelConfig)
+		if err != nil {
+			return nil, fmt.Errorf("reading components from %s: %w", path, err)
+		}
+		declared.Providers = providers
+
+		check := func(componentType ComponentType, names []string, specNames []string) []string {
+			var found []string
+			for _, name := range names {
+				specName, ok := findSpecComponent(name, specNames)
+				if !ok {
+					report.Missing = append(report.Missing, MissingComponent{ConfigPath: path, Type: componentType, Name: name})
+					continue
+				}
+				found = append(found, specName)
+			}
+			return found
+		}
+		used.Merge(&DistributionComponents{
+			Receivers:  check(Receiver, declared.Receivers, specComponents.Receivers),
+			Processors: check(Processor, declared.Processors, specComponents.Processors),
+			Exporters:  check(Exporter, declared.Exporters, specComponents.Exporters),
+			Connectors: check(Connector, declared.Connectors, specComponents.Connectors),
+			Extensions: check(Extension, declared.Extensions, specComponents.Extensions),
+			Providers:  check(Provider, declared.Providers, specComponents.Providers),
+		})
+	}
+
+	report.Unused = &DistributionComponents{
+		Receivers:  unusedComponents(specComponents.Receivers, used.Receivers),
+		Processors: unusedComponents(specComponents.Processors, used.Processors),
+		Exporters:  unusedComponents(specComponents.Exporters, used.Exporters),
+		Connectors: unusedComponents(specComponents.Connectors, used.Connectors),
+		Extensions: unusedComponents(specComponents.Extensions, used.Extensions),
+	}
+	return report, nil
+}
+
+// findSpecComponent returns the name in the spec of the component
+// with the given type.
+func findSpecComponent(componentType string, specNames []string) (string, bool) {
+	for _, specName := range specNames {
+		if specName == componentType || specName == registryNameOf(componentType) {
+			return specName, true
+		}
+	}
+	return "", false
+}
+
+func unusedComponents(specNames []string, used []string) []string {
+	var unused []string
+	for _, name := range specNames {
+		if !slices.Contains(used, name) {
+			unused = append(unused, name)
+		}
+	}
+	slices.Sort(unused)
+	return unused
+}
+
+// HasUnused reports whether the spec has components that none of the configs declare.
+func (r *ConfigValidationReport) HasUnused() bool {
+	u := r.Unused
+	return len(u.Receivers)+len(u.Processors)+len(u.Exporters)+len(u.Connectors)+len(u.Extensions) > 0
+}
+
+// Write writes the report in a human readable format.
+func (r *ConfigValidationReport) Write(w io.Writer) error {
+	var sb strings.Builder
+	if len(r.Missing) == 0 {
+		sb.WriteString("All components used by the configs are in the spec.\n")
+	} else {
+		sb.WriteString("Components missing from the spec:\n")
+		for _, m := range r.Missing {
+			fmt.Fprintf(&sb, "  %s: %s %s\n", m.ConfigPath, m.Type, m.Name)
+		}
+	}
+
+	if r.HasUnused() {
+		sb.WriteString("Components in the spec not used by any config:\n")
+		for _, unused := range []struct {
+			componentType ComponentType
+			names         []string
+		}{
+			{Receiver, r.Unused.Receivers},
+			{Processor, r.Unused.Processors},
+			{Exporter, r.Unused.Exporters},
+			{Connector, r.Unused.Connectors},
+			{Extension, r.Unused.Extensions},
+		} {
+			if len(unused.names) > 0 {
+				fmt.Fprintf(&sb, "  %ss: %s\n", unused.componentType, strings.Join(unused.names, ", "))
+			}
+		}
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
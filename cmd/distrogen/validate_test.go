@@ -0,0 +1,82 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func loadValidateTestSpec(t *testing.T) *DistributionSpec {
+	t.Helper()
+	spec, err := NewDistributionSpec(filepath.Join("testdata", "validate", "spec.yaml"))
+	assert.NilError(t, err)
+	return spec
+}
+
+func TestReadOTelConfigFile(t *testing.T) {
+	otelConfig, providers, err := ReadOTelConfigFile(filepath.Join("testdata", "validate", "valid.yaml"))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, providers, []string{"env", "file"})
+
+	exporters := otelConfig["exporters"].(map[string]any)
+	assert.Equal(t, exporters["googlecloud"].(map[string]any)["project"], configPlaceholderValue)
+	assert.Equal(t, exporters["debug"].(map[string]any)["verbosity"], "$${verbosity}")
+}
+
+func TestValidateOTelConfigFiles(t *testing.T) {
+	spec := loadValidateTestSpec(t)
+
+	report, err := ValidateOTelConfigFiles(spec, []string{filepath.Join("testdata", "validate", "valid.yaml")})
+	assert.NilError(t, err)
+	assert.Equal(t, len(report.Missing), 0)
+	assert.DeepEqual(t, report.Unused, &DistributionComponents{
+		Receivers:  []string{"jmx"},
+		Extensions: []string{"zpages"},
+	})
+}
+
+func TestValidateOTelConfigFilesMissingComponents(t *testing.T) {
+	spec := loadValidateTestSpec(t)
+	missingPath := filepath.Join("testdata", "validate", "missing.yaml")
+
+	report, err := ValidateOTelConfigFiles(spec, []string{
+		filepath.Join("testdata", "validate", "valid.yaml"),
+		missingPath,
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, report.Missing, []MissingComponent{
+		{ConfigPath: missingPath, Type: Receiver, Name: "kafka"},
+		{ConfigPath: missingPath, Type: Processor, Name: "transform"},
+		{ConfigPath: missingPath, Type: Provider, Name: "googlesecretmanager"},
+	})
+	assert.DeepEqual(t, report.Unused, &DistributionComponents{
+		Receivers:  []string{"jmx"},
+		Extensions: []string{"zpages"},
+	})
+
+	var sb strings.Builder
+	assert.NilError(t, report.Write(&sb))
+	assert.Equal(t, sb.String(), "Components missing from the spec:\n"+
+		"  "+missingPath+": receiver kafka\n"+
+		"  "+missingPath+": processor transform\n"+
+		"  "+missingPath+": provider googlesecretmanager\n"+
+		"Components in the spec not used by any config:\n"+
+		"  receivers: jmx\n"+
+		"  extensions: zpages\n")
+}
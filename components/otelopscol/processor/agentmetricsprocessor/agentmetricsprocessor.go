@@ -18,7 +18,9 @@ import (
 	"context"
 	"regexp"
 	"sync"
+	"time"
 
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
@@ -41,43 +43,125 @@ type agentMetricsProcessor struct {
 	logger *zap.Logger
 	cfg    *Config
 
-	mutex             sync.Mutex
-	prevCPUTimeValues map[string]float64
-	prevOp            map[opKey]opData
+	metricsToSplit   map[string]bool
+	utilizationRules map[string][]utilizationRule
+
+	now func() time.Time
+
+	// mutex guards resourceStates for the duration of a batch.
+	mutex          sync.Mutex
+	resourceStates map[string]*resourceState
+}
+
+// staleResourceTimeout is how long the state of a resource is kept after
+// it was last seen.
+const staleResourceTimeout = 10 * time.Minute
+
+// resourceState holds the values persisted between batches for a single
+// resource, so that resources sent in the same batch (e.g. several hosts
+// sent to a gateway collector) do not overwrite each other's values.
+type resourceState struct {
+	lastSeen time.Time
+
+	// prevCumulativeValues holds the previous values of cumulative utilization
+	// inputs, keyed by output metric name and then by labels.
+	prevCumulativeValues map[string]map[string]float64
+	prevOp               map[opKey]opData
+}
+
+func newResourceState() *resourceState {
+	return &resourceState{
+		prevCumulativeValues: make(map[string]map[string]float64),
+		prevOp:               make(map[opKey]opData),
+	}
+}
+
+// resourceStateFor returns the state of the resource, creating it if it
+// doesn't exist yet. The caller must hold mtp.mutex.
+func (mtp *agentMetricsProcessor) resourceStateFor(resource pcommon.Resource) *resourceState {
+	key := labelsAsKey(resource.Attributes())
+	state, ok := mtp.resourceStates[key]
+	if !ok {
+		state = newResourceState()
+		mtp.resourceStates[key] = state
+	}
+	state.lastSeen = mtp.now()
+	return state
+}
+
+// evictStaleResources removes the state of resources that have not been seen
+// for staleResourceTimeout. The caller must hold mtp.mutex.
+func (mtp *agentMetricsProcessor) evictStaleResources() {
+	cutoff := mtp.now().Add(-staleResourceTimeout)
+	for key, state := range mtp.resourceStates {
+		if state.lastSeen.Before(cutoff) {
+			delete(mtp.resourceStates, key)
+		}
+	}
 }
 
 func newAgentMetricsProcessor(logger *zap.Logger, cfg *Config) *agentMetricsProcessor {
 	return &agentMetricsProcessor{
-		logger: logger,
-		cfg:    cfg,
-		prevOp: make(map[opKey]opData),
+		logger:           logger,
+		cfg:              cfg,
+		metricsToSplit:   toSet(cfg.SplitReadWrite.Metrics),
+		utilizationRules: newUtilizationRules(cfg.Utilization),
+		now:              time.Now,
+		resourceStates:   make(map[string]*resourceState),
+	}
+}
+
+func toSet(names []string) map[string]bool {
+	set := make(map[string]bool, len(names))
+	for _, name := range names {
+		set[name] = true
 	}
+	return set
 }
 
 // ProcessMetrics implements the MProcessor interface.
 func (mtp *agentMetricsProcessor) ProcessMetrics(_ context.Context, metrics pmetric.Metrics) (pmetric.Metrics, error) {
-	convertNonMonotonicSumsToGauges(metrics.ResourceMetrics())
-	removeVersionAttribute(metrics.ResourceMetrics())
+	if mtp.cfg.ConvertNonMonotonicSums.Enabled {
+		convertNonMonotonicSumsToGauges(metrics.ResourceMetrics())
+	}
+
+	if mtp.cfg.RemoveAttributes.Enabled {
+		removeAttributes(metrics.ResourceMetrics(), mtp.cfg.RemoveAttributes.Attributes)
+	}
+
+	mtp.mutex.Lock()
+	defer mtp.mutex.Unlock()
+	defer mtp.evictStaleResources()
 
 	var errors []error
-	if err := combineProcessMetrics(metrics.ResourceMetrics()); err != nil {
-		errors = append(errors, err)
+	if mtp.cfg.CombineProcessMetrics.Enabled {
+		if err := combineProcessMetrics(metrics.ResourceMetrics()); err != nil {
+			errors = append(errors, err)
+		}
 	}
 
-	if err := splitReadWriteBytesMetrics(metrics.ResourceMetrics()); err != nil {
-		errors = append(errors, err)
+	if mtp.cfg.SplitReadWrite.Enabled {
+		if err := mtp.splitReadWriteBytesMetrics(metrics.ResourceMetrics()); err != nil {
+			errors = append(errors, err)
+		}
 	}
 
-	if err := mtp.appendUtilizationMetrics(metrics.ResourceMetrics()); err != nil {
-		errors = append(errors, err)
+	if mtp.cfg.Utilization.Enabled {
+		if err := mtp.appendUtilizationMetrics(metrics.ResourceMetrics()); err != nil {
+			errors = append(errors, err)
+		}
 	}
 
-	if err := cleanCPUNumber(metrics.ResourceMetrics()); err != nil {
-		errors = append(errors, err)
+	if mtp.cfg.CleanCPUNumber.Enabled {
+		if err := cleanCPUNumber(metrics.ResourceMetrics()); err != nil {
+			errors = append(errors, err)
+		}
 	}
 
-	if err := mtp.appendAverageDiskMetrics(metrics.ResourceMetrics()); err != nil {
-		errors = append(errors, err)
+	if mtp.cfg.AverageDiskOperationTime.Enabled {
+		if err := mtp.appendAverageDiskMetrics(metrics.ResourceMetrics()); err != nil {
+			errors = append(errors, err)
+		}
 	}
 
 	// Add blank labels last so they can also be applied to metrics added by agentmetricsprocessor.
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -37,6 +38,7 @@ type testCase struct {
 	prevCPUTimeValuesInput    map[string]float64
 	prevCPUTimeValuesExpected map[string]float64
 	prevOpInput               map[opKey]opData
+	configure                 func(cfg *Config)
 }
 
 func TestAgentMetricsProcessor(t *testing.T) {
@@ -61,6 +63,11 @@ func TestAgentMetricsProcessor(t *testing.T) {
 			input:    generateProcessResourceMetricsInput(),
 			expected: generateProcessResourceMetricsExpected(),
 		},
+		{
+			name:     "multi-host-process-resources-case",
+			input:    generateMultiHostProcessResourceMetricsInput(),
+			expected: generateMultiHostProcessResourceMetricsExpected(),
+		},
 		{
 			name:     "read-write-split-case",
 			input:    generateReadWriteMetricsInput(),
@@ -89,13 +96,90 @@ func TestAgentMetricsProcessor(t *testing.T) {
 			expected:    generateAverageDiskPrevExpected(),
 			prevOpInput: generateAverageDiskPrevOpInput(),
 		},
+		{
+			name:     "all-disabled",
+			input:    generateReadWriteMetricsInput(),
+			expected: generateReadWriteMetricsInput(),
+			configure: func(cfg *Config) {
+				cfg.ConvertNonMonotonicSums.Enabled = false
+				cfg.RemoveAttributes.Enabled = false
+				cfg.CombineProcessMetrics.Enabled = false
+				cfg.SplitReadWrite.Enabled = false
+				cfg.Utilization.Enabled = false
+				cfg.CleanCPUNumber.Enabled = false
+				cfg.AverageDiskOperationTime.Enabled = false
+			},
+		},
+		{
+			name:     "custom-read-write-split-case",
+			input:    generateReadWriteMetricsInput(),
+			expected: generateCustomReadWriteMetricsExpected(),
+			configure: func(cfg *Config) {
+				cfg.SplitReadWrite.Metrics = []string{"system.disk.io"}
+				cfg.SplitReadWrite.ReadPrefix = "r_"
+				cfg.SplitReadWrite.WritePrefix = "w_"
+			},
+		},
+		{
+			name:     "suffix-read-write-split-case",
+			input:    generateReadWriteMetricsInput(),
+			expected: generateSuffixReadWriteMetricsExpected(),
+			configure: func(cfg *Config) {
+				cfg.SplitReadWrite.Metrics = []string{"system.disk.io"}
+				cfg.SplitReadWrite.ReadPrefix = ""
+				cfg.SplitReadWrite.WritePrefix = ""
+				cfg.SplitReadWrite.ReadSuffix = ".read"
+				cfg.SplitReadWrite.WriteSuffix = ".write"
+			},
+		},
+		{
+			name:     "custom-utilization-case",
+			input:    generateCustomUtilizationMetricsInput(),
+			expected: generateCustomUtilizationMetricsExpected(),
+			configure: func(cfg *Config) {
+				cfg.Utilization.Metrics = []string{"container.memory.usage"}
+				cfg.Utilization.Label = "type"
+			},
+		},
+		{
+			name:     "utilization-rules-case",
+			input:    generateUtilizationRulesInput(),
+			expected: generateUtilizationRulesExpected(),
+			configure: func(cfg *Config) {
+				cfg.Utilization.Metrics = nil
+				cfg.Utilization.Rules = []UtilizationRule{
+					{
+						Metric:       "jvm.memory.heap.used",
+						SumOverLabel: "pool",
+						OutputMetric: "jvm.memory.heap.pool_fraction",
+						OutputUnit:   "1",
+						Scale:        1,
+					},
+					{
+						Metric: "gpu.memory.usage",
+					},
+				}
+			},
+		},
+		{
+			name:     "remove-custom-attribute-case",
+			input:    generateMultiAttrVersionInput(),
+			expected: generateRemoveCustomAttributeExpected(),
+			configure: func(cfg *Config) {
+				cfg.RemoveAttributes.Attributes = []string{"other_attr"}
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			amp := newAgentMetricsProcessor(zap.NewExample(), &Config{
-				BlankLabelMetrics: []string{"system.cpu.time"},
-			})
+			cfg := createDefaultConfig().(*Config)
+			cfg.BlankLabelMetrics = []string{"system.cpu.time"}
+			if tt.configure != nil {
+				tt.configure(cfg)
+			}
+			require.NoError(t, cfg.Validate())
+			amp := newAgentMetricsProcessor(zap.NewExample(), cfg)
 
 			tmn := &consumertest.MetricsSink{}
 			rmp, err := processorhelper.NewMetrics(
@@ -108,9 +192,14 @@ func TestAgentMetricsProcessor(t *testing.T) {
 			require.NoError(t, err)
 			assert.True(t, rmp.Capabilities().MutatesData)
 
-			amp.prevCPUTimeValues = tt.prevCPUTimeValuesInput
+			// all test inputs use a single resource without attributes
+			state := newResourceState()
+			amp.resourceStates[""] = state
+			if tt.prevCPUTimeValuesInput != nil {
+				state.prevCumulativeValues["system.cpu.utilization"] = tt.prevCPUTimeValuesInput
+			}
 			if tt.prevOpInput != nil {
-				amp.prevOp = tt.prevOpInput
+				state.prevOp = tt.prevOpInput
 			}
 			require.NoError(t, rmp.Start(context.Background(), componenttest.NewNopHost()))
 			defer func() { assert.NoError(t, rmp.Shutdown(context.Background())) }()
@@ -125,12 +214,70 @@ func TestAgentMetricsProcessor(t *testing.T) {
 
 			assertEqual(t, tt.expected, tmn.AllMetrics()[0])
 			if tt.prevCPUTimeValuesExpected != nil {
-				assert.Equal(t, tt.prevCPUTimeValuesExpected, amp.prevCPUTimeValues)
+				assert.Equal(t, tt.prevCPUTimeValuesExpected, state.prevCumulativeValues["system.cpu.utilization"])
 			}
 		})
 	}
 }
 
+func TestUtilizationRulesDeltaCumulative(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Utilization.Rules = []UtilizationRule{{
+		Metric:          "container.cpu.time",
+		DeltaCumulative: true,
+		OutputMetric:    "container.cpu.utilization",
+	}}
+	require.NoError(t, cfg.Validate())
+	amp := newAgentMetricsProcessor(zap.NewExample(), cfg)
+
+	// the first batch only persists the cumulative values
+	_, err := amp.ProcessMetrics(context.Background(), generateDeltaCumulativeInput(10, 30))
+	require.NoError(t, err)
+
+	actual, err := amp.ProcessMetrics(context.Background(), generateDeltaCumulativeInput(13, 39))
+	require.NoError(t, err)
+	assertEqual(t, generateDeltaCumulativeExpected(), actual)
+}
+
+func TestAgentMetricsProcessorInterleavedResources(t *testing.T) {
+	now := time.Unix(0, 0)
+	amp := newAgentMetricsProcessor(zap.NewExample(), createDefaultConfig().(*Config))
+	amp.now = func() time.Time { return now }
+
+	_, err := amp.ProcessMetrics(context.Background(), generateInterleavedResourcesInput(0, 100, 200, 1000))
+	require.NoError(t, err)
+	require.Len(t, amp.resourceStates, 2)
+
+	now = now.Add(time.Minute)
+	actual, err := amp.ProcessMetrics(context.Background(), generateInterleavedResourcesInput(1, 102, 204, 1040))
+	require.NoError(t, err)
+	assertEqual(t, generateInterleavedResourcesExpected(), actual)
+
+	// only host-a keeps reporting, so host-b is evicted once it is stale
+	now = now.Add(staleResourceTimeout + time.Second)
+	onlyA := generateInterleavedResourcesInput(2, 104, 208, 1080)
+	onlyA.ResourceMetrics().RemoveIf(func(rm pmetric.ResourceMetrics) bool {
+		v, _ := rm.Resource().Attributes().Get("host.name")
+		return v.Str() == "host-b"
+	})
+	_, err = amp.ProcessMetrics(context.Background(), onlyA)
+	require.NoError(t, err)
+	require.Len(t, amp.resourceStates, 1)
+	require.Contains(t, amp.resourceStates, "host.name=host-a")
+}
+
+func TestCombineProcessMetricsErrorLeavesMetricsUnchanged(t *testing.T) {
+	input := generateProcessResourceMetricsInput()
+	// the last process resource has an owner that can't be converted to a label
+	input.ResourceMetrics().At(2).Resource().Attributes().PutEmptySlice("process.owner")
+	expected := pmetric.NewMetrics()
+	input.CopyTo(expected)
+
+	err := combineProcessMetrics(input.ResourceMetrics())
+	require.Error(t, err)
+	assertEqual(t, expected, input)
+}
+
 // builders to generate test metrics
 
 type resourceMetricsBuilder struct {
@@ -14,9 +14,170 @@
 
 package agentmetricsprocessor
 
+import (
+	"errors"
+	"fmt"
+)
+
 // Config defines configuration for Resource processor.
 type Config struct {
 	// BlankLabelMetrics is a list of metrics that need a label called
 	// "blank" with an empty value.
 	BlankLabelMetrics []string `mapstructure:"blank_label_metrics"`
+
+	// ConvertNonMonotonicSums converts non-monotonic sums to gauges.
+	ConvertNonMonotonicSums TransformConfig `mapstructure:"convert_non_monotonic_sums"`
+
+	// RemoveAttributes removes data point attributes from all metrics.
+	RemoveAttributes RemoveAttributesConfig `mapstructure:"remove_attributes"`
+
+	// CombineProcessMetrics moves process resource attributes to data point
+	// labels and merges the process metrics into a single resource.
+	CombineProcessMetrics TransformConfig `mapstructure:"combine_process_metrics"`
+
+	// SplitReadWrite splits metrics with a direction label into separate
+	// read and write metrics.
+	SplitReadWrite SplitReadWriteConfig `mapstructure:"split_read_write"`
+
+	// Utilization appends utilization metrics computed from usage metrics.
+	Utilization UtilizationConfig `mapstructure:"utilization"`
+
+	// CleanCPUNumber strips the "cpu" prefix from the values of the cpu label.
+	CleanCPUNumber TransformConfig `mapstructure:"clean_cpu_number"`
+
+	// AverageDiskOperationTime appends the average disk operation time
+	// computed from the disk operation count and time metrics.
+	AverageDiskOperationTime TransformConfig `mapstructure:"average_disk_operation_time"`
+}
+
+// TransformConfig toggles a single transformation.
+type TransformConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// RemoveAttributesConfig configures the removal of data point attributes.
+type RemoveAttributesConfig struct {
+	TransformConfig `mapstructure:",squash"`
+
+	// Attributes is the list of data point attribute keys to remove.
+	Attributes []string `mapstructure:"attributes"`
+}
+
+// SplitReadWriteConfig configures the split of metrics with a direction
+// label into read and write metrics.
+type SplitReadWriteConfig struct {
+	TransformConfig `mapstructure:",squash"`
+
+	// Metrics is the list of metrics to split.
+	Metrics []string `mapstructure:"metrics"`
+
+	// ReadPrefix and WritePrefix are prepended to the last dot-separated
+	// segment of the metric name to name the read and write metrics, e.g.
+	// "system.disk.io" becomes "system.disk.read_io", which is how the Ops
+	// Agent names them.
+	ReadPrefix  string `mapstructure:"read_prefix"`
+	WritePrefix string `mapstructure:"write_prefix"`
+
+	// ReadSuffix and WriteSuffix are appended to the metric name to name the
+	// read and write metrics, after the prefixes, e.g. "system.disk.io"
+	// becomes "system.disk.io.read" with a ".read" suffix and no prefix.
+	ReadSuffix  string `mapstructure:"read_suffix"`
+	WriteSuffix string `mapstructure:"write_suffix"`
+}
+
+// readName and writeName return the names of the read and write metrics
+// split from the metric name.
+func (cfg *SplitReadWriteConfig) readName(name string) string {
+	return prefixLastSegment(name, cfg.ReadPrefix) + cfg.ReadSuffix
+}
+
+func (cfg *SplitReadWriteConfig) writeName(name string) string {
+	return prefixLastSegment(name, cfg.WritePrefix) + cfg.WriteSuffix
+}
+
+// UtilizationConfig configures the utilization metrics computed from usage
+// metrics.
+type UtilizationConfig struct {
+	TransformConfig `mapstructure:",squash"`
+
+	// Metrics is the list of usage metrics to compute utilizations for.
+	Metrics []string `mapstructure:"metrics"`
+
+	// Label is the label the usage is summed over to compute the total.
+	Label string `mapstructure:"label"`
+
+	// Rules derive utilization metrics with more control than Metrics.
+	Rules []UtilizationRule `mapstructure:"rules"`
+}
+
+// UtilizationRule derives a ratio metric from a source metric using the
+// formula:
+//
+// output{l1=v1,...} = scale * value{l1=v1,...} / sum(value{l1=vx,...}) over x=1..N
+type UtilizationRule struct {
+	// Metric is the name of the source metric.
+	Metric string `mapstructure:"metric"`
+
+	// SumOverLabel is the label the values are summed over to compute the
+	// total. Defaults to the Label of the enclosing UtilizationConfig.
+	SumOverLabel string `mapstructure:"sum_over_label"`
+
+	// OutputMetric is the name of the derived metric. Defaults to the source
+	// name with its last segment replaced by "utilization".
+	OutputMetric string `mapstructure:"output_metric"`
+
+	// OutputUnit is the unit of the derived metric. Defaults to the unit of
+	// the source metric.
+	OutputUnit string `mapstructure:"output_unit"`
+
+	// DeltaCumulative computes the ratio over the change in value since the
+	// previous batch, for cumulative inputs such as CPU time.
+	DeltaCumulative bool `mapstructure:"delta_cumulative"`
+
+	// Scale multiplies the ratio. Defaults to 100, i.e. a percentage.
+	Scale float64 `mapstructure:"scale"`
+}
+
+// Validate checks that the enabled transformations are consistently
+// configured.
+func (cfg *Config) Validate() error {
+	var errs []error
+	if cfg.SplitReadWrite.Enabled && cfg.SplitReadWrite.ReadPrefix == cfg.SplitReadWrite.WritePrefix && cfg.SplitReadWrite.ReadSuffix == cfg.SplitReadWrite.WriteSuffix {
+		errs = append(errs, errors.New("split_read_write: read_prefix and write_prefix, or read_suffix and write_suffix, must differ"))
+	}
+	if cfg.Utilization.Enabled {
+		if len(cfg.Utilization.Metrics) > 0 && cfg.Utilization.Label == "" {
+			errs = append(errs, errors.New("utilization: label must not be empty"))
+		}
+		// outputs holds where each resolved output metric name comes from, so
+		// that two utilizations are never appended under the same name.
+		outputs := make(map[string]string)
+		addOutput := func(source, output string) {
+			if previous, ok := outputs[output]; ok {
+				errs = append(errs, fmt.Errorf("utilization: %s: output metric %q is already produced by %s", source, output, previous))
+				return
+			}
+			outputs[output] = source
+		}
+		for i, name := range cfg.Utilization.Metrics {
+			addOutput(fmt.Sprintf("metrics[%d]", i), defaultUtilizationOutputMetric(name))
+		}
+		for i, rule := range cfg.Utilization.Rules {
+			if rule.Metric == "" {
+				errs = append(errs, fmt.Errorf("utilization: rules[%d]: metric must not be empty", i))
+			}
+			if rule.SumOverLabel == "" && cfg.Utilization.Label == "" {
+				errs = append(errs, fmt.Errorf("utilization: rules[%d]: sum_over_label must not be empty", i))
+			}
+			if rule.Scale < 0 {
+				errs = append(errs, fmt.Errorf("utilization: rules[%d]: scale must not be negative", i))
+			}
+			output := rule.OutputMetric
+			if output == "" {
+				output = defaultUtilizationOutputMetric(rule.Metric)
+			}
+			addOutput(fmt.Sprintf("rules[%d]", i), output)
+		}
+	}
+	return errors.Join(errs...)
 }
@@ -16,6 +16,7 @@ package agentmetricsprocessor
 
 import (
 	"path"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -40,4 +41,66 @@ func TestLoadConfig(t *testing.T) {
 	want.(*Config).BlankLabelMetrics = []string{"system.cpu.time"}
 
 	assert.Equal(t, want, p1)
+
+	p2 := cfg.Processors[component.NewIDWithName(componentType, "custom")]
+
+	want = factory.CreateDefaultConfig()
+	want.(*Config).ConvertNonMonotonicSums.Enabled = false
+	want.(*Config).RemoveAttributes.Attributes = []string{"version"}
+	want.(*Config).SplitReadWrite.Metrics = []string{"system.disk.io"}
+	want.(*Config).SplitReadWrite.ReadPrefix = "r_"
+	want.(*Config).SplitReadWrite.WritePrefix = "w_"
+	want.(*Config).Utilization.Metrics = []string{"container.memory.usage"}
+	want.(*Config).Utilization.Label = "type"
+	want.(*Config).CleanCPUNumber.Enabled = false
+
+	assert.Equal(t, want, p2)
+}
+
+func TestValidateConfig(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.SplitReadWrite.WritePrefix = cfg.SplitReadWrite.ReadPrefix
+	cfg.Utilization.Label = ""
+	assert.EqualError(t, cfg.Validate(), "split_read_write: read_prefix and write_prefix, or read_suffix and write_suffix, must differ\nutilization: label must not be empty")
+
+	cfg.SplitReadWrite.ReadSuffix = ".read"
+	cfg.SplitReadWrite.WriteSuffix = ".write"
+	cfg.Utilization.Enabled = false
+	assert.NoError(t, cfg.Validate())
+
+	cfg.SplitReadWrite.Enabled = false
+	cfg.Utilization.Enabled = false
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestValidateUtilizationRules(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Utilization.Label = ""
+	cfg.Utilization.Metrics = nil
+	cfg.Utilization.Rules = []UtilizationRule{
+		{Metric: "m1", SumOverLabel: "state", OutputMetric: "out"},
+		{SumOverLabel: "state", Scale: -1, OutputMetric: "out"},
+		{Metric: "m3"},
+	}
+	assert.EqualError(t, cfg.Validate(), strings.Join([]string{
+		"utilization: rules[1]: metric must not be empty",
+		"utilization: rules[1]: scale must not be negative",
+		`utilization: rules[1]: output metric "out" is already produced by rules[0]`,
+		"utilization: rules[2]: sum_over_label must not be empty",
+	}, "\n"))
+}
+
+func TestValidateUtilizationDefaultOutputMetrics(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Utilization.Metrics = []string{"system.memory.usage", "system.paging.usage"}
+	cfg.Utilization.Rules = []UtilizationRule{
+		// defaults to system.memory.utilization
+		{Metric: "system.memory.usage", SumOverLabel: "state"},
+		{Metric: "system.swap.usage", OutputMetric: "system.paging.utilization"},
+		{Metric: "system.memory.usage", OutputMetric: "system.memory.used_utilization"},
+	}
+	assert.EqualError(t, cfg.Validate(), strings.Join([]string{
+		`utilization: rules[0]: output metric "system.memory.utilization" is already produced by metrics[0]`,
+		`utilization: rules[1]: output metric "system.paging.utilization" is already produced by metrics[1]`,
+	}, "\n"))
 }
@@ -34,7 +34,27 @@ func NewFactory() processor.Factory {
 }
 
 func createDefaultConfig() component.Config {
-	return &Config{}
+	return &Config{
+		ConvertNonMonotonicSums: TransformConfig{Enabled: true},
+		RemoveAttributes: RemoveAttributesConfig{
+			TransformConfig: TransformConfig{Enabled: true},
+			Attributes:      []string{"service_version"},
+		},
+		CombineProcessMetrics: TransformConfig{Enabled: true},
+		SplitReadWrite: SplitReadWriteConfig{
+			TransformConfig: TransformConfig{Enabled: true},
+			Metrics:         []string{hostDiskBytes, processDiskBytes},
+			ReadPrefix:      "read_",
+			WritePrefix:     "write_",
+		},
+		Utilization: UtilizationConfig{
+			TransformConfig: TransformConfig{Enabled: true},
+			Metrics:         []string{cpuTime, memoryUsage, fileSystemUsage, swapUsage},
+			Label:           stateLabel,
+		},
+		CleanCPUNumber:           TransformConfig{Enabled: true},
+		AverageDiskOperationTime: TransformConfig{Enabled: true},
+	}
 }
 
 var processorCapabilities = consumer.Capabilities{MutatesData: true}
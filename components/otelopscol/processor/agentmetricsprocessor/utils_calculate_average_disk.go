@@ -25,6 +25,7 @@ const opTimeName = "system.disk.operation_time"
 
 func (mtp *agentMetricsProcessor) appendAverageDiskMetrics(rms pmetric.ResourceMetricsSlice) error {
 	for i := 0; i < rms.Len(); i++ {
+		var state *resourceState
 		ilms := rms.At(i).ScopeMetrics()
 		for j := 0; j < ilms.Len(); j++ {
 			// Collect the corresponding count and time so they can be divided.
@@ -40,6 +41,9 @@ func (mtp *agentMetricsProcessor) appendAverageDiskMetrics(rms pmetric.ResourceM
 					opTimeMetric = metric
 					fallthrough
 				case opName:
+					if state == nil {
+						state = mtp.resourceStateFor(rms.At(i).Resource())
+					}
 					ndps := metric.Sum().DataPoints()
 					for i := 0; i < ndps.Len(); i++ {
 						ndp := ndps.At(i)
@@ -51,7 +55,7 @@ func (mtp *agentMetricsProcessor) appendAverageDiskMetrics(rms pmetric.ResourceM
 
 						op, ok := newOp[key]
 						if !ok {
-							op = mtp.prevOp[key]
+							op = state.prevOp[key]
 						}
 						// Can't just save ndp because it is overwritten by OT.
 						ndp2 := pmetric.NewNumberDataPoint()
@@ -75,7 +79,7 @@ func (mtp *agentMetricsProcessor) appendAverageDiskMetrics(rms pmetric.ResourceM
 			// Generate a new metric from the operation count and time for each disk and direction.
 			ndps := pmetric.NewNumberDataPointSlice()
 			for key, new := range newOp {
-				prev, prevOk := mtp.prevOp[key]
+				prev, prevOk := state.prevOp[key]
 				t := new.time.DoubleValue()
 				ops := new.operations.IntValue()
 				if prevOk {
@@ -90,7 +94,7 @@ func (mtp *agentMetricsProcessor) appendAverageDiskMetrics(rms pmetric.ResourceM
 					}
 					ndp.SetDoubleValue(new.cumAvgTime)
 				}
-				mtp.prevOp[key] = new
+				state.prevOp[key] = new
 			}
 			if ndps.Len() > 0 {
 				averageTimeMetric := metrics.AppendEmpty()
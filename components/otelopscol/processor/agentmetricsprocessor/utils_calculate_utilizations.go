@@ -35,17 +35,68 @@ const (
 	swapUsage       = "system.paging.usage"
 )
 
-var metricsToComputeUtilizationFor = map[string]bool{
-	cpuTime:         true,
-	memoryUsage:     true,
-	fileSystemUsage: true,
-	swapUsage:       true,
+const stateLabel = "state"
+
+// defaultUtilizationScale converts ratios to percentages.
+const defaultUtilizationScale = 100
+
+// utilizationRule is a UtilizationRule with its defaults resolved.
+type utilizationRule struct {
+	metric          string
+	label           string
+	outputMetric    string
+	outputUnit      string
+	deltaCumulative bool
+	scale           float64
 }
 
-const stateLabel = "state"
+// defaultUtilizationOutputMetric returns the name of the utilization metric
+// of the source metric, with its last segment replaced by "utilization".
+func defaultUtilizationOutputMetric(metric string) string {
+	return metricPostfixRegex.ReplaceAllString(metric, "utilization")
+}
+
+// newUtilizationRules resolves the configured utilization metrics and rules,
+// indexed by source metric name.
+func newUtilizationRules(cfg UtilizationConfig) map[string][]utilizationRule {
+	rules := make(map[string][]utilizationRule)
+	for _, name := range cfg.Metrics {
+		rules[name] = append(rules[name], utilizationRule{
+			metric:       name,
+			label:        cfg.Label,
+			outputMetric: defaultUtilizationOutputMetric(name),
+			// cpu.time is cumulative, so we need to convert it to delta values
+			// before computing utilization of the deltas
+			deltaCumulative: name == cpuTime,
+			scale:           defaultUtilizationScale,
+		})
+	}
+	for _, r := range cfg.Rules {
+		rule := utilizationRule{
+			metric:          r.Metric,
+			label:           r.SumOverLabel,
+			outputMetric:    r.OutputMetric,
+			outputUnit:      r.OutputUnit,
+			deltaCumulative: r.DeltaCumulative,
+			scale:           r.Scale,
+		}
+		if rule.label == "" {
+			rule.label = cfg.Label
+		}
+		if rule.outputMetric == "" {
+			rule.outputMetric = defaultUtilizationOutputMetric(r.Metric)
+		}
+		if rule.scale == 0 {
+			rule.scale = defaultUtilizationScale
+		}
+		rules[r.Metric] = append(rules[r.Metric], rule)
+	}
+	return rules
+}
 
 func (mtp *agentMetricsProcessor) appendUtilizationMetrics(rms pmetric.ResourceMetricsSlice) error {
 	for i := 0; i < rms.Len(); i++ {
+		var state *resourceState
 		ilms := rms.At(i).ScopeMetrics()
 		for j := 0; j < ilms.Len(); j++ {
 			metrics := ilms.At(j).Metrics()
@@ -53,18 +104,19 @@ func (mtp *agentMetricsProcessor) appendUtilizationMetrics(rms pmetric.ResourceM
 				metric := metrics.At(k)
 
 				// ignore all metrics except the ones we want to compute utilizations for
-				metricName := metric.Name()
-				if !metricsToComputeUtilizationFor[metricName] {
-					continue
-				}
-
-				// calculate new utilization metric and append it
-				utilizationMetric, err := mtp.calculateUtilizationMetric(metric)
-				if err != nil {
-					return err
+				for _, rule := range mtp.utilizationRules[metric.Name()] {
+					if state == nil {
+						state = mtp.resourceStateFor(rms.At(i).Resource())
+					}
+
+					// calculate new utilization metric and append it
+					utilizationMetric, err := calculateUtilizationMetric(state, metric, rule)
+					if err != nil {
+						return err
+					}
+
+					utilizationMetric.CopyTo(metrics.AppendEmpty())
 				}
-
-				utilizationMetric.CopyTo(metrics.AppendEmpty())
 			}
 		}
 	}
@@ -72,57 +124,57 @@ func (mtp *agentMetricsProcessor) appendUtilizationMetrics(rms pmetric.ResourceM
 	return nil
 }
 
-func (mtp *agentMetricsProcessor) calculateUtilizationMetric(usageMetric pmetric.Metric) (pmetric.Metric, error) {
+func calculateUtilizationMetric(state *resourceState, usageMetric pmetric.Metric, rule utilizationRule) (pmetric.Metric, error) {
 	utilizationMetric := pmetric.NewMetric()
 	usageMetric.CopyTo(utilizationMetric)
 
-	utilizationMetric.SetName(metricPostfixRegex.ReplaceAllString(usageMetric.Name(), "utilization"))
+	utilizationMetric.SetName(rule.outputMetric)
+	if rule.outputUnit != "" {
+		utilizationMetric.SetUnit(rule.outputUnit)
+	}
 	utilizationMetric.SetEmptyGauge()
 	utilizationMetric.Gauge()
 
 	metric := usageMetric
 
-	// for "cpu.time", we need to convert cumulative values to delta values before
-	// computing utilization of the deltas
-	isCPUTime := usageMetric.Name() == cpuTime
-	if isCPUTime {
+	// for cumulative inputs, we need to convert cumulative values to delta values
+	// before computing utilization of the deltas
+	if rule.deltaCumulative {
 		delta := pmetric.NewMetric()
 		usageMetric.CopyTo(delta)
-		mtp.convertPrevCPUTimeToDelta(delta)
+		state.convertPrevCumulativeToDelta(rule.outputMetric, delta)
 		metric = delta
 	}
 
 	switch t := metric.Type(); t {
 	case pmetric.MetricTypeSum, pmetric.MetricTypeGauge:
-		if err := calculateUtilizationFromNumberDataPoints(metric, utilizationMetric); err != nil {
+		if err := calculateUtilizationFromNumberDataPoints(metric, utilizationMetric, rule.label, rule.scale); err != nil {
 			return pmetric.NewMetric(), err
 		}
 	default:
 		return pmetric.NewMetric(), fmt.Errorf("unsupported metric data type: %v", t)
 	}
 
-	// persist the values of "cpu.time" so we can compute deltas on the next cycle
-	if isCPUTime {
-		mtp.setPrevCPUTimes(usageMetric)
+	// persist the cumulative values so we can compute deltas on the next cycle
+	if rule.deltaCumulative {
+		state.setPrevCumulativeValues(rule.outputMetric, usageMetric)
 	}
 
 	return utilizationMetric, nil
 }
 
-// convertPrevCPUTimeToDelta converts the cpu.time values to delta values using the
-// values persisted in the previous snapshot
-func (mtp *agentMetricsProcessor) convertPrevCPUTimeToDelta(cpuTimeMetric pmetric.Metric) {
-	mtp.mutex.Lock()
-	defer mtp.mutex.Unlock()
-
-	ndps := cpuTimeMetric.Sum().DataPoints()
+// convertPrevCumulativeToDelta converts the cumulative values to delta values
+// using the values persisted in the previous snapshot for the same rule
+func (state *resourceState) convertPrevCumulativeToDelta(ruleKey string, cumulativeMetric pmetric.Metric) {
+	ndps := numberDataPoints(cumulativeMetric)
+	prevValues := state.prevCumulativeValues[ruleKey]
 	out := pmetric.NewNumberDataPointSlice()
 	for i := 0; i < ndps.Len(); i++ {
 		ndp := ndps.At(i)
 
-		// if we have no previous value for this cpu/state combination,
+		// if we have no previous value for this label combination,
 		// remove the data point as we cannot calculate a utilization
-		prevValue, ok := mtp.prevCPUTimeValues[labelsAsKey(ndp.Attributes())]
+		prevValue, ok := prevValues[labelsAsKey(ndp.Attributes())]
 		if !ok {
 			continue
 		}
@@ -130,19 +182,16 @@ func (mtp *agentMetricsProcessor) convertPrevCPUTimeToDelta(cpuTimeMetric pmetri
 		// delta value = current cumulative value - previous cumulative value
 		ndp2 := out.AppendEmpty()
 		ndp.CopyTo(ndp2)
-		ndp2.SetDoubleValue(ndp.DoubleValue() - prevValue)
+		ndp2.SetDoubleValue(numberValue(ndp) - prevValue)
 	}
 	// overwrite previous slice
 	out.CopyTo(ndps)
 }
 
-// setPrevCPUTimes persists the cpu.time cumulative values as a map so they can
+// setPrevCumulativeValues persists the cumulative values as a map so they can
 // be used to calculate deltas in the next snapshot
-func (mtp *agentMetricsProcessor) setPrevCPUTimes(cpuTimeMetric pmetric.Metric) {
-	mtp.mutex.Lock()
-	defer mtp.mutex.Unlock()
-
-	mtp.prevCPUTimeValues = doubleDataPointsToMap(cpuTimeMetric)
+func (state *resourceState) setPrevCumulativeValues(ruleKey string, cumulativeMetric pmetric.Metric) {
+	state.prevCumulativeValues[ruleKey] = numberDataPointsToMap(cumulativeMetric)
 }
 
 type numberPoints struct {
@@ -150,21 +199,15 @@ type numberPoints struct {
 	sum float64
 }
 
-func calculateUtilizationFromNumberDataPoints(metric, utilizationMetric pmetric.Metric) error {
-	var ndps pmetric.NumberDataPointSlice
-	switch t := metric.Type(); t {
-	case pmetric.MetricTypeSum:
-		ndps = metric.Sum().DataPoints()
-	case pmetric.MetricTypeGauge:
-		ndps = metric.Gauge().DataPoints()
-	}
+func calculateUtilizationFromNumberDataPoints(metric, utilizationMetric pmetric.Metric, label string, scale float64) error {
+	ndps := numberDataPoints(metric)
 
 	pointCount := ndps.Len()
 	groupedPoints := make(map[string]*numberPoints, pointCount) // overallocate to ensure no resizes are required
 	for i := 0; i < pointCount; i++ {
 		ndp := ndps.At(i)
 
-		key, err := otherLabelsAsKey(ndp.Attributes(), stateLabel)
+		key, err := otherLabelsAsKey(ndp.Attributes(), label)
 		if err != nil {
 			return fmt.Errorf("metric %v: %w", metric.Name(), err)
 		}
@@ -175,12 +218,7 @@ func calculateUtilizationFromNumberDataPoints(metric, utilizationMetric pmetric.
 			groupedPoints[key] = points
 		}
 
-		switch ndp.ValueType() {
-		case pmetric.NumberDataPointValueTypeInt:
-			points.sum += float64(ndp.IntValue())
-		case pmetric.NumberDataPointValueTypeDouble:
-			points.sum += ndp.DoubleValue()
-		}
+		points.sum += numberValue(ndp)
 		points.pts = append(points.pts, ndp)
 	}
 
@@ -194,14 +232,7 @@ func calculateUtilizationFromNumberDataPoints(metric, utilizationMetric pmetric.
 			point.Attributes().CopyTo(ndp.Attributes())
 			ndp.SetStartTimestamp(point.StartTimestamp())
 			ndp.SetTimestamp(point.Timestamp())
-			var num float64
-			switch point.ValueType() {
-			case pmetric.NumberDataPointValueTypeInt:
-				num = float64(point.IntValue())
-			case pmetric.NumberDataPointValueTypeDouble:
-				num = point.DoubleValue()
-			}
-			ndp.SetDoubleValue(num / points.sum * 100)
+			ndp.SetDoubleValue(numberValue(point) / points.sum * scale)
 		}
 	}
 	ndps.CopyTo(utilizationMetric.Gauge().DataPoints())
@@ -209,15 +240,36 @@ func calculateUtilizationFromNumberDataPoints(metric, utilizationMetric pmetric.
 	return nil
 }
 
-// doubleDataPointsToMap converts the double data points in the provided metric
+// numberDataPoints returns the data points of a sum or gauge metric.
+func numberDataPoints(metric pmetric.Metric) pmetric.NumberDataPointSlice {
+	switch metric.Type() {
+	case pmetric.MetricTypeSum:
+		return metric.Sum().DataPoints()
+	case pmetric.MetricTypeGauge:
+		return metric.Gauge().DataPoints()
+	}
+	return pmetric.NewNumberDataPointSlice()
+}
+
+// numberValue returns the value of the data point as a float64.
+func numberValue(ndp pmetric.NumberDataPoint) float64 {
+	switch ndp.ValueType() {
+	case pmetric.NumberDataPointValueTypeInt:
+		return float64(ndp.IntValue())
+	case pmetric.NumberDataPointValueTypeDouble:
+		return ndp.DoubleValue()
+	}
+	return 0
+}
+
+// numberDataPointsToMap converts the number data points in the provided metric
 // to a map of labels to values
-func doubleDataPointsToMap(metric pmetric.Metric) map[string]float64 {
-	ddps := metric.Sum().DataPoints()
-	labelToValuesMap := make(map[string]float64, ddps.Len())
-	for i := 0; i < ddps.Len(); i++ {
-		ddp := ddps.At(i)
-		key, _ := otherLabelsAsKey(ddp.Attributes())
-		labelToValuesMap[key] = ddp.DoubleValue()
+func numberDataPointsToMap(metric pmetric.Metric) map[string]float64 {
+	ndps := numberDataPoints(metric)
+	labelToValuesMap := make(map[string]float64, ndps.Len())
+	for i := 0; i < ndps.Len(); i++ {
+		ndp := ndps.At(i)
+		labelToValuesMap[labelsAsKey(ndp.Attributes())] = numberValue(ndp)
 	}
 	return labelToValuesMap
 }
@@ -15,6 +15,7 @@
 package agentmetricsprocessor
 
 import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 )
 
@@ -127,3 +128,180 @@ func generateUtilizationPrevCPUTimeValuesExpected() map[string]float64 {
 		"label1=value2;state=idle": 404,
 	}
 }
+
+func generateCustomUtilizationMetricsInput() pmetric.Metrics {
+	input := pmetric.NewMetrics()
+
+	rmb := newResourceMetricsBuilder()
+	b := rmb.addResourceMetrics(nil)
+
+	mb1 := b.addMetric("container.memory.usage", pmetric.MetricTypeGauge, false)
+	mb1.addIntDataPoint(1, map[string]string{"container": "a", "type": "rss"})
+	mb1.addIntDataPoint(3, map[string]string{"container": "a", "type": "cache"})
+	mb1.addIntDataPoint(2, map[string]string{"container": "b", "type": "rss"})
+	mb1.addIntDataPoint(2, map[string]string{"container": "b", "type": "cache"})
+
+	mb2 := b.addMetric("system.memory.usage", pmetric.MetricTypeGauge, false)
+	mb2.addIntDataPoint(1, map[string]string{"state": "used"})
+
+	rmb.Build().CopyTo(input.ResourceMetrics())
+	return input
+}
+
+func generateCustomUtilizationMetricsExpected() pmetric.Metrics {
+	expected := pmetric.NewMetrics()
+
+	rmb := newResourceMetricsBuilder()
+	b := rmb.addResourceMetrics(nil)
+
+	mb1 := b.addMetric("container.memory.usage", pmetric.MetricTypeGauge, false)
+	mb1.addIntDataPoint(1, map[string]string{"container": "a", "type": "rss"})
+	mb1.addIntDataPoint(3, map[string]string{"container": "a", "type": "cache"})
+	mb1.addIntDataPoint(2, map[string]string{"container": "b", "type": "rss"})
+	mb1.addIntDataPoint(2, map[string]string{"container": "b", "type": "cache"})
+
+	mb2 := b.addMetric("system.memory.usage", pmetric.MetricTypeGauge, false)
+	mb2.addIntDataPoint(1, map[string]string{"state": "used"})
+
+	mb3 := b.addMetric("container.memory.utilization", pmetric.MetricTypeGauge, false)
+	mb3.addDoubleDataPoint(1.0/(1.0+3.0)*100, map[string]string{"container": "a", "type": "rss"})
+	mb3.addDoubleDataPoint(3.0/(1.0+3.0)*100, map[string]string{"container": "a", "type": "cache"})
+	mb3.addDoubleDataPoint(2.0/(2.0+2.0)*100, map[string]string{"container": "b", "type": "rss"})
+	mb3.addDoubleDataPoint(2.0/(2.0+2.0)*100, map[string]string{"container": "b", "type": "cache"})
+
+	rmb.Build().CopyTo(expected.ResourceMetrics())
+	return expected
+}
+
+func generateUtilizationRulesInput() pmetric.Metrics {
+	input := pmetric.NewMetrics()
+
+	rmb := newResourceMetricsBuilder()
+	b := rmb.addResourceMetrics(nil)
+
+	mb1 := b.addMetric("jvm.memory.heap.used", pmetric.MetricTypeGauge, false)
+	mb1.metric.SetUnit("By")
+	mb1.addIntDataPoint(30, map[string]string{"pool": "eden"})
+	mb1.addIntDataPoint(70, map[string]string{"pool": "old"})
+
+	mb2 := b.addMetric("gpu.memory.usage", pmetric.MetricTypeGauge, false)
+	mb2.addDoubleDataPoint(1, map[string]string{"gpu": "0", "state": "used"})
+	mb2.addDoubleDataPoint(3, map[string]string{"gpu": "0", "state": "free"})
+
+	rmb.Build().CopyTo(input.ResourceMetrics())
+	return input
+}
+
+func generateUtilizationRulesExpected() pmetric.Metrics {
+	expected := pmetric.NewMetrics()
+
+	rmb := newResourceMetricsBuilder()
+	b := rmb.addResourceMetrics(nil)
+
+	mb1 := b.addMetric("jvm.memory.heap.used", pmetric.MetricTypeGauge, false)
+	mb1.metric.SetUnit("By")
+	mb1.addIntDataPoint(30, map[string]string{"pool": "eden"})
+	mb1.addIntDataPoint(70, map[string]string{"pool": "old"})
+
+	mb2 := b.addMetric("gpu.memory.usage", pmetric.MetricTypeGauge, false)
+	mb2.addDoubleDataPoint(1, map[string]string{"gpu": "0", "state": "used"})
+	mb2.addDoubleDataPoint(3, map[string]string{"gpu": "0", "state": "free"})
+
+	mb3 := b.addMetric("jvm.memory.heap.pool_fraction", pmetric.MetricTypeGauge, false)
+	mb3.metric.SetUnit("1")
+	mb3.addDoubleDataPoint(0.3, map[string]string{"pool": "eden"})
+	mb3.addDoubleDataPoint(0.7, map[string]string{"pool": "old"})
+
+	mb4 := b.addMetric("gpu.memory.utilization", pmetric.MetricTypeGauge, false)
+	mb4.addDoubleDataPoint(25, map[string]string{"gpu": "0", "state": "used"})
+	mb4.addDoubleDataPoint(75, map[string]string{"gpu": "0", "state": "free"})
+
+	rmb.Build().CopyTo(expected.ResourceMetrics())
+	return expected
+}
+
+func generateDeltaCumulativeInput(user, idle int64) pmetric.Metrics {
+	input := pmetric.NewMetrics()
+
+	rmb := newResourceMetricsBuilder()
+	b := rmb.addResourceMetrics(nil)
+
+	mb1 := b.addMetric("container.cpu.time", pmetric.MetricTypeSum, true)
+	mb1.addIntDataPoint(user, map[string]string{"state": "user"})
+	mb1.addIntDataPoint(idle, map[string]string{"state": "idle"})
+
+	rmb.Build().CopyTo(input.ResourceMetrics())
+	return input
+}
+
+func generateDeltaCumulativeExpected() pmetric.Metrics {
+	expected := pmetric.NewMetrics()
+
+	rmb := newResourceMetricsBuilder()
+	b := rmb.addResourceMetrics(nil)
+
+	mb1 := b.addMetric("container.cpu.time", pmetric.MetricTypeSum, true)
+	mb1.addIntDataPoint(13, map[string]string{"state": "user"})
+	mb1.addIntDataPoint(39, map[string]string{"state": "idle"})
+
+	mb2 := b.addMetric("container.cpu.utilization", pmetric.MetricTypeGauge, false)
+	mb2.addDoubleDataPoint(3.0/(3.0+9.0)*100, map[string]string{"state": "user"})
+	mb2.addDoubleDataPoint(9.0/(3.0+9.0)*100, map[string]string{"state": "idle"})
+
+	rmb.Build().CopyTo(expected.ResourceMetrics())
+	return expected
+}
+
+// generateInterleavedResourcesInput generates cpu.time and disk metrics for two
+// hosts, interleaving the ResourceMetrics of both hosts in one batch.
+func generateInterleavedResourcesInput(seconds int64, userA, userB, ioTimeA float64) pmetric.Metrics {
+	input := pmetric.NewMetrics()
+
+	rmb := newResourceMetricsBuilder()
+	for _, host := range []struct {
+		name      string
+		user      float64
+		ioTime    float64
+		ioOpsMult int64
+	}{
+		{"host-a", userA, ioTimeA, 1},
+		{"host-b", userB, 2 * ioTimeA, 4},
+	} {
+		b := rmb.addResourceMetrics(map[string]pcommon.Value{"host.name": pcommon.NewValueStr(host.name)})
+		b.timestamp = pcommon.Timestamp(seconds * 1e9)
+		b.addMetric("system.cpu.time", pmetric.MetricTypeSum, true).
+			addDoubleDataPoint(host.user, map[string]string{"cpu": "cpu0", "state": "user"}).
+			addDoubleDataPoint(host.user*3, map[string]string{"cpu": "cpu0", "state": "idle"})
+		b.addMetric("system.disk.operation_time", pmetric.MetricTypeSum, true).
+			addDoubleDataPoint(host.ioTime, map[string]string{"device": "sda", "direction": "read"})
+		b.addMetric("system.disk.operations", pmetric.MetricTypeSum, true).
+			addIntDataPoint(10*(seconds+1)*host.ioOpsMult, map[string]string{"device": "sda", "direction": "read"})
+	}
+
+	rmb.Build().CopyTo(input.ResourceMetrics())
+	return input
+}
+
+func generateInterleavedResourcesExpected() pmetric.Metrics {
+	expected := generateInterleavedResourcesInput(1, 102, 204, 1040)
+	_ = cleanCPUNumber(expected.ResourceMetrics())
+
+	for i, host := range []struct {
+		userDelta float64
+		avgTime   float64
+	}{
+		// host-a: 40 time units over 10 operations in one second
+		{2, 40.0 / 10},
+		// host-b: 80 time units over 40 operations in one second
+		{4, 80.0 / 40},
+	} {
+		metrics := expected.ResourceMetrics().At(i).ScopeMetrics().At(0).Metrics()
+		b := metricsBuilder{metrics: metrics, timestamp: pcommon.Timestamp(1e9)}
+		b.addMetric("system.cpu.utilization", pmetric.MetricTypeGauge, false).
+			addDoubleDataPoint(host.userDelta/(host.userDelta*4)*100, map[string]string{"cpu": "0", "state": "user"}).
+			addDoubleDataPoint(host.userDelta*3/(host.userDelta*4)*100, map[string]string{"cpu": "0", "state": "idle"})
+		b.addMetric("system.disk.average_operation_time", pmetric.MetricTypeSum, true).
+			addDoubleDataPoint(host.avgTime, map[string]string{"device": "sda", "direction": "read"})
+	}
+	return expected
+}
@@ -31,7 +31,7 @@ import (
 //
 //    ResourceMetrics         ResourceMetrics               ResourceMetrics
 // +-------------------+ +-----------------------+     +-----------------------+
-// |  Resource: Empty  | |  Resource: Process 1  |     |  Resource: Process X  |
+// |  Resource: Host   | | Resource: Host + P1   |     | Resource: Host + PX   |
 // +-------+---+-------+ +---------+---+---------+ ... +---------+---+---------+
 // |Metric1|...|MetricN| |MetricN+1|...|MetricN+M|     |MetricN+1|...|MetricN+M|
 // +-------+---+-------+ +---------+---+---------+     +---------+---+---------+
@@ -40,71 +40,125 @@ import (
 //
 //                             ResourceMetrics
 // +---------------------------------------------------------------------+
-// |                           Resource: Empty                           |
+// |                           Resource: Host                            |
 // +-------+---+-------+----------------------+---+----------------------+
 // |Metric1|...|MetricN|MetricN+1{P1, ..., PX}|...|MetricN+M{P1, ..., PX}|
 // +-------+---+-------+----------------------+---+----------------------+
 //
-// Assumptions:
-// * There is at most one resource metrics slice without process resource info (will raise error if not)
-// * There is no other resource info supplied that needs to be retained (info may be silently lost if it exists)
+// Process resources are grouped by their non-process attributes ("Host" above,
+// e.g. host, cloud or k8s pod attributes), and the process metrics of each
+// group are merged into the ResourceMetrics with exactly those attributes. If
+// there is no such ResourceMetrics, one is created with the non-process
+// attributes, so no resource information other than the process attributes is
+// lost. Multiple hosts in one batch are combined independently.
 
 func combineProcessMetrics(rms pmetric.ResourceMetricsSlice) error {
-	// create collection of combined process metrics, disregarding any ResourceMetrics
-	// with no process resource attributes as "otherMetrics"
-	processMetrics, otherMetrics, err := createProcessMetrics(rms)
-	if err != nil {
-		return err
-	}
-
-	// if non-process specific metrics were supplied, initialize the result
-	// with those metrics
-	resultMetrics := otherMetrics
-
-	// append all of the process metrics
-	metrics := resultMetrics.At(0).ScopeMetrics().At(0).Metrics()
-	for _, metric := range processMetrics {
-		metric.Metric.CopyTo(metrics.AppendEmpty())
+	// convert the process attributes of every process resource to labels
+	// before modifying anything, so that rms is left untouched on error
+	processLabels := make(map[int]pcommon.Map)
+	for i := 0; i < rms.Len(); i++ {
+		resource := rms.At(i).Resource()
+		if !includesProcessAttributes(resource) {
+			continue
+		}
+		labels := pcommon.NewMap()
+		if err := appendAttributesToLabels(labels, resource.Attributes()); err != nil {
+			return err
+		}
+		processLabels[i] = labels
 	}
 
-	resultMetrics.CopyTo(rms)
-	return nil
-}
-
-func createProcessMetrics(rms pmetric.ResourceMetricsSlice) (processMetrics convertedMetrics, otherMetrics pmetric.ResourceMetricsSlice, err error) {
-	processMetrics = convertedMetrics{}
-	otherMetrics = pmetric.NewResourceMetricsSlice()
+	resultMetrics := pmetric.NewResourceMetricsSlice()
+	// index of the ResourceMetrics in resultMetrics for each non-process resource
+	hosts := make(map[string]int)
+	// combined process metrics for each non-process resource, in order of appearance
+	var groups []*processMetricsGroup
+	groupsByKey := make(map[string]*processMetricsGroup)
 
 	for i := 0; i < rms.Len(); i++ {
 		rm := rms.At(i)
 		resource := rm.Resource()
 
 		// if these ResourceMetrics do not contain process resource attributes,
-		// these must be the "other" non-process metrics
+		// these must be "other" non-process metrics
 		if !includesProcessAttributes(resource) {
-			rm.CopyTo(otherMetrics.AppendEmpty())
+			key := labelsAsKey(resource.Attributes())
+			if _, ok := hosts[key]; !ok {
+				hosts[key] = resultMetrics.Len()
+			}
+			rm.MoveTo(resultMetrics.AppendEmpty())
 			continue
 		}
 
+		key := nonProcessAttributesAsKey(resource)
+		group, ok := groupsByKey[key]
+		if !ok {
+			group = &processMetricsGroup{key: key, resource: resource, metrics: convertedMetrics{}}
+			groupsByKey[key] = group
+			groups = append(groups, group)
+		}
+
 		// combine all metrics into the process metrics map by appending
 		// the data points
 		ilms := rm.ScopeMetrics()
 		for j := 0; j < ilms.Len(); j++ {
 			metrics := ilms.At(j).Metrics()
 			for k := 0; k < metrics.Len(); k++ {
-				err = processMetrics.append(metrics.At(k), resource)
-				if err != nil {
-					return
-				}
+				group.metrics.append(metrics.At(k), processLabels[i])
 			}
 		}
 	}
 
-	return
+	for _, group := range groups {
+		var host pmetric.ResourceMetrics
+		if idx, ok := hosts[group.key]; ok {
+			host = resultMetrics.At(idx)
+		} else {
+			// there are no non-process metrics for this resource, so create a
+			// resource with the non-process attributes of the process resource
+			host = resultMetrics.AppendEmpty()
+			group.resource.Attributes().CopyTo(host.Resource().Attributes())
+			host.Resource().Attributes().RemoveIf(func(k string, _ pcommon.Value) bool {
+				return strings.HasPrefix(k, processAttributePrefix)
+			})
+			hosts[group.key] = resultMetrics.Len() - 1
+		}
+		if host.ScopeMetrics().Len() == 0 {
+			host.ScopeMetrics().AppendEmpty()
+		}
+
+		// append all of the process metrics
+		metrics := host.ScopeMetrics().At(0).Metrics()
+		for _, name := range group.metrics.names {
+			group.metrics.byName[name].Metric.MoveTo(metrics.AppendEmpty())
+		}
+	}
+
+	resultMetrics.CopyTo(rms)
+	return nil
+}
+
+// processMetricsGroup holds the combined process metrics of all process
+// resources sharing the same non-process attributes.
+type processMetricsGroup struct {
+	key      string
+	resource pcommon.Resource
+	metrics  convertedMetrics
 }
 
 const processAttributePrefix = "process."
 
+// nonProcessAttributesAsKey returns a key representing the attributes of the
+// resource that do not have a "process." prefix.
+func nonProcessAttributesAsKey(resource pcommon.Resource) string {
+	attrs := pcommon.NewMap()
+	resource.Attributes().CopyTo(attrs)
+	attrs.RemoveIf(func(k string, _ pcommon.Value) bool {
+		return strings.HasPrefix(k, processAttributePrefix)
+	})
+	return labelsAsKey(attrs)
+}
+
 // includesProcessAttributes returns true if the resource includes
 // any attributes with a "process." prefix
 func includesProcessAttributes(resource pcommon.Resource) bool {
@@ -121,30 +175,37 @@ func includesProcessAttributes(resource pcommon.Resource) bool {
 
 // convertedMetrics stores a map of metric names to converted metrics
 // where convertedMetrics have process information stored as labels.
-type convertedMetrics map[string]*convertedMetric
+type convertedMetrics struct {
+	byName map[string]*convertedMetric
+	// names preserves the order in which metrics were first seen
+	names []string
+}
 
 // append appends the data points associated with the provided metric to the
 // associated converted metric (creating this metric if it doesn't exist yet),
-// and appends the provided resource attributes as labels against these
-// data points.
-func (cms convertedMetrics) append(metric pmetric.Metric, resource pcommon.Resource) error {
+// and appends the provided labels against these data points.
+func (cms *convertedMetrics) append(metric pmetric.Metric, labels pcommon.Map) {
 	cm := cms.getOrCreate(metric)
-	return cm.append(metric, resource)
+	cm.append(metric, labels)
 }
 
 // getOrCreate returns the converted metric associated with a given metric
 // name (creating this metric if it doesn't exist yet).
-func (cms convertedMetrics) getOrCreate(metric pmetric.Metric) *convertedMetric {
+func (cms *convertedMetrics) getOrCreate(metric pmetric.Metric) *convertedMetric {
 	// if we have an existing converted metric, return this
 	metricName := metric.Name()
-	if cm, ok := cms[metricName]; ok {
+	if cm, ok := cms.byName[metricName]; ok {
 		return cm
 	}
 
 	// if there is no existing converted metric, create one using the
 	// descriptor info from the provided metric
+	if cms.byName == nil {
+		cms.byName = make(map[string]*convertedMetric)
+	}
 	cm := &convertedMetric{newMetric(metric)}
-	cms[metricName] = cm
+	cms.byName[metricName] = cm
+	cms.names = append(cms.names, metricName)
 	return cm
 }
 
@@ -154,30 +215,25 @@ type convertedMetric struct {
 }
 
 // append appends the data points associated with the provided metric to the
-// converted metric and appends the provided resource attributes as labels
-// against these data points.
-func (cm convertedMetric) append(metric pmetric.Metric, resource pcommon.Resource) error {
-	var err error
-
+// converted metric and appends the provided labels against these data points.
+func (cm convertedMetric) append(metric pmetric.Metric, labels pcommon.Map) {
 	switch t := metric.Type(); t {
 	case pmetric.MetricTypeSum:
-		err = appendNumberDataSlice(metric.Sum().DataPoints(), cm.Sum().DataPoints(), resource)
+		appendNumberDataSlice(metric.Sum().DataPoints(), cm.Sum().DataPoints(), labels)
 	case pmetric.MetricTypeGauge:
-		err = appendNumberDataSlice(metric.Gauge().DataPoints(), cm.Gauge().DataPoints(), resource)
+		appendNumberDataSlice(metric.Gauge().DataPoints(), cm.Gauge().DataPoints(), labels)
 	}
-
-	return err
 }
 
-func appendNumberDataSlice(ndps, converted pmetric.NumberDataPointSlice, resource pcommon.Resource) error {
+func appendNumberDataSlice(ndps, converted pmetric.NumberDataPointSlice, labels pcommon.Map) {
 	for i := 0; i < ndps.Len(); i++ {
-		err := appendAttributesToLabels(ndps.At(i).Attributes(), resource.Attributes())
-		if err != nil {
-			return err
-		}
+		attrs := ndps.At(i).Attributes()
+		labels.Range(func(k string, v pcommon.Value) bool {
+			attrs.PutStr(k, v.Str())
+			return true
+		})
 	}
 	ndps.MoveAndAppendTo(converted)
-	return nil
 }
 
 // appendAttributesToLabels appends the provided attributes to the provided labels map.
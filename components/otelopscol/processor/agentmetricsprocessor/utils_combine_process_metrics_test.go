@@ -97,3 +97,60 @@ func generateProcessResourceMetricsExpected() pmetric.Metrics {
 	rmb.Build().CopyTo(expected.ResourceMetrics())
 	return expected
 }
+
+func generateMultiHostProcessResourceMetricsInput() pmetric.Metrics {
+	input := pmetric.NewMetrics()
+
+	hostA := map[string]pcommon.Value{
+		"host.name":      pcommon.NewValueStr("host-a"),
+		"cloud.provider": pcommon.NewValueStr("gcp"),
+	}
+	hostB := map[string]pcommon.Value{
+		"host.name":      pcommon.NewValueStr("host-b"),
+		"cloud.provider": pcommon.NewValueStr("gcp"),
+	}
+	withProcess := func(host map[string]pcommon.Value, pid int64) map[string]pcommon.Value {
+		attrs := map[string]pcommon.Value{
+			"process.pid":             pcommon.NewValueInt(pid),
+			"process.executable.name": pcommon.NewValueStr("process"),
+		}
+		for k, v := range host {
+			attrs[k] = v
+		}
+		return attrs
+	}
+
+	rmb := newResourceMetricsBuilder()
+	rmb.addResourceMetrics(hostA).addMetric("m1", pmetric.MetricTypeSum, true).addIntDataPoint(1, map[string]string{})
+	rmb.addResourceMetrics(withProcess(hostB, 3)).addMetric("m3", pmetric.MetricTypeSum, true).addIntDataPoint(3, map[string]string{})
+	rmb.addResourceMetrics(withProcess(hostA, 1)).addMetric("m3", pmetric.MetricTypeSum, true).addIntDataPoint(1, map[string]string{})
+	rmb.addResourceMetrics(withProcess(hostA, 2)).addMetric("m3", pmetric.MetricTypeSum, true).addIntDataPoint(2, map[string]string{})
+
+	rmb.Build().CopyTo(input.ResourceMetrics())
+	return input
+}
+
+func generateMultiHostProcessResourceMetricsExpected() pmetric.Metrics {
+	expected := pmetric.NewMetrics()
+
+	rmb := newResourceMetricsBuilder()
+	b1 := rmb.addResourceMetrics(map[string]pcommon.Value{
+		"host.name":      pcommon.NewValueStr("host-a"),
+		"cloud.provider": pcommon.NewValueStr("gcp"),
+	})
+	b1.addMetric("m1", pmetric.MetricTypeSum, true).addIntDataPoint(1, map[string]string{})
+	b1.addMetric("m3", pmetric.MetricTypeSum, true).
+		addIntDataPoint(1, map[string]string{"pid": "1", "command": "process"}).
+		addIntDataPoint(2, map[string]string{"pid": "2", "command": "process"})
+
+	// host-b has no non-process metrics, so its resource is created from the
+	// non-process attributes of its process resources
+	b2 := rmb.addResourceMetrics(map[string]pcommon.Value{
+		"host.name":      pcommon.NewValueStr("host-b"),
+		"cloud.provider": pcommon.NewValueStr("gcp"),
+	})
+	b2.addMetric("m3", pmetric.MetricTypeSum, true).addIntDataPoint(3, map[string]string{"pid": "3", "command": "process"})
+
+	rmb.Build().CopyTo(expected.ResourceMetrics())
+	return expected
+}
@@ -18,7 +18,9 @@ import (
 	"go.opentelemetry.io/collector/pdata/pmetric"
 )
 
-func removeVersionAttribute(rms pmetric.ResourceMetricsSlice) {
+// removeAttributes removes the provided attribute keys from the data points
+// of all metrics.
+func removeAttributes(rms pmetric.ResourceMetricsSlice, keys []string) {
 	for i := 0; i < rms.Len(); i++ {
 		ilms := rms.At(i).ScopeMetrics()
 		for j := 0; j < ilms.Len(); j++ {
@@ -36,7 +38,9 @@ func removeVersionAttribute(rms pmetric.ResourceMetricsSlice) {
 
 				for l := 0; l < dps.Len(); l++ {
 					dp := dps.At(l)
-					dp.Attributes().Remove("service_version")
+					for _, key := range keys {
+						dp.Attributes().Remove(key)
+					}
 				}
 			}
 		}
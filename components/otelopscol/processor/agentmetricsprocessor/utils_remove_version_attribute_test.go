@@ -81,3 +81,19 @@ func generateMultiAttrVersionExpected() pmetric.Metrics {
 	rmb.Build().CopyTo(input.ResourceMetrics())
 	return input
 }
+
+func generateRemoveCustomAttributeExpected() pmetric.Metrics {
+	input := pmetric.NewMetrics()
+
+	rmb := newResourceMetricsBuilder()
+	b := rmb.addResourceMetrics(nil)
+
+	mb1 := b.addMetric("m1", pmetric.MetricTypeSum, true)
+	mb1.addIntDataPoint(2, map[string]string{"service_version": "value2"})
+
+	mb2 := b.addMetric("m2", pmetric.MetricTypeGauge, false)
+	mb2.addDoubleDataPoint(3, map[string]string{"service_version": "value1"})
+
+	rmb.Build().CopyTo(input.ResourceMetrics())
+	return input
+}
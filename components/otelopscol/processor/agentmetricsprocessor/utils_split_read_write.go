@@ -16,6 +16,7 @@ package agentmetricsprocessor
 
 import (
 	"fmt"
+	"strings"
 
 	"go.opentelemetry.io/collector/pdata/pmetric"
 )
@@ -44,12 +45,7 @@ const (
 	processDiskBytes = "process.disk.io"
 )
 
-var metricsToSplit = map[string]bool{
-	hostDiskBytes:    true,
-	processDiskBytes: true,
-}
-
-func splitReadWriteBytesMetrics(rms pmetric.ResourceMetricsSlice) error {
+func (mtp *agentMetricsProcessor) splitReadWriteBytesMetrics(rms pmetric.ResourceMetricsSlice) error {
 	for i := 0; i < rms.Len(); i++ {
 		ilms := rms.At(i).ScopeMetrics()
 		for j := 0; j < ilms.Len(); j++ {
@@ -59,12 +55,12 @@ func splitReadWriteBytesMetrics(rms pmetric.ResourceMetricsSlice) error {
 
 				// ignore all metrics except "disk.io" metrics
 				metricName := metric.Name()
-				if _, ok := metricsToSplit[metricName]; !ok {
+				if !mtp.metricsToSplit[metricName] {
 					continue
 				}
 
 				// split into read and write metrics
-				read, write, err := splitReadWriteBytesMetric(metric)
+				read, write, err := splitReadWriteBytesMetric(metric, &mtp.cfg.SplitReadWrite)
 				if err != nil {
 					return err
 				}
@@ -85,10 +81,10 @@ const (
 	writeDirection = "write"
 )
 
-func splitReadWriteBytesMetric(metric pmetric.Metric) (read pmetric.Metric, write pmetric.Metric, err error) {
-	// create new read & write metrics with descriptor & name including "read_" & "write_" prefix respectively
-	read = newMetricWithName(metric, metricPostfixRegex.ReplaceAllString(metric.Name(), "read_$1"))
-	write = newMetricWithName(metric, metricPostfixRegex.ReplaceAllString(metric.Name(), "write_$1"))
+func splitReadWriteBytesMetric(metric pmetric.Metric, cfg *SplitReadWriteConfig) (read pmetric.Metric, write pmetric.Metric, err error) {
+	// create new read & write metrics with descriptor & name including the read & write prefix and suffix respectively
+	read = newMetricWithName(metric, cfg.readName(metric.Name()))
+	write = newMetricWithName(metric, cfg.writeName(metric.Name()))
 
 	// append data points to the read or write metric as appropriate
 	switch t := metric.Type(); t {
@@ -103,6 +99,13 @@ func splitReadWriteBytesMetric(metric pmetric.Metric) (read pmetric.Metric, writ
 	return read, write, err
 }
 
+// prefixLastSegment prepends the prefix to the last dot-separated segment of
+// the metric name.
+func prefixLastSegment(name, prefix string) string {
+	i := strings.LastIndex(name, ".") + 1
+	return name[:i] + prefix + name[i:]
+}
+
 func appendNumberDataPoints(metricName string, ndps, read, write pmetric.NumberDataPointSlice) error {
 	for i := 0; i < ndps.Len(); i++ {
 		ndp := ndps.At(i)
@@ -49,3 +49,33 @@ func generateReadWriteMetricsExpected() pmetric.Metrics {
 	rmb.Build().CopyTo(expected.ResourceMetrics())
 	return expected
 }
+
+func generateCustomReadWriteMetricsExpected() pmetric.Metrics {
+	expected := pmetric.NewMetrics()
+
+	rmb := newResourceMetricsBuilder()
+	b := rmb.addResourceMetrics(nil)
+	b.addMetric("system.disk.r_io", pmetric.MetricTypeSum, true).addIntDataPoint(1, map[string]string{"label1": "value1"})
+	b.addMetric("system.disk.w_io", pmetric.MetricTypeSum, true).addIntDataPoint(2, map[string]string{"label1": "value2"})
+	mb := b.addMetric("process.disk.io", pmetric.MetricTypeGauge, false)
+	mb.addDoubleDataPoint(3, map[string]string{"label1": "value1", "direction": "read"})
+	mb.addDoubleDataPoint(4, map[string]string{"label1": "value2", "direction": "write"})
+
+	rmb.Build().CopyTo(expected.ResourceMetrics())
+	return expected
+}
+
+func generateSuffixReadWriteMetricsExpected() pmetric.Metrics {
+	expected := pmetric.NewMetrics()
+
+	rmb := newResourceMetricsBuilder()
+	b := rmb.addResourceMetrics(nil)
+	b.addMetric("system.disk.io.read", pmetric.MetricTypeSum, true).addIntDataPoint(1, map[string]string{"label1": "value1"})
+	b.addMetric("system.disk.io.write", pmetric.MetricTypeSum, true).addIntDataPoint(2, map[string]string{"label1": "value2"})
+	mb := b.addMetric("process.disk.io", pmetric.MetricTypeGauge, false)
+	mb.addDoubleDataPoint(3, map[string]string{"label1": "value1", "direction": "read"})
+	mb.addDoubleDataPoint(4, map[string]string{"label1": "value2", "direction": "write"})
+
+	rmb.Build().CopyTo(expected.ResourceMetrics())
+	return expected
+}
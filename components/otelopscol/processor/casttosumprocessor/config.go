@@ -15,20 +15,88 @@
 package casttosumprocessor
 
 import (
+	"errors"
 	"fmt"
 )
 
+// MatchType specifies how the metric names of a Rule are matched.
+type MatchType string
+
+const (
+	// MatchTypeStrict matches metric names exactly.
+	MatchTypeStrict MatchType = "strict"
+	// MatchTypeRegexp matches metric names against RE2 regular expressions.
+	MatchTypeRegexp MatchType = "regexp"
+	// MatchTypeGlob matches metric names against glob patterns.
+	MatchTypeGlob MatchType = "glob"
+)
+
+// AggregationTemporality is the temporality assigned to the resulting sum.
+type AggregationTemporality string
+
+const (
+	AggregationTemporalityCumulative AggregationTemporality = "cumulative"
+	AggregationTemporalityDelta      AggregationTemporality = "delta"
+)
+
+// StartTimestampPolicy specifies how the start timestamp of data points
+// without one is populated.
+type StartTimestampPolicy string
+
+const (
+	// StartTimestampKeep leaves start timestamps unchanged.
+	StartTimestampKeep StartTimestampPolicy = "keep"
+	// StartTimestampFirstSeen uses the timestamp of the first data point
+	// observed for the same series.
+	StartTimestampFirstSeen StartTimestampPolicy = "first_seen"
+	// StartTimestampProcessorStart uses the time the processor was created.
+	StartTimestampProcessorStart StartTimestampPolicy = "processor_start"
+)
+
 // Config defines the configuration for the processor.
 type Config struct {
-	// List of input metrics
+	// List of input metrics, matched by exact name and cast to cumulative
+	// monotonic sums.
 	Metrics []string `mapstructure:"metrics"`
+
+	// Rules is a list of casting rules. For each metric, the first matching
+	// rule is applied. The Metrics list is checked before any rule.
+	Rules []Rule `mapstructure:"rules"`
+}
+
+// Rule selects a set of metrics and describes the sum they are cast to.
+type Rule struct {
+	// MetricNames is the list of names or patterns to match.
+	MetricNames []string `mapstructure:"metric_names"`
+
+	// MatchType is one of "strict" (default), "regexp" or "glob".
+	MatchType MatchType `mapstructure:"match_type"`
+
+	// AggregationTemporality is one of "cumulative" (default) or "delta".
+	AggregationTemporality AggregationTemporality `mapstructure:"aggregation_temporality"`
+
+	// Monotonic sets the monotonic flag of the resulting sum. Defaults to true.
+	Monotonic *bool `mapstructure:"monotonic"`
+
+	// NewName renames the matched metrics if set.
+	NewName string `mapstructure:"new_name"`
+
+	// StartTimestamp is one of "keep" (default), "first_seen" or
+	// "processor_start".
+	StartTimestamp StartTimestampPolicy `mapstructure:"start_timestamp"`
 }
 
 // Validate checks whether the input configuration has all of the required fields for the processor.
 // An error is returned if there are any invalid inputs.
 func (config *Config) Validate() error {
-	if len(config.Metrics) == 0 {
+	if len(config.Metrics) == 0 && len(config.Rules) == 0 {
 		return fmt.Errorf("metric names are missing")
 	}
-	return nil
+	var errs []error
+	for i, rule := range config.Rules {
+		if _, err := compileRule(rule); err != nil {
+			errs = append(errs, fmt.Errorf("rules[%d]: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
 }
@@ -56,3 +56,88 @@ func TestValidateConfig(t *testing.T) {
 	_, err = otelcoltest.LoadConfigAndValidate(path.Join(".", "testdata", "config_missing_name.yaml"), factories)
 	assert.EqualError(t, err, fmt.Sprintf("processors::%s: %s", componentType, "metric names are missing"))
 }
+
+func TestLoadingRulesConfig(t *testing.T) {
+	factories, err := otelcoltest.NopFactories()
+	assert.NoError(t, err)
+
+	factory := NewFactory()
+	factories.Processors[componentType] = factory
+
+	cfg, err := otelcoltest.LoadConfigAndValidate(path.Join(".", "testdata", "config_rules.yaml"), factories)
+	assert.NoError(t, err)
+	assert.NotNil(t, cfg)
+
+	falseValue := false
+	id := component.NewID(componentType)
+	p1 := cfg.Processors[id]
+	expectedCfg := &Config{
+		Metrics: []string{"metric1"},
+		Rules: []Rule{
+			{
+				MetricNames:            []string{`^app\.requests\..*$`},
+				MatchType:              MatchTypeRegexp,
+				AggregationTemporality: AggregationTemporalityDelta,
+				Monotonic:              &falseValue,
+			},
+			{
+				MetricNames:    []string{"workload.googleapis.com/*.count"},
+				MatchType:      MatchTypeGlob,
+				NewName:        "workload.googleapis.com/count",
+				StartTimestamp: StartTimestampFirstSeen,
+			},
+		},
+	}
+	assert.Equal(t, p1, expectedCfg)
+}
+
+func TestValidateInvalidRule(t *testing.T) {
+	factories, err := otelcoltest.NopFactories()
+	assert.NoError(t, err)
+
+	factory := NewFactory()
+	factories.Processors[componentType] = factory
+
+	_, err = otelcoltest.LoadConfigAndValidate(path.Join(".", "testdata", "config_invalid_rule.yaml"), factories)
+	assert.ErrorContains(t, err, `rules[0]: invalid regexp "("`)
+}
+
+func TestValidateRuleOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    Rule
+		wantErr string
+	}{
+		{
+			name:    "missing names",
+			rule:    Rule{},
+			wantErr: "rules[0]: metric names are missing",
+		},
+		{
+			name:    "unknown match type",
+			rule:    Rule{MetricNames: []string{"m1"}, MatchType: "prefix"},
+			wantErr: `rules[0]: unsupported match_type "prefix"`,
+		},
+		{
+			name:    "invalid glob",
+			rule:    Rule{MetricNames: []string{"[a"}, MatchType: MatchTypeGlob},
+			wantErr: `rules[0]: invalid glob "[a"`,
+		},
+		{
+			name:    "unknown temporality",
+			rule:    Rule{MetricNames: []string{"m1"}, AggregationTemporality: "gauge"},
+			wantErr: `rules[0]: unsupported aggregation_temporality "gauge"`,
+		},
+		{
+			name:    "unknown start timestamp policy",
+			rule:    Rule{MetricNames: []string{"m1"}, StartTimestamp: "now"},
+			wantErr: `rules[0]: unsupported start_timestamp "now"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Rules: []Rule{tt.rule}}
+			assert.ErrorContains(t, cfg.Validate(), tt.wantErr)
+		})
+	}
+}
@@ -55,7 +55,10 @@ func createMetricsProcessor(
 		return nil, err
 	}
 
-	metricsProcessor := newCastToSumProcessor(processorConfig, params.Logger)
+	metricsProcessor, err := newCastToSumProcessor(processorConfig, params.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
 	return processorhelper.NewMetrics(
 		ctx,
 		params,
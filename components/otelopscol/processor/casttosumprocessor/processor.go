@@ -16,72 +16,209 @@ package casttosumprocessor
 
 import (
 	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/zap"
 )
 
+const scopeName = "github.com/GoogleCloudPlatform/opentelemetry-operations-collector/components/otelopscol/processor/casttosumprocessor"
+
 // TODO - This processor shares a lot of similar intent with the MetricsAdjuster present in the
 // prometheus receiver. The relevant code should be merged together and made available in a way
 // where it is available to all receivers.
 // see: https://github.com/open-telemetry/opentelemetry-collector/blob/6e5beaf43b325e63ec6f1e864d9746a0d051cc35/receiver/prometheusreceiver/internal/metrics_adjuster.go#L187
 type CastToSumProcessor struct {
-	Metrics []string
-	logger  *zap.Logger
+	logger    *zap.Logger
+	rules     []*compiledRule
+	startTime pcommon.Timestamp
+
+	// unsupportedMetrics counts matched metrics that were skipped because
+	// they are neither gauges nor sums.
+	unsupportedMetrics metric.Int64Counter
+
+	now func() time.Time
+
+	mutex sync.Mutex
+	// firstSeen holds the first timestamp observed for each series matched
+	// by a rule using the "first_seen" start timestamp policy.
+	firstSeen map[string]*firstSeenSeries
 }
 
-func newCastToSumProcessor(config *Config, logger *zap.Logger) *CastToSumProcessor {
-	return &CastToSumProcessor{
-		Metrics: config.Metrics,
-		logger:  logger,
+// staleSeriesTimeout is how long the first timestamp of a series is kept
+// after the series was last seen.
+const staleSeriesTimeout = 10 * time.Minute
+
+type firstSeenSeries struct {
+	start    pcommon.Timestamp
+	lastSeen time.Time
+}
+
+func newCastToSumProcessor(config *Config, set component.TelemetrySettings) (*CastToSumProcessor, error) {
+	var rules []*compiledRule
+	if len(config.Metrics) > 0 {
+		rule, err := compileRule(Rule{MetricNames: config.Metrics})
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
 	}
+	for _, r := range config.Rules {
+		rule, err := compileRule(r)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	unsupportedMetrics, err := set.MeterProvider.Meter(scopeName).Int64Counter(
+		"processor_casttosum_unsupported_metrics",
+		metric.WithDescription("Number of matched metrics skipped because they are neither gauges nor sums."),
+		metric.WithUnit("{metrics}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CastToSumProcessor{
+		logger:             set.Logger,
+		rules:              rules,
+		startTime:          pcommon.NewTimestampFromTime(time.Now()),
+		unsupportedMetrics: unsupportedMetrics,
+		now:                time.Now,
+		firstSeen:          make(map[string]*firstSeenSeries),
+	}, nil
 }
 
 // ProcessMetrics implements the MProcessor interface.
-func (ctsp *CastToSumProcessor) ProcessMetrics(_ context.Context, metrics pmetric.Metrics) (pmetric.Metrics, error) {
+func (ctsp *CastToSumProcessor) ProcessMetrics(ctx context.Context, metrics pmetric.Metrics) (pmetric.Metrics, error) {
 	for i := 0; i < metrics.ResourceMetrics().Len(); i++ {
 		rms := metrics.ResourceMetrics().At(i)
-		ctsp.transformMetrics(rms)
+		ctsp.transformMetrics(ctx, rms)
 	}
+	ctsp.evictStaleSeries()
 
 	return metrics, nil
 }
 
-func (ctsp *CastToSumProcessor) transformMetrics(rms pmetric.ResourceMetrics) {
+// evictStaleSeries removes the first timestamps of the series that have not
+// been seen for staleSeriesTimeout.
+func (ctsp *CastToSumProcessor) evictStaleSeries() {
+	ctsp.mutex.Lock()
+	defer ctsp.mutex.Unlock()
+
+	cutoff := ctsp.now().Add(-staleSeriesTimeout)
+	for key, series := range ctsp.firstSeen {
+		if series.lastSeen.Before(cutoff) {
+			delete(ctsp.firstSeen, key)
+		}
+	}
+}
+
+func (ctsp *CastToSumProcessor) transformMetrics(ctx context.Context, rms pmetric.ResourceMetrics) {
 	ilms := rms.ScopeMetrics()
 	for j := 0; j < ilms.Len(); j++ {
 		ilm := ilms.At(j).Metrics()
 		for k := 0; k < ilm.Len(); k++ {
 			metric := ilm.At(k)
-			ctsp.processMetric(rms.Resource(), metric)
+			ctsp.processMetric(ctx, rms.Resource(), metric)
 		}
 	}
 }
 
-func sliceContains(names []string, name string) bool {
-	for _, n := range names {
-		if name == n {
-			return true
+// matchRule returns the first rule matching the metric name, or nil.
+func (ctsp *CastToSumProcessor) matchRule(name string) *compiledRule {
+	for _, rule := range ctsp.rules {
+		if rule.matches(name) {
+			return rule
 		}
 	}
-	return false
+	return nil
 }
 
 // processMetric processes a supported metric.
-func (ctsp *CastToSumProcessor) processMetric(_ pcommon.Resource, metric pmetric.Metric) {
-	if !sliceContains(ctsp.Metrics, metric.Name()) {
+func (ctsp *CastToSumProcessor) processMetric(ctx context.Context, resource pcommon.Resource, m pmetric.Metric) {
+	rule := ctsp.matchRule(m.Name())
+	if rule == nil {
+		return
+	}
+
+	switch m.Type() {
+	case pmetric.MetricTypeGauge:
+		dps := pmetric.NewNumberDataPointSlice()
+		m.Gauge().DataPoints().MoveAndAppendTo(dps)
+		m.SetEmptySum()
+		dps.MoveAndAppendTo(m.Sum().DataPoints())
+	case pmetric.MetricTypeSum:
+	default:
+		ctsp.logger.Debug("Configured metric is neither gauge nor sum, skipping",
+			zap.String("metric", m.Name()),
+			zap.String("type", m.Type().String()))
+		ctsp.unsupportedMetrics.Add(ctx, 1, metric.WithAttributes(attribute.String("type", m.Type().String())))
 		return
 	}
-	if metric.Type() == pmetric.MetricTypeGauge {
-		newMetric := pmetric.NewMetric()
-		metric.CopyTo(newMetric)
-		newMetric.SetEmptySum()
-		metric.Gauge().DataPoints().CopyTo(newMetric.Sum().DataPoints())
-		newMetric.CopyTo(metric)
-	} else if metric.Type() != pmetric.MetricTypeSum {
-		ctsp.logger.Info("Configured metric %s is neither gauge nor sum", zap.String("metric", metric.Name()))
+
+	switch rule.startTimestamp {
+	case StartTimestampFirstSeen:
+		ctsp.setFirstSeenStartTimestamps(resource, m)
+	case StartTimestampProcessorStart:
+		dps := m.Sum().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			if dps.At(i).StartTimestamp() == 0 {
+				dps.At(i).SetStartTimestamp(ctsp.startTime)
+			}
+		}
+	}
+
+	m.Sum().SetIsMonotonic(rule.monotonic)
+	m.Sum().SetAggregationTemporality(rule.temporality)
+	if rule.newName != "" {
+		m.SetName(rule.newName)
 	}
-	metric.Sum().SetIsMonotonic(true)
-	metric.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+// setFirstSeenStartTimestamps sets the start timestamp of data points without
+// one to the timestamp of the first data point observed for the same series.
+func (ctsp *CastToSumProcessor) setFirstSeenStartTimestamps(resource pcommon.Resource, m pmetric.Metric) {
+	ctsp.mutex.Lock()
+	defer ctsp.mutex.Unlock()
+
+	now := ctsp.now()
+	prefix := attributesAsKey(resource.Attributes()) + "/" + m.Name() + "/"
+	dps := m.Sum().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		if dp.StartTimestamp() != 0 {
+			continue
+		}
+		key := prefix + attributesAsKey(dp.Attributes())
+		series, ok := ctsp.firstSeen[key]
+		if !ok {
+			series = &firstSeenSeries{start: dp.Timestamp()}
+			ctsp.firstSeen[key] = series
+		}
+		series.lastSeen = now
+		dp.SetStartTimestamp(series.start)
+	}
+}
+
+// attributesAsKey returns a key representing the provided attributes.
+func attributesAsKey(attrs pcommon.Map) string {
+	idx, labels := 0, make([]string, attrs.Len())
+	attrs.Range(func(k string, v pcommon.Value) bool {
+		labels[idx] = k + "=" + v.AsString()
+		idx++
+		return true
+	})
+	// sort the slice so that we consider attribute sets
+	// the same regardless of order
+	sort.Strings(labels)
+	return strings.Join(labels, ";")
 }
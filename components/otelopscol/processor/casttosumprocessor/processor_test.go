@@ -29,7 +29,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/processor/processorhelper"
 	"go.opentelemetry.io/collector/processor/processortest"
-	"go.uber.org/zap"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
 
 type testCase struct {
@@ -83,7 +83,8 @@ func TestCastToSumProcessor(t *testing.T) {
 			cfg := &Config{
 				Metrics: []string{"m1", "m2"},
 			}
-			nsp := newCastToSumProcessor(cfg, zap.NewExample())
+			nsp, err := newCastToSumProcessor(cfg, componenttest.NewNopTelemetrySettings())
+			require.NoError(t, err)
 
 			tmn := &consumertest.MetricsSink{}
 			rmp, err := processorhelper.NewMetrics(
@@ -110,6 +111,210 @@ func TestCastToSumProcessor(t *testing.T) {
 	}
 }
 
+func TestCastToSumProcessorRules(t *testing.T) {
+	testStart := time.Now().Unix()
+	falseValue := false
+	tests := []struct {
+		name     string
+		rules    []Rule
+		input    func() pmetric.Metrics
+		expected func() pmetric.Metrics
+	}{
+		{
+			name: "regexp-match",
+			rules: []Rule{{
+				MetricNames: []string{`^app\.requests\..*$`},
+				MatchType:   MatchTypeRegexp,
+			}},
+			input: func() pmetric.Metrics {
+				rmb := newResourceMetricsBuilder()
+				b := rmb.addResourceMetrics(nil)
+				b.addMetric("app.requests.count", pmetric.MetricTypeGauge, false).addIntDataPoint(1, map[string]string{}, testStart, 0)
+				b.addMetric("app.latency", pmetric.MetricTypeGauge, false).addIntDataPoint(2, map[string]string{}, testStart, 0)
+				return buildMetrics(rmb)
+			},
+			expected: func() pmetric.Metrics {
+				rmb := newResourceMetricsBuilder()
+				b := rmb.addResourceMetrics(nil)
+				b.addMetric("app.requests.count", pmetric.MetricTypeSum, true).addIntDataPoint(1, map[string]string{}, testStart, 0)
+				b.addMetric("app.latency", pmetric.MetricTypeGauge, false).addIntDataPoint(2, map[string]string{}, testStart, 0)
+				return buildMetrics(rmb)
+			},
+		},
+		{
+			name: "glob-match",
+			rules: []Rule{{
+				MetricNames: []string{"workload.googleapis.com/*.count"},
+				MatchType:   MatchTypeGlob,
+			}},
+			input: func() pmetric.Metrics {
+				rmb := newResourceMetricsBuilder()
+				b := rmb.addResourceMetrics(nil)
+				b.addMetric("workload.googleapis.com/a/b.count", pmetric.MetricTypeGauge, false).addIntDataPoint(1, map[string]string{}, testStart, 0)
+				b.addMetric("workload.googleapis.com/a/b.size", pmetric.MetricTypeGauge, false).addIntDataPoint(2, map[string]string{}, testStart, 0)
+				return buildMetrics(rmb)
+			},
+			expected: func() pmetric.Metrics {
+				rmb := newResourceMetricsBuilder()
+				b := rmb.addResourceMetrics(nil)
+				b.addMetric("workload.googleapis.com/a/b.count", pmetric.MetricTypeSum, true).addIntDataPoint(1, map[string]string{}, testStart, 0)
+				b.addMetric("workload.googleapis.com/a/b.size", pmetric.MetricTypeGauge, false).addIntDataPoint(2, map[string]string{}, testStart, 0)
+				return buildMetrics(rmb)
+			},
+		},
+		{
+			name: "delta-non-monotonic-rename",
+			rules: []Rule{{
+				MetricNames:            []string{"m1"},
+				AggregationTemporality: AggregationTemporalityDelta,
+				Monotonic:              &falseValue,
+				NewName:                "m1.delta",
+			}},
+			input: func() pmetric.Metrics {
+				rmb := newResourceMetricsBuilder()
+				b := rmb.addResourceMetrics(nil)
+				b.addMetric("m1", pmetric.MetricTypeGauge, false).addDoubleDataPoint(3, map[string]string{}, testStart, 0)
+				return buildMetrics(rmb)
+			},
+			expected: func() pmetric.Metrics {
+				rmb := newResourceMetricsBuilder()
+				b := rmb.addResourceMetrics(nil)
+				mb := b.addMetric("m1.delta", pmetric.MetricTypeSum, false)
+				mb.metric.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+				mb.addDoubleDataPoint(3, map[string]string{}, testStart, 0)
+				return buildMetrics(rmb)
+			},
+		},
+		{
+			name: "first-rule-wins",
+			rules: []Rule{
+				{MetricNames: []string{"m1"}, NewName: "first"},
+				{MetricNames: []string{"m.*"}, MatchType: MatchTypeRegexp, NewName: "second"},
+			},
+			input: func() pmetric.Metrics {
+				rmb := newResourceMetricsBuilder()
+				b := rmb.addResourceMetrics(nil)
+				b.addMetric("m1", pmetric.MetricTypeGauge, false).addIntDataPoint(1, map[string]string{}, testStart, 0)
+				b.addMetric("m2", pmetric.MetricTypeGauge, false).addIntDataPoint(2, map[string]string{}, testStart, 0)
+				return buildMetrics(rmb)
+			},
+			expected: func() pmetric.Metrics {
+				rmb := newResourceMetricsBuilder()
+				b := rmb.addResourceMetrics(nil)
+				b.addMetric("first", pmetric.MetricTypeSum, true).addIntDataPoint(1, map[string]string{}, testStart, 0)
+				b.addMetric("second", pmetric.MetricTypeSum, true).addIntDataPoint(2, map[string]string{}, testStart, 0)
+				return buildMetrics(rmb)
+			},
+		},
+		{
+			name: "first-seen-start-timestamp",
+			rules: []Rule{{
+				MetricNames:    []string{"m1"},
+				StartTimestamp: StartTimestampFirstSeen,
+			}},
+			input: func() pmetric.Metrics {
+				rmb := newResourceMetricsBuilder()
+				b := rmb.addResourceMetrics(nil)
+				mb := b.addMetric("m1", pmetric.MetricTypeGauge, false)
+				mb.addIntDataPoint(1, map[string]string{"label": "val1"}, testStart, 0)
+				mb.addIntDataPoint(2, map[string]string{"label": "val1"}, testStart+1000, 0)
+				mb.addIntDataPoint(3, map[string]string{"label": "val2"}, testStart+1000, 0)
+				mb.addIntDataPoint(4, map[string]string{"label": "val2"}, testStart+2000, testStart+500)
+				return buildMetrics(rmb)
+			},
+			expected: func() pmetric.Metrics {
+				rmb := newResourceMetricsBuilder()
+				b := rmb.addResourceMetrics(nil)
+				mb := b.addMetric("m1", pmetric.MetricTypeSum, true)
+				mb.addIntDataPoint(1, map[string]string{"label": "val1"}, testStart, testStart)
+				mb.addIntDataPoint(2, map[string]string{"label": "val1"}, testStart+1000, testStart)
+				mb.addIntDataPoint(3, map[string]string{"label": "val2"}, testStart+1000, testStart+1000)
+				mb.addIntDataPoint(4, map[string]string{"label": "val2"}, testStart+2000, testStart+500)
+				return buildMetrics(rmb)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Rules: tt.rules}
+			require.NoError(t, cfg.Validate())
+			ctsp, err := newCastToSumProcessor(cfg, componenttest.NewNopTelemetrySettings())
+			require.NoError(t, err)
+
+			actual, err := ctsp.ProcessMetrics(context.Background(), tt.input())
+			require.NoError(t, err)
+			requireEqual(t, []pmetric.Metrics{tt.expected()}, []pmetric.Metrics{actual})
+		})
+	}
+}
+
+func TestCastToSumProcessorUnsupportedType(t *testing.T) {
+	tel := componenttest.NewTelemetry()
+	defer func() { require.NoError(t, tel.Shutdown(context.Background())) }()
+
+	ctsp, err := newCastToSumProcessor(&Config{Metrics: []string{"m1"}}, tel.NewTelemetrySettings())
+	require.NoError(t, err)
+
+	input := pmetric.NewMetrics()
+	m := input.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("m1")
+	m.SetEmptyHistogram().DataPoints().AppendEmpty().SetCount(1)
+
+	actual, err := ctsp.ProcessMetrics(context.Background(), input)
+	require.NoError(t, err)
+
+	out := actual.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	require.Equal(t, pmetric.MetricTypeHistogram, out.Type())
+	require.Equal(t, uint64(1), out.Histogram().DataPoints().At(0).Count())
+
+	got, err := tel.GetMetric("processor_casttosum_unsupported_metrics")
+	require.NoError(t, err)
+	sum, ok := got.Data.(metricdata.Sum[int64])
+	require.True(t, ok)
+	require.Len(t, sum.DataPoints, 1)
+	require.Equal(t, int64(1), sum.DataPoints[0].Value)
+}
+
+func TestCastToSumProcessorEvictsStaleSeries(t *testing.T) {
+	cfg := &Config{Rules: []Rule{{
+		MetricNames:    []string{"m1"},
+		StartTimestamp: StartTimestampFirstSeen,
+	}}}
+	require.NoError(t, cfg.Validate())
+	ctsp, err := newCastToSumProcessor(cfg, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	now := time.Now()
+	ctsp.now = func() time.Time { return now }
+
+	// process returns the start timestamp given to the data point, in seconds.
+	process := func(label string, timestamp int64) int64 {
+		rmb := newResourceMetricsBuilder()
+		rmb.addResourceMetrics(nil).addMetric("m1", pmetric.MetricTypeGauge, false).
+			addIntDataPoint(1, map[string]string{"label": label}, timestamp, 0)
+		actual, err := ctsp.ProcessMetrics(context.Background(), buildMetrics(rmb))
+		require.NoError(t, err)
+		return actual.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0).StartTimestamp().AsTime().Unix()
+	}
+
+	require.Equal(t, int64(1000), process("val1", 1000))
+	require.Equal(t, int64(2000), process("val2", 2000))
+
+	// only val1 keeps reporting, so val2 is evicted once it is stale
+	now = now.Add(staleSeriesTimeout / 2)
+	require.Equal(t, int64(1000), process("val1", 3000))
+	now = now.Add(staleSeriesTimeout/2 + time.Second)
+	require.Equal(t, int64(1000), process("val1", 4000))
+	require.Len(t, ctsp.firstSeen, 1)
+	require.Equal(t, int64(5000), process("val2", 5000))
+}
+
+func buildMetrics(rmb resourceMetricsBuilder) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rmb.Build().CopyTo(md.ResourceMetrics())
+	return md
+}
+
 func generateNoTransformMetrics(startTime int64) []pmetric.Metrics {
 	input := pmetric.NewMetrics()
 
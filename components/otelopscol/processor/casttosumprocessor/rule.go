@@ -0,0 +1,115 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package casttosumprocessor
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/gobwas/glob"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// compiledRule is a Rule with its name patterns compiled and its options
+// resolved to their defaults.
+type compiledRule struct {
+	names   map[string]struct{}
+	regexps []*regexp.Regexp
+	globs   []glob.Glob
+
+	temporality    pmetric.AggregationTemporality
+	monotonic      bool
+	newName        string
+	startTimestamp StartTimestampPolicy
+}
+
+func compileRule(rule Rule) (*compiledRule, error) {
+	if len(rule.MetricNames) == 0 {
+		return nil, fmt.Errorf("metric names are missing")
+	}
+
+	cr := &compiledRule{
+		temporality:    pmetric.AggregationTemporalityCumulative,
+		monotonic:      true,
+		newName:        rule.NewName,
+		startTimestamp: StartTimestampKeep,
+	}
+
+	switch rule.MatchType {
+	case "", MatchTypeStrict:
+		cr.names = make(map[string]struct{}, len(rule.MetricNames))
+		for _, name := range rule.MetricNames {
+			cr.names[name] = struct{}{}
+		}
+	case MatchTypeRegexp:
+		for _, name := range rule.MetricNames {
+			re, err := regexp.Compile(name)
+			if err != nil {
+				return nil, fmt.Errorf("invalid regexp %q: %w", name, err)
+			}
+			cr.regexps = append(cr.regexps, re)
+		}
+	case MatchTypeGlob:
+		for _, name := range rule.MetricNames {
+			g, err := glob.Compile(name)
+			if err != nil {
+				return nil, fmt.Errorf("invalid glob %q: %w", name, err)
+			}
+			cr.globs = append(cr.globs, g)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported match_type %q", rule.MatchType)
+	}
+
+	switch rule.AggregationTemporality {
+	case "", AggregationTemporalityCumulative:
+	case AggregationTemporalityDelta:
+		cr.temporality = pmetric.AggregationTemporalityDelta
+	default:
+		return nil, fmt.Errorf("unsupported aggregation_temporality %q", rule.AggregationTemporality)
+	}
+
+	if rule.Monotonic != nil {
+		cr.monotonic = *rule.Monotonic
+	}
+
+	switch rule.StartTimestamp {
+	case "":
+	case StartTimestampKeep, StartTimestampFirstSeen, StartTimestampProcessorStart:
+		cr.startTimestamp = rule.StartTimestamp
+	default:
+		return nil, fmt.Errorf("unsupported start_timestamp %q", rule.StartTimestamp)
+	}
+
+	return cr, nil
+}
+
+// matches reports whether the metric name is selected by the rule.
+func (cr *compiledRule) matches(name string) bool {
+	if _, ok := cr.names[name]; ok {
+		return true
+	}
+	for _, re := range cr.regexps {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	for _, g := range cr.globs {
+		if g.Match(name) {
+			return true
+		}
+	}
+	return false
+}
@@ -26,8 +26,17 @@ var componentType component.Type = component.MustNewType("filter")
 // NewFactory create a factory for the transform processor.
 func NewFactory() processor.Factory {
 	return contribfilter.NewFactoryWithOptions(
-		contribfilter.WithLogFunctionsNew(contribfilter.DefaultLogFunctionsNew()),
-		// Add log functions defined in ottlfuncs.
-		contribfilter.WithLogFunctionsNew(ottlfuncs.LogFunctions()),
+		contribfilter.WithResourceFunctions(contribfilter.DefaultResourceFunctions()),
+		contribfilter.WithLogFunctions(contribfilter.DefaultLogFunctions()),
+		contribfilter.WithMetricFunctions(contribfilter.DefaultMetricFunctions()),
+		contribfilter.WithDataPointFunctions(contribfilter.DefaultDataPointFunctions()),
+		contribfilter.WithSpanFunctions(contribfilter.DefaultSpanFunctions()),
+		// Add functions defined in ottlfuncs. The filter processor doesn't
+		// support adding functions to the scope context.
+		contribfilter.WithResourceFunctions(ottlfuncs.ResourceFunctions()),
+		contribfilter.WithLogFunctions(ottlfuncs.LogFunctions()),
+		contribfilter.WithMetricFunctions(ottlfuncs.MetricFunctions()),
+		contribfilter.WithDataPointFunctions(ottlfuncs.DataPointFunctions()),
+		contribfilter.WithSpanFunctions(ottlfuncs.SpanFunctions()),
 	)
 }
@@ -16,11 +16,19 @@ package filterprocessor
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/processor/processortest"
 )
 
@@ -48,3 +56,115 @@ func TestCreateTraces(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, mp)
 }
+
+// ottlfuncsCondition returns a condition using every function defined in
+// ottlfuncs on the given map path. It matches maps created by putInput with
+// the "hello world" line.
+func ottlfuncsCondition(path string) string {
+	return fmt.Sprintf(`IsMatchRubyRegex(%[1]s["line"], "(?<=hello )world") and `+
+		`ExtractPatternsRubyRegex(%[1]s["line"], "^(?<greeting>\\w+)")["greeting"] == "hello" and `+
+		`Len(ToValues(%[1]s["records"])) == 2`, path)
+}
+
+func putInput(m pcommon.Map, line string) {
+	m.PutStr("line", line)
+	records := m.PutEmptySlice("records")
+	records.AppendEmpty().SetEmptyMap().PutStr("first", "foo")
+	records.AppendEmpty().SetEmptyMap().PutStr("second", "bar")
+}
+
+func newConfig(t *testing.T, conf map[string]any) component.Config {
+	t.Helper()
+
+	cfg := NewFactory().CreateDefaultConfig()
+	require.NoError(t, confmap.NewFromStringMap(conf).Unmarshal(cfg))
+	require.NoError(t, cfg.(interface{ Validate() error }).Validate())
+	return cfg
+}
+
+func TestMetricsOTTLFunctions(t *testing.T) {
+	// The functions specific to a context must still be available along with
+	// the ones defined in ottlfuncs. The resource and datapoint contexts have
+	// none of their own.
+	tests := []struct {
+		context   string
+		condition string
+	}{
+		{context: "resource", condition: ottlfuncsCondition("resource.attributes")},
+		{context: "metric", condition: ottlfuncsCondition("metric.metadata") + ` and HasAttrOnDatapoint("line", "hello world")`},
+		{context: "datapoint", condition: ottlfuncsCondition("datapoint.attributes")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.context, func(t *testing.T) {
+			cfg := newConfig(t, map[string]any{
+				"metrics": map[string]any{tt.context: []any{tt.condition}},
+			})
+			sink := new(consumertest.MetricsSink)
+			mp, err := NewFactory().CreateMetrics(context.Background(), processortest.NewNopSettings(componentType), cfg, sink)
+			require.NoError(t, err)
+
+			md := pmetric.NewMetrics()
+			for _, line := range []string{"hello world", "goodbye world"} {
+				rm := md.ResourceMetrics().AppendEmpty()
+				putInput(rm.Resource().Attributes(), line)
+				metric := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+				putInput(metric.Metadata(), line)
+				putInput(metric.SetEmptyGauge().DataPoints().AppendEmpty().Attributes(), line)
+			}
+			require.NoError(t, mp.ConsumeMetrics(context.Background(), md))
+
+			require.Len(t, sink.AllMetrics(), 1)
+			rms := sink.AllMetrics()[0].ResourceMetrics()
+			require.Equal(t, 1, rms.Len())
+			line, _ := rms.At(0).Resource().Attributes().Get("line")
+			assert.Equal(t, "goodbye world", line.Str())
+		})
+	}
+}
+
+func TestLogsOTTLFunctions(t *testing.T) {
+	cfg := newConfig(t, map[string]any{
+		"logs": map[string]any{"log_record": []any{
+			ottlfuncsCondition("log.attributes") + ` and UnixSeconds(ParseFluentdTime(log.body, "%d/%b/%Y:%H:%M:%S %z")) == 1696946136`,
+		}},
+	})
+	sink := new(consumertest.LogsSink)
+	lp, err := NewFactory().CreateLogs(context.Background(), processortest.NewNopSettings(componentType), cfg, sink)
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	lrs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	for _, line := range []string{"hello world", "goodbye world"} {
+		lr := lrs.AppendEmpty()
+		lr.Body().SetStr("10/Oct/2023:13:55:36 +0000")
+		putInput(lr.Attributes(), line)
+	}
+	require.NoError(t, lp.ConsumeLogs(context.Background(), ld))
+
+	require.Len(t, sink.AllLogs(), 1)
+	lrs = sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 1, lrs.Len())
+	line, _ := lrs.At(0).Attributes().Get("line")
+	assert.Equal(t, "goodbye world", line.Str())
+}
+
+func TestTracesOTTLFunctions(t *testing.T) {
+	cfg := newConfig(t, map[string]any{
+		"traces": map[string]any{"span": []any{ottlfuncsCondition("span.attributes") + " and IsRootSpan()"}},
+	})
+	sink := new(consumertest.TracesSink)
+	tp, err := NewFactory().CreateTraces(context.Background(), processortest.NewNopSettings(componentType), cfg, sink)
+	require.NoError(t, err)
+
+	td := ptrace.NewTraces()
+	spans := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+	putInput(spans.AppendEmpty().Attributes(), "hello world")
+	putInput(spans.AppendEmpty().Attributes(), "goodbye world")
+	require.NoError(t, tp.ConsumeTraces(context.Background(), td))
+
+	require.Len(t, sink.AllTraces(), 1)
+	spans = sink.AllTraces()[0].ResourceSpans().At(0).ScopeSpans().At(0).Spans()
+	require.Equal(t, 1, spans.Len())
+	line, _ := spans.At(0).Attributes().Get("line")
+	assert.Equal(t, "goodbye world", line.Str())
+}
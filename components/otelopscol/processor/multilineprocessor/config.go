@@ -0,0 +1,120 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multilineprocessor
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/GoogleCloudPlatform/opentelemetry-operations-collector/internal/ottlfuncs"
+	rubex "github.com/GoogleCloudPlatform/opentelemetry-operations-collector/third_party/go-oniguruma"
+)
+
+// Config defines the configuration for the processor.
+type Config struct {
+	// FirstLine is a Ruby regex matching the first line of a record, like the
+	// format_firstline of the fluentd multiline parser. This is a required
+	// field.
+	FirstLine string `mapstructure:"first_line"`
+
+	// Format is an optional Ruby regex whose named capture groups are
+	// extracted from the combined records into their attributes, the same
+	// way as the ExtractPatternsRubyRegex OTTL function.
+	Format string `mapstructure:"format"`
+
+	// OmitEmptyValues skips the capture groups of Format which are empty.
+	OmitEmptyValues bool `mapstructure:"omit_empty_values"`
+
+	// LogNameAttribute is the record attribute identifying the log of a
+	// record. Only the consecutive records of the same resource, scope and
+	// log are combined.
+	LogNameAttribute string `mapstructure:"log_name_attribute"`
+
+	// FlushTimeout is how long a record waits for its next line before it
+	// is sent.
+	FlushTimeout time.Duration `mapstructure:"flush_timeout"`
+
+	// MaxLines is the maximum number of lines of a record.
+	MaxLines int `mapstructure:"max_lines"`
+
+	// MaxBytes is the maximum size of a record. A line which is larger on
+	// its own is sent as a record of its own.
+	MaxBytes int `mapstructure:"max_bytes"`
+
+	// RetryLimit is the maximum number of backtracking retries of a search
+	// of FirstLine or Format in a line or record. 0 means no limit.
+	RetryLimit int64 `mapstructure:"retry_limit"`
+
+	// TimeLimit is the maximum duration of a search of FirstLine or Format
+	// in a line or record. 0 means no limit.
+	TimeLimit time.Duration `mapstructure:"time_limit"`
+}
+
+// Validate checks whether the input configuration has all of the required fields for the processor.
+// An error is returned if there are any invalid inputs.
+func (config *Config) Validate() error {
+	var errs []error
+	if config.FirstLine == "" {
+		errs = append(errs, errors.New("first_line is missing"))
+	} else if firstLine, err := compileFirstLine(config.FirstLine, config.matchParams()); err != nil {
+		errs = append(errs, err)
+	} else {
+		firstLine.Free()
+	}
+	if config.Format != "" {
+		if _, err := ottlfuncs.NewRubyRegexExtractor(config.Format, config.OmitEmptyValues, config.matchParams()); err != nil {
+			errs = append(errs, fmt.Errorf("format: %w", err))
+		}
+	}
+	if config.FlushTimeout <= 0 {
+		errs = append(errs, errors.New("flush_timeout must be positive"))
+	}
+	if config.MaxLines <= 0 {
+		errs = append(errs, errors.New("max_lines must be positive"))
+	}
+	if config.MaxBytes <= 0 {
+		errs = append(errs, errors.New("max_bytes must be positive"))
+	}
+	if config.RetryLimit < 0 {
+		errs = append(errs, errors.New("retry_limit must not be negative"))
+	}
+	if config.TimeLimit < 0 {
+		errs = append(errs, errors.New("time_limit must not be negative"))
+	}
+	return errors.Join(errs...)
+}
+
+// matchParams returns the limits of the searches of FirstLine and Format.
+func (config *Config) matchParams() rubex.MatchParams {
+	return rubex.MatchParams{
+		RetryLimitInSearch: uint64(max(config.RetryLimit, 0)),
+		TimeLimit:          config.TimeLimit,
+	}
+}
+
+// compileFirstLine compiles the FirstLine pattern with the given limits. The
+// C memory of the result is freed by its finalizer, or by Free when it is
+// only compiled to be validated.
+func compileFirstLine(pattern string, params rubex.MatchParams) (*rubex.Regexp, error) {
+	firstLine, err := rubex.NewRegexp(pattern, rubex.ONIG_OPTION_DEFAULT)
+	if err != nil {
+		// The failed Regexp has no finalizer but holds the error buffers.
+		firstLine.Free()
+		return nil, fmt.Errorf("first_line is not a valid pattern: %w", err)
+	}
+	firstLine.SetMatchParams(params)
+	return firstLine, nil
+}
@@ -0,0 +1,112 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multilineprocessor
+
+import (
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/otelcol/otelcoltest"
+)
+
+func TestLoadingFullConfig(t *testing.T) {
+	factories, err := otelcoltest.NopFactories()
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	factories.Processors[componentType] = factory
+
+	cfg, err := otelcoltest.LoadConfigAndValidate(path.Join(".", "testdata", "config_full.yaml"), factories)
+	require.NoError(t, err)
+
+	id := component.NewID(componentType)
+	expectedCfg := &Config{
+		FirstLine:        `^\d{4}-\d{2}-\d{2}`,
+		Format:           `(?<time>\S+ \S+) (?<severity>\w+) (?<message>.*)`,
+		OmitEmptyValues:  true,
+		LogNameAttribute: "log.file.name",
+		FlushTimeout:     10 * time.Second,
+		MaxLines:         500,
+		MaxBytes:         65536,
+		RetryLimit:       100000,
+		TimeLimit:        time.Second,
+	}
+	assert.Equal(t, expectedCfg, cfg.Processors[id])
+}
+
+func TestValidateConfig(t *testing.T) {
+	factories, err := otelcoltest.NopFactories()
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	factories.Processors[componentType] = factory
+
+	_, err = otelcoltest.LoadConfigAndValidate(path.Join(".", "testdata", "config_missing_first_line.yaml"), factories)
+	assert.ErrorContains(t, err, "first_line is missing")
+
+	tests := []struct {
+		name     string
+		modify   func(*Config)
+		expected string
+	}{
+		{
+			name:     "invalid first line",
+			modify:   func(c *Config) { c.FirstLine = "(" },
+			expected: "first_line is not a valid pattern",
+		},
+		{
+			name:     "format without named captures",
+			modify:   func(c *Config) { c.Format = "(.*)" },
+			expected: "format: at least 1 named capture group",
+		},
+		{
+			name:     "flush timeout",
+			modify:   func(c *Config) { c.FlushTimeout = 0 },
+			expected: "flush_timeout must be positive",
+		},
+		{
+			name:     "max lines",
+			modify:   func(c *Config) { c.MaxLines = -1 },
+			expected: "max_lines must be positive",
+		},
+		{
+			name:     "max bytes",
+			modify:   func(c *Config) { c.MaxBytes = 0 },
+			expected: "max_bytes must be positive",
+		},
+		{
+			name:     "retry limit",
+			modify:   func(c *Config) { c.RetryLimit = -1 },
+			expected: "retry_limit must not be negative",
+		},
+		{
+			name:     "time limit",
+			modify:   func(c *Config) { c.TimeLimit = -time.Second },
+			expected: "time_limit must not be negative",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := factory.CreateDefaultConfig().(*Config)
+			cfg.FirstLine = "^start"
+			tt.modify(cfg)
+			assert.ErrorContains(t, cfg.Validate(), tt.expected)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multilineprocessor
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/processor"
+)
+
+// The value of "type" key in configuration.
+var componentType component.Type = component.MustNewType("multiline")
+
+const (
+	defaultLogNameAttribute = "gcp.log_name"
+	defaultFlushTimeout     = 5 * time.Second
+	defaultMaxLines         = 1000
+	defaultMaxBytes         = 1 << 20
+	defaultTimeLimit        = 100 * time.Millisecond
+)
+
+func NewFactory() processor.Factory {
+	return processor.NewFactory(
+		componentType,
+		createDefaultConfig,
+		processor.WithLogs(createLogsProcessor, component.StabilityLevelAlpha))
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		LogNameAttribute: defaultLogNameAttribute,
+		FlushTimeout:     defaultFlushTimeout,
+		MaxLines:         defaultMaxLines,
+		MaxBytes:         defaultMaxBytes,
+		TimeLimit:        defaultTimeLimit,
+	}
+}
+
+func createLogsProcessor(
+	_ context.Context,
+	params processor.Settings,
+	cfg component.Config,
+	nextConsumer consumer.Logs,
+) (processor.Logs, error) {
+	return newMultilineProcessor(cfg.(*Config), params.Logger, nextConsumer)
+}
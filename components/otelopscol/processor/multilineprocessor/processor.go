@@ -0,0 +1,304 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multilineprocessor
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+
+	"github.com/GoogleCloudPlatform/opentelemetry-operations-collector/internal/ottlfuncs"
+	rubex "github.com/GoogleCloudPlatform/opentelemetry-operations-collector/third_party/go-oniguruma"
+)
+
+// minFlushInterval is the minimum interval between the checks for expired
+// records, so that very short flush timeouts don't make the checks spin.
+const minFlushInterval = time.Millisecond
+
+// scopeKey identifies the resource and scope of records.
+type scopeKey struct {
+	resource     [16]byte
+	scopeName    string
+	scopeVersion string
+}
+
+// logKey identifies the log of records, whose consecutive lines are combined.
+type logKey struct {
+	scopeKey
+	logName string
+}
+
+// pendingRecord is a record waiting for more lines.
+type pendingRecord struct {
+	resource pcommon.Resource
+	scope    pcommon.InstrumentationScope
+	record   plog.LogRecord
+	lines    []string
+	bytes    int
+	updated  time.Time
+}
+
+type multilineProcessor struct {
+	logger           *zap.Logger
+	next             consumer.Logs
+	firstLine        *rubex.Regexp
+	extractor        *ottlfuncs.RubyRegexExtractor
+	logNameAttribute string
+	flushTimeout     time.Duration
+	maxLines         int
+	maxBytes         int
+	now              func() time.Time
+
+	// mu protects pending. It is released before the completed records are
+	// sent, so that a slow consumer doesn't block the calls which complete no
+	// records.
+	mu      sync.Mutex
+	pending map[logKey]*pendingRecord
+	// sendMu is held while sending completed records. It is locked before mu
+	// is released, so that the records are sent in the order they were
+	// completed, e.g. an expired record before a later one of the same log.
+	sendMu sync.Mutex
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newMultilineProcessor(cfg *Config, logger *zap.Logger, next consumer.Logs) (*multilineProcessor, error) {
+	firstLine, err := compileFirstLine(cfg.FirstLine, cfg.matchParams())
+	if err != nil {
+		return nil, err
+	}
+	var extractor *ottlfuncs.RubyRegexExtractor
+	if cfg.Format != "" {
+		if extractor, err = ottlfuncs.NewRubyRegexExtractor(cfg.Format, cfg.OmitEmptyValues, cfg.matchParams()); err != nil {
+			return nil, err
+		}
+	}
+	return &multilineProcessor{
+		logger:           logger,
+		next:             next,
+		firstLine:        firstLine,
+		extractor:        extractor,
+		logNameAttribute: cfg.LogNameAttribute,
+		flushTimeout:     cfg.FlushTimeout,
+		maxLines:         cfg.MaxLines,
+		maxBytes:         cfg.MaxBytes,
+		now:              time.Now,
+		pending:          map[logKey]*pendingRecord{},
+	}, nil
+}
+
+func (mp *multilineProcessor) Start(context.Context, component.Host) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	mp.cancel = cancel
+	mp.done = make(chan struct{})
+	go func() {
+		defer close(mp.done)
+		ticker := time.NewTicker(max(mp.flushTimeout/2, minFlushInterval))
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := mp.flush(ctx, false); err != nil {
+					mp.logger.Error("Failed to send the expired multiline records", zap.Error(err))
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// Shutdown sends all the pending records.
+func (mp *multilineProcessor) Shutdown(ctx context.Context) error {
+	if mp.cancel != nil {
+		mp.cancel()
+		<-mp.done
+	}
+	return mp.flush(ctx, true)
+}
+
+func (mp *multilineProcessor) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+// ConsumeLogs combines the records with a string body with the previous ones
+// of their log, until a record matches the first line pattern. Other records
+// are sent as they are, after the pending record of their log. The error of
+// the next consumer is returned, and the records it failed are dropped.
+func (mp *multilineProcessor) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	mp.mu.Lock()
+	return mp.send(ctx, mp.combine(ld))
+}
+
+// combine adds the records of ld to the pending ones, and returns the
+// completed records. mu must be held.
+func (mp *multilineProcessor) combine(ld plog.Logs) *logsBuilder {
+	out := newLogsBuilder()
+	now := mp.now()
+	for _, rl := range ld.ResourceLogs().All() {
+		resourceHash := pdatautil.MapHash(rl.Resource().Attributes())
+		for _, sl := range rl.ScopeLogs().All() {
+			sk := scopeKey{
+				resource:     resourceHash,
+				scopeName:    sl.Scope().Name(),
+				scopeVersion: sl.Scope().Version(),
+			}
+			for _, lr := range sl.LogRecords().All() {
+				key := mp.logKey(sk, lr)
+				if lr.Body().Type() != pcommon.ValueTypeStr {
+					// The pending record of the log comes before this one.
+					if pending := mp.pending[key]; pending != nil {
+						mp.complete(out, key, pending)
+					}
+					lr.CopyTo(out.appendRecord(sk, rl.Resource(), sl.Scope()))
+					continue
+				}
+				mp.addLine(out, key, rl.Resource(), sl.Scope(), lr, now)
+			}
+		}
+	}
+	return out
+}
+
+func (mp *multilineProcessor) logKey(sk scopeKey, lr plog.LogRecord) logKey {
+	key := logKey{scopeKey: sk}
+	if name, ok := lr.Attributes().Get(mp.logNameAttribute); ok {
+		key.logName = name.AsString()
+	}
+	return key
+}
+
+// addLine adds the body of lr to the pending record of its log, or starts a
+// new record after sending the pending one.
+func (mp *multilineProcessor) addLine(out *logsBuilder, key logKey, resource pcommon.Resource, scope pcommon.InstrumentationScope, lr plog.LogRecord, now time.Time) {
+	line := lr.Body().Str()
+	pending := mp.pending[key]
+	if pending != nil && (len(pending.lines) >= mp.maxLines || pending.bytes+1+len(line) > mp.maxBytes || mp.isFirstLine(line)) {
+		mp.complete(out, key, pending)
+		pending = nil
+	}
+	if pending == nil {
+		pending = &pendingRecord{
+			resource: pcommon.NewResource(),
+			scope:    pcommon.NewInstrumentationScope(),
+			record:   plog.NewLogRecord(),
+			bytes:    -1,
+		}
+		resource.CopyTo(pending.resource)
+		scope.CopyTo(pending.scope)
+		lr.CopyTo(pending.record)
+		mp.pending[key] = pending
+	}
+	pending.lines = append(pending.lines, line)
+	pending.bytes += 1 + len(line)
+	pending.updated = now
+}
+
+// isFirstLine returns whether line matches the first line pattern. A line
+// whose search exceeds the match limits is appended to the pending record,
+// like a line which doesn't match.
+func (mp *multilineProcessor) isFirstLine(line string) bool {
+	matched, err := mp.firstLine.MatchStringErr(line)
+	if err != nil {
+		mp.logger.Warn("Failed to match the first line pattern", zap.Error(err))
+	}
+	return matched
+}
+
+// complete adds the pending record to out, with its lines joined and the
+// captures of the format pattern.
+func (mp *multilineProcessor) complete(out *logsBuilder, key logKey, pending *pendingRecord) {
+	delete(mp.pending, key)
+	body := strings.Join(pending.lines, "\n")
+	pending.record.Body().SetStr(body)
+	if mp.extractor != nil {
+		captures, err := mp.extractor.Extract(body)
+		if err != nil {
+			mp.logger.Warn("Failed to match the format pattern", zap.Error(err))
+		}
+		for k, v := range captures.All() {
+			v.CopyTo(pending.record.Attributes().PutEmpty(k))
+		}
+	}
+	pending.record.MoveTo(out.appendRecord(key.scopeKey, pending.resource, pending.scope))
+}
+
+// flush sends the pending records which haven't been updated for the flush
+// timeout, or all of them if force is set.
+func (mp *multilineProcessor) flush(ctx context.Context, force bool) error {
+	mp.mu.Lock()
+	return mp.send(ctx, mp.expire(force))
+}
+
+// expire completes the pending records which haven't been updated for the
+// flush timeout, or all of them if force is set. mu must be held.
+func (mp *multilineProcessor) expire(force bool) *logsBuilder {
+	out := newLogsBuilder()
+	now := mp.now()
+	for key, pending := range mp.pending {
+		if force || now.Sub(pending.updated) >= mp.flushTimeout {
+			mp.complete(out, key, pending)
+		}
+	}
+	return out
+}
+
+// send sends the completed records to the next consumer. It must be called
+// with mu held, and releases it once the previous records were sent.
+func (mp *multilineProcessor) send(ctx context.Context, out *logsBuilder) error {
+	if out.logs.LogRecordCount() == 0 {
+		mp.mu.Unlock()
+		return nil
+	}
+	mp.sendMu.Lock()
+	defer mp.sendMu.Unlock()
+	mp.mu.Unlock()
+	return mp.next.ConsumeLogs(ctx, out.logs)
+}
+
+// logsBuilder groups records by resource and scope.
+type logsBuilder struct {
+	logs   plog.Logs
+	scopes map[scopeKey]plog.ScopeLogs
+}
+
+func newLogsBuilder() *logsBuilder {
+	return &logsBuilder{
+		logs:   plog.NewLogs(),
+		scopes: map[scopeKey]plog.ScopeLogs{},
+	}
+}
+
+func (b *logsBuilder) appendRecord(key scopeKey, resource pcommon.Resource, scope pcommon.InstrumentationScope) plog.LogRecord {
+	sl, ok := b.scopes[key]
+	if !ok {
+		rl := b.logs.ResourceLogs().AppendEmpty()
+		resource.CopyTo(rl.Resource())
+		sl = rl.ScopeLogs().AppendEmpty()
+		scope.CopyTo(sl.Scope())
+		b.scopes[key] = sl
+	}
+	return sl.LogRecords().AppendEmpty()
+}
@@ -0,0 +1,312 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multilineprocessor
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+)
+
+// newLogs returns records of the host resource and the log name, with the
+// given bodies.
+func newLogs(host, logName string, bodies ...any) plog.Logs {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("host.name", host)
+	sl := rl.ScopeLogs().AppendEmpty()
+	for _, body := range bodies {
+		lr := sl.LogRecords().AppendEmpty()
+		lr.Attributes().PutStr(defaultLogNameAttribute, logName)
+		switch b := body.(type) {
+		case string:
+			lr.Body().SetStr(b)
+		case int:
+			lr.Body().SetInt(int64(b))
+		}
+	}
+	return ld
+}
+
+// record is the flattened form of an output record.
+type record struct {
+	host       string
+	body       any
+	attributes map[string]any
+}
+
+func records(sink *consumertest.LogsSink) []record {
+	var result []record
+	for _, ld := range sink.AllLogs() {
+		for _, rl := range ld.ResourceLogs().All() {
+			host, _ := rl.Resource().Attributes().Get("host.name")
+			for _, sl := range rl.ScopeLogs().All() {
+				for _, lr := range sl.LogRecords().All() {
+					attributes := lr.Attributes().AsRaw()
+					delete(attributes, defaultLogNameAttribute)
+					result = append(result, record{
+						host:       host.Str(),
+						body:       lr.Body().AsRaw(),
+						attributes: attributes,
+					})
+				}
+			}
+		}
+	}
+	return result
+}
+
+func newTestProcessor(t *testing.T, modify func(*Config)) (*multilineProcessor, *consumertest.LogsSink) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.FirstLine = `^\d{4}-\d{2}-\d{2} `
+	if modify != nil {
+		modify(cfg)
+	}
+	require.NoError(t, cfg.Validate())
+	sink := &consumertest.LogsSink{}
+	mp, err := newMultilineProcessor(cfg, zap.NewNop(), sink)
+	require.NoError(t, err)
+	return mp, sink
+}
+
+func TestMultilineProcessor(t *testing.T) {
+	mp, sink := newTestProcessor(t, nil)
+	ctx := context.Background()
+
+	require.NoError(t, mp.ConsumeLogs(ctx, newLogs("a", "app",
+		"2024-01-02 03:04:05 ERROR boom",
+		"java.lang.RuntimeException: boom",
+		"\tat com.example.Main.main(Main.java:1)",
+	)))
+	assert.Empty(t, records(sink))
+
+	// A record without a string body completes the record before it, and
+	// lines of other logs or resources don't.
+	require.NoError(t, mp.ConsumeLogs(ctx, newLogs("a", "other", "unrelated")))
+	require.NoError(t, mp.ConsumeLogs(ctx, newLogs("b", "app", "2024-01-02 03:04:06 INFO b")))
+	require.NoError(t, mp.ConsumeLogs(ctx, newLogs("a", "app", "\tat com.example.Main.run(Main.java:2)", 42, "2024-01-02 03:04:07 INFO ok")))
+	assert.Equal(t, []record{
+		{host: "a", body: "2024-01-02 03:04:05 ERROR boom\njava.lang.RuntimeException: boom\n\tat com.example.Main.main(Main.java:1)\n\tat com.example.Main.run(Main.java:2)", attributes: map[string]any{}},
+		{host: "a", body: int64(42), attributes: map[string]any{}},
+	}, records(sink))
+
+	sink.Reset()
+	require.NoError(t, mp.Shutdown(ctx))
+	assert.ElementsMatch(t, []record{
+		{host: "a", body: "unrelated", attributes: map[string]any{}},
+		{host: "b", body: "2024-01-02 03:04:06 INFO b", attributes: map[string]any{}},
+		{host: "a", body: "2024-01-02 03:04:07 INFO ok", attributes: map[string]any{}},
+	}, records(sink))
+}
+
+func TestMultilineProcessorFormat(t *testing.T) {
+	mp, sink := newTestProcessor(t, func(cfg *Config) {
+		cfg.Format = `(?<time>\S+ \S+) (?<severity>\w+) (?<message>(?m:.*))(?<missing>x)?`
+		cfg.OmitEmptyValues = true
+	})
+	ctx := context.Background()
+
+	require.NoError(t, mp.ConsumeLogs(ctx, newLogs("a", "app", "2024-01-02 03:04:05 ERROR boom", "  cause", "not a first line")))
+	require.NoError(t, mp.Shutdown(ctx))
+	assert.Equal(t, []record{
+		{
+			host: "a",
+			body: "2024-01-02 03:04:05 ERROR boom\n  cause\nnot a first line",
+			attributes: map[string]any{
+				"time":     "2024-01-02 03:04:05",
+				"severity": "ERROR",
+				"message":  "boom\n  cause\nnot a first line",
+			},
+		},
+	}, records(sink))
+}
+
+func TestMultilineProcessorLimits(t *testing.T) {
+	mp, sink := newTestProcessor(t, func(cfg *Config) {
+		cfg.MaxLines = 3
+		cfg.MaxBytes = 16
+	})
+	ctx := context.Background()
+
+	require.NoError(t, mp.ConsumeLogs(ctx, newLogs("a", "app", "a", "b", "c", "d", "0123456789", "0123456789abcdefghij", "e")))
+	require.NoError(t, mp.Shutdown(ctx))
+	var bodies []any
+	for _, r := range records(sink) {
+		bodies = append(bodies, r.body)
+	}
+	assert.Equal(t, []any{"a\nb\nc", "d\n0123456789", "0123456789abcdefghij", "e"}, bodies)
+}
+
+func TestMultilineProcessorFlushTimeout(t *testing.T) {
+	mp, sink := newTestProcessor(t, nil)
+	ctx := context.Background()
+	now := time.Now()
+	mp.now = func() time.Time { return now }
+
+	require.NoError(t, mp.ConsumeLogs(ctx, newLogs("a", "app", "2024-01-02 03:04:05 ERROR boom", "cause")))
+	now = now.Add(defaultFlushTimeout / 2)
+	require.NoError(t, mp.ConsumeLogs(ctx, newLogs("b", "app", "2024-01-02 03:04:05 INFO b")))
+	now = now.Add(defaultFlushTimeout / 2)
+	require.NoError(t, mp.flush(ctx, false))
+	assert.Equal(t, []record{
+		{host: "a", body: "2024-01-02 03:04:05 ERROR boom\ncause", attributes: map[string]any{}},
+	}, records(sink))
+
+	// A line after the timeout starts a new record.
+	require.NoError(t, mp.ConsumeLogs(ctx, newLogs("a", "app", "more")))
+	require.NoError(t, mp.Shutdown(ctx))
+	assert.Len(t, records(sink), 3)
+}
+
+func TestMultilineProcessorMatchLimits(t *testing.T) {
+	mp, sink := newTestProcessor(t, func(cfg *Config) {
+		cfg.FirstLine = `^(a+)+b`
+		cfg.Format = `^(?<first>(a+)+c)`
+		cfg.RetryLimit = 1000
+	})
+	ctx := context.Background()
+
+	// The searches in the second line and in the record exceed the retry
+	// limit, so the line is appended and the record has no captures.
+	long := strings.Repeat("a", 30)
+	require.NoError(t, mp.ConsumeLogs(ctx, newLogs("a", "app", "ab", long)))
+	require.NoError(t, mp.Shutdown(ctx))
+	assert.Equal(t, []record{
+		{host: "a", body: "ab\n" + long, attributes: map[string]any{}},
+	}, records(sink))
+}
+
+// blockingConsumer blocks until unblock is closed, and then fails.
+type blockingConsumer struct {
+	consumertest.Consumer
+	started chan struct{}
+	unblock chan struct{}
+}
+
+func (c *blockingConsumer) ConsumeLogs(context.Context, plog.Logs) error {
+	close(c.started)
+	<-c.unblock
+	return errors.New("export failed")
+}
+
+func TestMultilineProcessorSlowConsumer(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.FirstLine = `^\d{4}-\d{2}-\d{2} `
+	next := &blockingConsumer{
+		Consumer: consumertest.NewNop(),
+		started:  make(chan struct{}),
+		unblock:  make(chan struct{}),
+	}
+	mp, err := newMultilineProcessor(cfg, zap.NewNop(), next)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	errs := make(chan error)
+	go func() {
+		errs <- mp.ConsumeLogs(ctx, newLogs("a", "app", 42))
+	}()
+	<-next.started
+
+	// Records which complete nothing are combined while the consumer is busy.
+	require.NoError(t, mp.ConsumeLogs(ctx, newLogs("a", "app", "2024-01-02 03:04:05 ERROR boom")))
+	close(next.unblock)
+	assert.EqualError(t, <-errs, "export failed")
+}
+
+// firstBlockingConsumer blocks its first call until unblock is closed, and
+// keeps the logs in the order they are received.
+type firstBlockingConsumer struct {
+	consumertest.LogsSink
+	called  atomic.Bool
+	started chan struct{}
+	unblock chan struct{}
+}
+
+func (c *firstBlockingConsumer) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	if !c.called.Swap(true) {
+		close(c.started)
+		<-c.unblock
+	}
+	return c.LogsSink.ConsumeLogs(ctx, ld)
+}
+
+func TestMultilineProcessorFlushOrder(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.FirstLine = `^\d{4}-\d{2}-\d{2} `
+	next := &firstBlockingConsumer{
+		started: make(chan struct{}),
+		unblock: make(chan struct{}),
+	}
+	mp, err := newMultilineProcessor(cfg, zap.NewNop(), next)
+	require.NoError(t, err)
+	ctx := context.Background()
+	now := time.Now()
+	mp.now = func() time.Time { return now }
+
+	require.NoError(t, mp.ConsumeLogs(ctx, newLogs("a", "app", "2024-01-02 03:04:05 ERROR boom", "cause")))
+	now = now.Add(defaultFlushTimeout)
+	flushErr := make(chan error)
+	go func() {
+		flushErr <- mp.flush(ctx, false)
+	}()
+	<-next.started
+
+	// The later records of the log wait for the expired one to be sent.
+	consumeErr := make(chan error)
+	go func() {
+		consumeErr <- mp.ConsumeLogs(ctx, newLogs("a", "app", "2024-01-02 03:04:06 INFO next", 42))
+	}()
+	assert.Never(t, func() bool {
+		return next.LogRecordCount() > 0
+	}, 50*time.Millisecond, 5*time.Millisecond)
+	close(next.unblock)
+	require.NoError(t, <-flushErr)
+	require.NoError(t, <-consumeErr)
+
+	assert.Equal(t, []record{
+		{host: "a", body: "2024-01-02 03:04:05 ERROR boom\ncause", attributes: map[string]any{}},
+		{host: "a", body: "2024-01-02 03:04:06 INFO next", attributes: map[string]any{}},
+		{host: "a", body: int64(42), attributes: map[string]any{}},
+	}, records(&next.LogsSink))
+}
+
+func TestMultilineProcessorBackgroundFlush(t *testing.T) {
+	for _, flushTimeout := range []time.Duration{10 * time.Millisecond, time.Nanosecond} {
+		t.Run(flushTimeout.String(), func(t *testing.T) {
+			mp, sink := newTestProcessor(t, func(cfg *Config) {
+				cfg.FlushTimeout = flushTimeout
+			})
+			ctx := context.Background()
+			require.NoError(t, mp.Start(ctx, componenttest.NewNopHost()))
+
+			require.NoError(t, mp.ConsumeLogs(ctx, newLogs("a", "app", "2024-01-02 03:04:05 ERROR boom", "cause")))
+			assert.Eventually(t, func() bool {
+				return len(records(sink)) >= 1
+			}, 5*time.Second, 5*time.Millisecond)
+			require.NoError(t, mp.Shutdown(ctx))
+			assert.True(t, strings.HasSuffix(records(sink)[0].body.(string), "\ncause"))
+		})
+	}
+}
@@ -26,8 +26,15 @@ var componentType component.Type = component.MustNewType("transform")
 // NewFactory create a factory for the transform processor.
 func NewFactory() processor.Factory {
 	return contribtransform.NewFactoryWithOptions(
-		contribtransform.WithLogFunctionsNew(contribtransform.DefaultLogFunctionsNew()),
-		// Add log functions defined in ottlfuncs.
-		contribtransform.WithLogFunctionsNew(ottlfuncs.LogFunctions()),
+		contribtransform.WithLogFunctions(contribtransform.DefaultLogFunctions()),
+		contribtransform.WithMetricFunctions(contribtransform.DefaultMetricFunctions()),
+		contribtransform.WithDataPointFunctions(contribtransform.DefaultDataPointFunctions()),
+		contribtransform.WithSpanFunctions(contribtransform.DefaultSpanFunctions()),
+		// Add functions defined in ottlfuncs. The transform processor doesn't
+		// support adding functions to the resource and scope contexts.
+		contribtransform.WithLogFunctions(ottlfuncs.LogFunctions()),
+		contribtransform.WithMetricFunctions(ottlfuncs.MetricFunctions()),
+		contribtransform.WithDataPointFunctions(ottlfuncs.DataPointFunctions()),
+		contribtransform.WithSpanFunctions(ottlfuncs.SpanFunctions()),
 	)
 }
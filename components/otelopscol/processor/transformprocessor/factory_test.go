@@ -16,11 +16,20 @@ package transformprocessor
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/processor/processortest"
 )
 
@@ -48,3 +57,139 @@ func TestCreateTraces(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, mp)
 }
+
+// ottlfuncsStatements returns statements using every function defined in
+// ottlfuncs on the given map path.
+func ottlfuncsStatements(path string) []any {
+	return []any{
+		fmt.Sprintf(`merge_maps(%[1]s, ExtractPatternsRubyRegex(%[1]s["line"], "^(?<greeting>\\w+)"), "upsert")`, path),
+		fmt.Sprintf(`set(%[1]s["matched"], IsMatchRubyRegex(%[1]s["line"], "(?<=hello )world"))`, path),
+		fmt.Sprintf(`set(%[1]s["values"], ToValues(%[1]s["records"]))`, path),
+		fmt.Sprintf(`replace_pattern_ruby_regex(%[1]s["line"], "(?<=hello )(?<name>\\w+)", "\\k<name>", ToUpperCase)`, path),
+		fmt.Sprintf(`replace_all_patterns_ruby_regex(%[1]s, "key", "^(rec)(?=ords)", "\\1_")`, path),
+	}
+}
+
+func putInput(m pcommon.Map) {
+	m.PutStr("line", "hello world")
+	records := m.PutEmptySlice("records")
+	records.AppendEmpty().SetEmptyMap().PutStr("first", "foo")
+	records.AppendEmpty().SetEmptyMap().PutStr("second", "bar")
+}
+
+func assertOutput(t *testing.T, m pcommon.Map) {
+	t.Helper()
+
+	expected := pcommon.NewMap()
+	expected.PutStr("line", "hello WORLD")
+	records := expected.PutEmptySlice("rec_ords")
+	records.AppendEmpty().SetEmptyMap().PutStr("first", "foo")
+	records.AppendEmpty().SetEmptyMap().PutStr("second", "bar")
+	expected.PutStr("greeting", "hello")
+	expected.PutBool("matched", true)
+	values := expected.PutEmptySlice("values")
+	values.AppendEmpty().SetStr("foo")
+	values.AppendEmpty().SetStr("bar")
+
+	assert.Equal(t, expected.AsRaw(), m.AsRaw())
+}
+
+func newConfig(t *testing.T, conf map[string]any) component.Config {
+	t.Helper()
+
+	cfg := NewFactory().CreateDefaultConfig()
+	require.NoError(t, confmap.NewFromStringMap(conf).Unmarshal(cfg))
+	require.NoError(t, cfg.(interface{ Validate() error }).Validate())
+	return cfg
+}
+
+func TestMetricsOTTLFunctions(t *testing.T) {
+	// The functions specific to each context must still be available along
+	// with the ones defined in ottlfuncs.
+	cfg := newConfig(t, map[string]any{
+		"metric_statements": []any{
+			map[string]any{"context": "metric", "statements": append(ottlfuncsStatements("metric.metadata"),
+				`convert_gauge_to_sum("cumulative", true) where metric.name == "gauge"`,
+			)},
+			map[string]any{"context": "datapoint", "statements": append(ottlfuncsStatements("datapoint.attributes"),
+				`convert_summary_count_val_to_sum("delta", false) where metric.name == "summary"`,
+			)},
+		},
+	})
+	sink := new(consumertest.MetricsSink)
+	mp, err := NewFactory().CreateMetrics(context.Background(), processortest.NewNopSettings(componentType), cfg, sink)
+	require.NoError(t, err)
+
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	metric := metrics.AppendEmpty()
+	metric.SetName("gauge")
+	putInput(metric.Metadata())
+	putInput(metric.SetEmptyGauge().DataPoints().AppendEmpty().Attributes())
+	summary := metrics.AppendEmpty()
+	summary.SetName("summary")
+	putInput(summary.Metadata())
+	dp := summary.SetEmptySummary().DataPoints().AppendEmpty()
+	dp.SetCount(5)
+	putInput(dp.Attributes())
+	require.NoError(t, mp.ConsumeMetrics(context.Background(), md))
+
+	require.Len(t, sink.AllMetrics(), 1)
+	metrics = sink.AllMetrics()[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 3, metrics.Len())
+	metric = metrics.At(0)
+	assertOutput(t, metric.Metadata())
+	require.Equal(t, pmetric.MetricTypeSum, metric.Type())
+	assert.True(t, metric.Sum().IsMonotonic())
+	assertOutput(t, metric.Sum().DataPoints().At(0).Attributes())
+	count := metrics.At(2)
+	assert.Equal(t, "summary_count", count.Name())
+	require.Equal(t, pmetric.MetricTypeSum, count.Type())
+	assert.Equal(t, int64(5), count.Sum().DataPoints().At(0).IntValue())
+}
+
+func TestLogsOTTLFunctions(t *testing.T) {
+	cfg := newConfig(t, map[string]any{
+		"log_statements": []any{
+			map[string]any{"context": "log", "statements": append(ottlfuncsStatements("log.attributes"),
+				`set(log.time, ParseFluentdTime(log.body, "%d/%b/%Y:%H:%M:%S %z"))`,
+			)},
+		},
+	})
+	sink := new(consumertest.LogsSink)
+	lp, err := NewFactory().CreateLogs(context.Background(), processortest.NewNopSettings(componentType), cfg, sink)
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	record := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	record.Body().SetStr("10/Oct/2023:13:55:36 +0000")
+	putInput(record.Attributes())
+	require.NoError(t, lp.ConsumeLogs(context.Background(), ld))
+
+	require.Len(t, sink.AllLogs(), 1)
+	record = sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assertOutput(t, record.Attributes())
+	assert.Equal(t, time.Date(2023, time.October, 10, 13, 55, 36, 0, time.UTC), record.Timestamp().AsTime())
+}
+
+func TestTracesOTTLFunctions(t *testing.T) {
+	cfg := newConfig(t, map[string]any{
+		"trace_statements": []any{
+			map[string]any{"context": "span", "statements": append(ottlfuncsStatements("span.attributes"),
+				`set(span.name, "root") where IsRootSpan()`,
+			)},
+		},
+	})
+	sink := new(consumertest.TracesSink)
+	tp, err := NewFactory().CreateTraces(context.Background(), processortest.NewNopSettings(componentType), cfg, sink)
+	require.NoError(t, err)
+
+	td := ptrace.NewTraces()
+	putInput(td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().Attributes())
+	require.NoError(t, tp.ConsumeTraces(context.Background(), td))
+
+	require.Len(t, sink.AllTraces(), 1)
+	span := sink.AllTraces()[0].ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+	assertOutput(t, span.Attributes())
+	assert.Equal(t, "root", span.Name())
+}
@@ -18,9 +18,11 @@
 package dcgmreceiver
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
 	"math"
+	"slices"
 	"time"
 
 	"github.com/NVIDIA/go-dcgm/pkg/dcgm"
@@ -45,14 +47,46 @@ type deviceMetrics struct {
 	ModelName string
 	UUID      string
 	Metrics   MetricsMap
+	// XIDErrors counts the XID errors seen since the client was created, by
+	// XID code.
+	XIDErrors map[int64]int64
+	// Instances are the metrics of the MIG instances of the device, by DCGM
+	// entity.
+	Instances map[dcgm.GroupEntityPair]migInstanceMetrics
+}
+
+// migInstance is a MIG GPU instance or compute instance discovered in the
+// DCGM entity hierarchy.
+type migInstance struct {
+	Entity dcgm.GroupEntityPair
+	// GPUIndex is the DCGM GPU ID of the device the instance is on.
+	GPUIndex uint
+	// Slices is the number of slices of the instance, e.g. 3g for a GPU
+	// instance or 1c.3g for a compute instance. DCGM doesn't report the
+	// memory size of the MIG profile.
+	Slices        string
+	GPUInstanceID uint
+	// ComputeInstanceID is only set on compute instances.
+	ComputeInstanceID uint
+}
+
+func (i migInstance) isComputeInstance() bool {
+	return i.Entity.EntityGroupId == dcgm.FE_GPU_CI
+}
+
+type migInstanceMetrics struct {
+	migInstance
+	Metrics MetricsMap
 }
 
 type dcgmClient struct {
 	logger            *zap.SugaredLogger
+	api               dcgmAPI
 	handleCleanup     func()
 	enabledFieldIDs   []dcgm.Short
 	enabledFieldGroup dcgm.FieldHandle
 	deviceGroup       dcgm.GroupHandle
+	migInstances      map[dcgm.GroupEntityPair]migInstance
 
 	devices            map[uint]deviceMetrics
 	lastSuccessfulPoll time.Time
@@ -70,14 +104,87 @@ var dcgmInit = func(args ...string) (func(), error) {
 
 var dcgmGetValuesSince = dcgm.GetValuesSince
 
-func newClient(settings *dcgmClientSettings, logger *zap.Logger) (*dcgmClient, error) {
-	dcgmCleanup, err := initializeDcgm(settings.endpoint, logger)
+// dcgmAPI is the part of the DCGM library used by the client, so that it can
+// be faked in tests.
+type dcgmAPI interface {
+	Init(args ...string) (func(), error)
+	GetSupportedDevices() ([]uint, error)
+	GetDeviceInfo(gpuIndex uint) (dcgm.Device, error)
+	GetSupportedMetricGroups(gpuIndex uint) ([]dcgm.MetricGroup, error)
+	GetGpuInstanceHierarchy() (dcgm.MigHierarchy_v2, error)
+	CreateGroup(groupName string) (dcgm.GroupHandle, error)
+	AddToGroup(group dcgm.GroupHandle, gpuIndex uint) error
+	AddEntityToGroup(group dcgm.GroupHandle, entityGroupID dcgm.Field_Entity_Group, entityID uint) error
+	DestroyGroup(group dcgm.GroupHandle) error
+	FieldGroupCreate(fieldGroupName string, fieldIDs []dcgm.Short) (dcgm.FieldHandle, error)
+	FieldGroupDestroy(fieldGroup dcgm.FieldHandle) error
+	WatchFieldsWithGroupEx(fieldGroup dcgm.FieldHandle, group dcgm.GroupHandle, updateFreqUs int64, maxKeepTime float64, maxKeepSamples int32) error
+	GetValuesSince(group dcgm.GroupHandle, fieldGroup dcgm.FieldHandle, since time.Time) ([]dcgm.FieldValue_v2, time.Time, error)
+}
+
+// dcgmLibrary implements dcgmAPI with the DCGM library.
+type dcgmLibrary struct{}
+
+func (dcgmLibrary) Init(args ...string) (func(), error) {
+	return dcgmInit(args...)
+}
+
+func (dcgmLibrary) GetSupportedDevices() ([]uint, error) {
+	return dcgm.GetSupportedDevices()
+}
+
+func (dcgmLibrary) GetDeviceInfo(gpuIndex uint) (dcgm.Device, error) {
+	return dcgm.GetDeviceInfo(gpuIndex)
+}
+
+func (dcgmLibrary) GetSupportedMetricGroups(gpuIndex uint) ([]dcgm.MetricGroup, error) {
+	return dcgm.GetSupportedMetricGroups(gpuIndex)
+}
+
+func (dcgmLibrary) GetGpuInstanceHierarchy() (dcgm.MigHierarchy_v2, error) {
+	return dcgm.GetGpuInstanceHierarchy()
+}
+
+func (dcgmLibrary) CreateGroup(groupName string) (dcgm.GroupHandle, error) {
+	return dcgm.CreateGroup(groupName)
+}
+
+func (dcgmLibrary) AddToGroup(group dcgm.GroupHandle, gpuIndex uint) error {
+	return dcgm.AddToGroup(group, gpuIndex)
+}
+
+func (dcgmLibrary) AddEntityToGroup(group dcgm.GroupHandle, entityGroupID dcgm.Field_Entity_Group, entityID uint) error {
+	return dcgm.AddEntityToGroup(group, entityGroupID, entityID)
+}
+
+func (dcgmLibrary) DestroyGroup(group dcgm.GroupHandle) error {
+	return dcgm.DestroyGroup(group)
+}
+
+func (dcgmLibrary) FieldGroupCreate(fieldGroupName string, fieldIDs []dcgm.Short) (dcgm.FieldHandle, error) {
+	return dcgm.FieldGroupCreate(fieldGroupName, fieldIDs)
+}
+
+func (dcgmLibrary) FieldGroupDestroy(fieldGroup dcgm.FieldHandle) error {
+	return dcgm.FieldGroupDestroy(fieldGroup)
+}
+
+func (dcgmLibrary) WatchFieldsWithGroupEx(fieldGroup dcgm.FieldHandle, group dcgm.GroupHandle, updateFreqUs int64, maxKeepTime float64, maxKeepSamples int32) error {
+	return dcgm.WatchFieldsWithGroupEx(fieldGroup, group, updateFreqUs, maxKeepTime, maxKeepSamples)
+}
+
+func (dcgmLibrary) GetValuesSince(group dcgm.GroupHandle, fieldGroup dcgm.FieldHandle, since time.Time) ([]dcgm.FieldValue_v2, time.Time, error) {
+	return dcgmGetValuesSince(group, fieldGroup, since)
+}
+
+func newClient(settings *dcgmClientSettings, api dcgmAPI, logger *zap.Logger) (*dcgmClient, error) {
+	dcgmCleanup, err := initializeDcgm(api, settings.endpoint, logger)
 	if err != nil {
 		return nil, errors.Join(ErrDcgmInitialization, err)
 	}
 	enabledFieldGroup := dcgm.FieldHandle{}
 	requestedFieldIDs := toFieldIDs(settings.fields)
-	supportedProfilingFieldIDs, err := getSupportedProfilingFields()
+	supportedProfilingFieldIDs, err := getSupportedProfilingFields(api)
 	if err != nil {
 		// If there is error querying the supported fields at all, let the
 		// receiver collect basic metrics: (GPU utilization, used/free memory).
@@ -88,29 +195,40 @@ func newClient(settings *dcgmClientSettings, logger *zap.Logger) (*dcgmClient, e
 		logger.Sugar().Warnf("Field '%s' is not supported", dcgmIDToName[f])
 	}
 	var deviceGroup dcgm.GroupHandle
+	migInstances := map[dcgm.GroupEntityPair]migInstance{}
 	if len(enabledFields) != 0 {
-		supportedDeviceIndices, err := dcgm.GetSupportedDevices()
+		supportedDeviceIndices, err := api.GetSupportedDevices()
 		if err != nil {
 			return nil, fmt.Errorf("Unable to discover supported GPUs on %w", err)
 		}
 		logger.Sugar().Infof("Discovered %d supported GPU devices", len(supportedDeviceIndices))
 
-		deviceGroup, err = createDeviceGroup(logger, supportedDeviceIndices)
+		migInstances, err = discoverMigInstances(api, supportedDeviceIndices)
+		if err != nil {
+			// MIG instances are optional; keep collecting device metrics.
+			logger.Sugar().Warnf("Unable to discover MIG instances on '%v'. MIG instance metrics will not be collected.", err)
+		} else if len(migInstances) != 0 {
+			logger.Sugar().Infof("Discovered %d MIG instances", len(migInstances))
+		}
+
+		deviceGroup, err = createDeviceGroup(api, logger, supportedDeviceIndices, migInstances)
 		if err != nil {
 			return nil, err
 		}
-		enabledFieldGroup, err = setWatchesOnEnabledFields(settings.pollingInterval, logger, deviceGroup, enabledFields)
+		enabledFieldGroup, err = setWatchesOnEnabledFields(api, settings.pollingInterval, logger, deviceGroup, enabledFields)
 		if err != nil {
-			_ = dcgm.FieldGroupDestroy(enabledFieldGroup)
+			_ = api.FieldGroupDestroy(enabledFieldGroup)
 			return nil, fmt.Errorf("Unable to set field watches on %w", err)
 		}
 	}
 	return &dcgmClient{
 		logger:                         logger.Sugar(),
+		api:                            api,
 		handleCleanup:                  dcgmCleanup,
 		enabledFieldIDs:                enabledFields,
 		enabledFieldGroup:              enabledFieldGroup,
 		deviceGroup:                    deviceGroup,
+		migInstances:                   migInstances,
 		devices:                        map[uint]deviceMetrics{},
 		lastSuccessfulPoll:             time.Now(),
 		deviceMetricToFailedQueryCount: make(map[string]int),
@@ -122,9 +240,9 @@ func newClient(settings *dcgmClientSettings, logger *zap.Logger) (*dcgmClient, e
 
 // initializeDcgm tries to initialize a DCGM connection; returns a cleanup func
 // only if the connection is initialized successfully without error
-func initializeDcgm(endpoint string, logger *zap.Logger) (func(), error) {
+func initializeDcgm(api dcgmAPI, endpoint string, logger *zap.Logger) (func(), error) {
 	isSocket := "0"
-	dcgmCleanup, err := dcgmInit(endpoint, isSocket)
+	dcgmCleanup, err := api.Init(endpoint, isSocket)
 	if err != nil {
 		msg := fmt.Sprintf("Unable to connect to DCGM daemon at %s on %v; Is the DCGM daemon running?", endpoint, err)
 		logger.Sugar().Warn(msg)
@@ -137,8 +255,8 @@ func initializeDcgm(endpoint string, logger *zap.Logger) (func(), error) {
 	return dcgmCleanup, nil
 }
 
-func newDeviceMetrics(logger *zap.SugaredLogger, gpuIndex uint) (deviceMetrics, error) {
-	deviceInfo, err := dcgm.GetDeviceInfo(gpuIndex)
+func newDeviceMetrics(logger *zap.SugaredLogger, api dcgmAPI, gpuIndex uint) (deviceMetrics, error) {
+	deviceInfo, err := api.GetDeviceInfo(gpuIndex)
 	if err != nil {
 		logger.Warnf("Unable to query device info for NVIDIA device %d on '%w'", gpuIndex, err)
 		return deviceMetrics{}, err
@@ -148,24 +266,87 @@ func newDeviceMetrics(logger *zap.SugaredLogger, gpuIndex uint) (deviceMetrics,
 		ModelName: deviceInfo.Identifiers.Model,
 		UUID:      deviceInfo.UUID,
 		Metrics:   MetricsMap{},
+		XIDErrors: map[int64]int64{},
+		Instances: map[dcgm.GroupEntityPair]migInstanceMetrics{},
 	}
 	logger.Infof("Discovered NVIDIA device %s with UUID %s (DCGM GPU ID %d)", device.ModelName, device.UUID, gpuIndex)
 	return device, nil
 }
 
-func createDeviceGroup(logger *zap.Logger, deviceIndices []uint) (dcgm.GroupHandle, error) {
+// discoverMigInstances returns the MIG GPU instances and compute instances of
+// the given devices, by DCGM entity. It returns no instances if MIG is not
+// enabled.
+func discoverMigInstances(api dcgmAPI, deviceIndices []uint) (map[dcgm.GroupEntityPair]migInstance, error) {
+	hierarchy, err := api.GetGpuInstanceHierarchy()
+	if err != nil {
+		return map[dcgm.GroupEntityPair]migInstance{}, err
+	}
+	entities := hierarchy.EntityList[:min(hierarchy.Count, uint(len(hierarchy.EntityList)))]
+
+	instances := map[dcgm.GroupEntityPair]migInstance{}
+	// Compute instances are children of GPU instances, which are children of
+	// devices, so GPU instances are resolved first.
+	for _, e := range entities {
+		if e.Entity.EntityGroupId != dcgm.FE_GPU_I || e.Parent.EntityGroupId != dcgm.FE_GPU || !slices.Contains(deviceIndices, e.Parent.EntityId) {
+			continue
+		}
+		instances[e.Entity] = migInstance{
+			Entity:        e.Entity,
+			GPUIndex:      e.Parent.EntityId,
+			Slices:        fmt.Sprintf("%dg", e.Info.NvmlProfileSlices),
+			GPUInstanceID: e.Info.NvmlInstanceId,
+		}
+	}
+	for _, e := range entities {
+		if e.Entity.EntityGroupId != dcgm.FE_GPU_CI {
+			continue
+		}
+		parent, ok := instances[e.Parent]
+		if !ok {
+			continue
+		}
+		instances[e.Entity] = migInstance{
+			Entity:            e.Entity,
+			GPUIndex:          parent.GPUIndex,
+			Slices:            fmt.Sprintf("%dc.%s", e.Info.NvmlProfileSlices, parent.Slices),
+			GPUInstanceID:     parent.GPUInstanceID,
+			ComputeInstanceID: e.Info.NvmlComputeInstanceId,
+		}
+	}
+	return instances, nil
+}
+
+// sortedMigEntities returns the entities of instances, GPU instances first.
+func sortedMigEntities[T any](instances map[dcgm.GroupEntityPair]T) []dcgm.GroupEntityPair {
+	entities := make([]dcgm.GroupEntityPair, 0, len(instances))
+	for entity := range instances {
+		entities = append(entities, entity)
+	}
+	slices.SortFunc(entities, func(a, b dcgm.GroupEntityPair) int {
+		return cmp.Or(cmp.Compare(a.EntityGroupId, b.EntityGroupId), cmp.Compare(a.EntityId, b.EntityId))
+	})
+	return entities
+}
+
+func createDeviceGroup(api dcgmAPI, logger *zap.Logger, deviceIndices []uint, migInstances map[dcgm.GroupEntityPair]migInstance) (dcgm.GroupHandle, error) {
 	deviceGroupName := "google-cloud-ops-agent-group"
-	deviceGroup, err := dcgm.CreateGroup(deviceGroupName)
+	deviceGroup, err := api.CreateGroup(deviceGroupName)
 	if err != nil {
 		return dcgm.GroupHandle{}, fmt.Errorf("Unable to create DCGM GPU group '%s' on %w", deviceGroupName, err)
 	}
 
 	for _, gpuIndex := range deviceIndices {
-		err = dcgm.AddToGroup(deviceGroup, gpuIndex)
+		err = api.AddToGroup(deviceGroup, gpuIndex)
 		if err != nil {
 			return dcgm.GroupHandle{}, fmt.Errorf("Unable add NVIDIA device %d to GPU group '%s' on %w", gpuIndex, deviceGroupName, err)
 		}
 	}
+	for _, entity := range sortedMigEntities(migInstances) {
+		err = api.AddEntityToGroup(deviceGroup, entity.EntityGroupId, entity.EntityId)
+		if err != nil {
+			return dcgm.GroupHandle{}, fmt.Errorf("Unable add %s %d to GPU group '%s' on %w", entity.EntityGroupId, entity.EntityId, deviceGroupName, err)
+		}
+	}
 
 	logger.Sugar().Infof("Created GPU group '%s'", deviceGroupName)
 	return deviceGroup, nil
@@ -181,13 +362,13 @@ func toFieldIDs(fields []string) []dcgm.Short {
 
 // getSupportedProfilingFields calls the DCGM query function to find out all
 // profiling fields that are supported by the current GPUs
-func getSupportedProfilingFields() ([]dcgm.Short, error) {
+func getSupportedProfilingFields(api dcgmAPI) ([]dcgm.Short, error) {
 	supported := []dcgm.Short{}
 	// GetSupportedMetricGroups currently does not support passing the actual
 	// group handle; here we pass 0 to query supported fields for group 0, which
 	// is the default DCGM group that is **supposed** to include all GPUs of the
 	// host.
-	fieldGroups, err := dcgm.GetSupportedMetricGroups(0)
+	fieldGroups, err := api.GetSupportedMetricGroups(0)
 	if err != nil {
 		var dcgmErr *dcgm.DcgmError
 		if errors.As(err, &dcgmErr) {
@@ -243,10 +424,10 @@ type dcgmWatchParams struct {
 }
 
 // Internal-only
-func setWatchesOnFields(logger *zap.Logger, deviceGroup dcgm.GroupHandle, fieldIDs []dcgm.Short, params dcgmWatchParams) (dcgm.FieldHandle, error) {
+func setWatchesOnFields(api dcgmAPI, logger *zap.Logger, deviceGroup dcgm.GroupHandle, fieldIDs []dcgm.Short, params dcgmWatchParams) (dcgm.FieldHandle, error) {
 	var err error
 
-	fieldGroup, err := dcgm.FieldGroupCreate(params.fieldGroupName, fieldIDs)
+	fieldGroup, err := api.FieldGroupCreate(params.fieldGroupName, fieldIDs)
 	if err != nil {
 		return dcgm.FieldHandle{}, fmt.Errorf("Unable to create DCGM field group '%s'", params.fieldGroupName)
 	}
@@ -261,7 +442,7 @@ func setWatchesOnFields(logger *zap.Logger, deviceGroup dcgm.GroupHandle, fieldI
 	dcgmUpdateFreq := params.updateFreqUs
 	dcgmMaxKeepTime := params.maxKeepTime
 	dcgmMaxKeepSamples := params.maxKeepSamples
-	err = dcgm.WatchFieldsWithGroupEx(fieldGroup, deviceGroup, dcgmUpdateFreq, dcgmMaxKeepTime, dcgmMaxKeepSamples)
+	err = api.WatchFieldsWithGroupEx(fieldGroup, deviceGroup, dcgmUpdateFreq, dcgmMaxKeepTime, dcgmMaxKeepSamples)
 	if err != nil {
 		return fieldGroup, fmt.Errorf("Setting watches for DCGM field group '%s' failed on %w", params.fieldGroupName, err)
 	}
@@ -272,8 +453,8 @@ func setWatchesOnFields(logger *zap.Logger, deviceGroup dcgm.GroupHandle, fieldI
 
 const maxKeepSamples = 100 // TODO: Is this enough?
 
-func setWatchesOnEnabledFields(pollingInterval time.Duration, logger *zap.Logger, deviceGroup dcgm.GroupHandle, enabledFieldIDs []dcgm.Short) (dcgm.FieldHandle, error) {
-	return setWatchesOnFields(logger, deviceGroup, enabledFieldIDs, dcgmWatchParams{
+func setWatchesOnEnabledFields(api dcgmAPI, pollingInterval time.Duration, logger *zap.Logger, deviceGroup dcgm.GroupHandle, enabledFieldIDs []dcgm.Short) (dcgm.FieldHandle, error) {
+	return setWatchesOnFields(api, logger, deviceGroup, enabledFieldIDs, dcgmWatchParams{
 		// Note: Add random suffix to avoid conflict amongnst any parallel collectors
 		fieldGroupName: fmt.Sprintf("google-cloud-ops-agent-metrics-%d", randSource.Intn(10000)),
 		// Note: DCGM retained samples = Max(maxKeepSamples, maxKeepTime/updateFreq)
@@ -284,8 +465,8 @@ func setWatchesOnEnabledFields(pollingInterval time.Duration, logger *zap.Logger
 }
 
 func (client *dcgmClient) cleanup() {
-	_ = dcgm.FieldGroupDestroy(client.enabledFieldGroup)
-	_ = dcgm.DestroyGroup(client.deviceGroup)
+	_ = client.api.FieldGroupDestroy(client.enabledFieldGroup)
+	_ = client.api.DestroyGroup(client.deviceGroup)
 	if client.handleCleanup != nil {
 		client.handleCleanup()
 	}
@@ -301,7 +482,7 @@ func (client *dcgmClient) collect() (time.Duration, error) {
 		// Make sure we don't try to scrape without a device group (since we don't construct one when there are no enabled fields).
 		return 0, nil
 	}
-	fieldValues, pollTime, err := dcgmGetValuesSince(client.deviceGroup, client.enabledFieldGroup, client.lastSuccessfulPoll)
+	fieldValues, pollTime, err := client.api.GetValuesSince(client.deviceGroup, client.enabledFieldGroup, client.lastSuccessfulPoll)
 	if err != nil {
 		msg := fmt.Sprintf("Unable to poll DCGM daemon for metrics: %s", err)
 		client.issueWarningForFailedQueryUptoThreshold("all-profiling-metrics", maxWarningsForFailedDeviceMetricQuery, msg)
@@ -312,28 +493,52 @@ func (client *dcgmClient) collect() (time.Duration, error) {
 	oldestTs := int64(math.MaxInt64)
 	newestTs := int64(0)
 	for _, fieldValue := range fieldValues {
-		if fieldValue.EntityGroupId != dcgm.FE_GPU {
+		var gpuIndex uint
+		var instance migInstance
+		isMigInstance := false
+		switch fieldValue.EntityGroupId {
+		case dcgm.FE_GPU:
+			gpuIndex = fieldValue.EntityId
+		case dcgm.FE_GPU_I, dcgm.FE_GPU_CI:
+			instance, isMigInstance = client.migInstances[dcgm.GroupEntityPair{EntityGroupId: fieldValue.EntityGroupId, EntityId: fieldValue.EntityId}]
+			if !isMigInstance {
+				continue
+			}
+			gpuIndex = instance.GPUIndex
+		default:
 			continue
 		}
-		gpuIndex := fieldValue.EntityId
 		if _, ok := client.devices[gpuIndex]; !ok {
-			device, err := newDeviceMetrics(client.logger, gpuIndex)
+			device, err := newDeviceMetrics(client.logger, client.api, gpuIndex)
 			if err != nil {
 				continue
 			}
 			client.devices[gpuIndex] = device
 		}
 		device := client.devices[gpuIndex]
+		metrics := device.Metrics
+		entityName := fmt.Sprintf("device%d", gpuIndex)
+		if isMigInstance {
+			if _, ok := device.Instances[instance.Entity]; !ok {
+				device.Instances[instance.Entity] = migInstanceMetrics{migInstance: instance, Metrics: MetricsMap{}}
+			}
+			metrics = device.Instances[instance.Entity].Metrics
+			entityName = fmt.Sprintf("%s.%s%d", entityName, instance.Entity.EntityGroupId, instance.Entity.EntityId)
+		}
 		dcgmName := dcgmIDToName[dcgm.Short(fieldValue.FieldId)]
 		if err := isValidValue(fieldValue); err == errBlankValue {
 			// Blank values are expected at startup.
 			continue
 		} else if err == errNotSupported {
-			client.issueWarningForFailedQueryUptoThreshold(dcgmName, 1, fmt.Sprintf("Field '%s' is not supported", dcgmName))
+			// Most device fields are not supported on MIG instances, so only
+			// warn about devices.
+			if !isMigInstance {
+				client.issueWarningForFailedQueryUptoThreshold(dcgmName, 1, fmt.Sprintf("Field '%s' is not supported", dcgmName))
+			}
 			continue
 		} else if err != nil {
-			msg := fmt.Sprintf("Received invalid value (ts %d gpu %d) %s: %v", fieldValue.Ts, gpuIndex, dcgmName, err)
-			client.issueWarningForFailedQueryUptoThreshold(fmt.Sprintf("device%d.%s", gpuIndex, dcgmName), maxWarningsForFailedDeviceMetricQuery, msg)
+			msg := fmt.Sprintf("Received invalid value (ts %d %s) %s: %v", fieldValue.Ts, entityName, dcgmName, err)
+			client.issueWarningForFailedQueryUptoThreshold(fmt.Sprintf("%s.%s", entityName, dcgmName), maxWarningsForFailedDeviceMetricQuery, msg)
 			continue
 		}
 		if fieldValue.Ts < oldestTs {
@@ -342,10 +547,13 @@ func (client *dcgmClient) collect() (time.Duration, error) {
 		if fieldValue.Ts > newestTs {
 			newestTs = fieldValue.Ts
 		}
-		if _, ok := device.Metrics[dcgmName]; !ok {
-			device.Metrics[dcgmName] = &metricStats{}
+		if fieldValue.FieldId == dcgm.DCGM_FI_DEV_XID_ERRORS && !isMigInstance {
+			countXIDError(device, fieldValue)
 		}
-		device.Metrics[dcgmName].Update(fieldValue)
+		if _, ok := metrics[dcgmName]; !ok {
+			metrics[dcgmName] = &metricStats{}
+		}
+		metrics[dcgmName].Update(fieldValue)
 	}
 	duration := time.Duration(newestTs-oldestTs) * time.Microsecond
 	client.logger.Debugf("Successful poll of DCGM daemon returned %v of data", duration)
@@ -354,17 +562,37 @@ func (client *dcgmClient) collect() (time.Duration, error) {
 	return duration, nil
 }
 
+// countXIDError counts the XID error reported by fieldValue. Each XID error is
+// reported as a sample of DCGM_FI_DEV_XID_ERRORS holding its code, so samples
+// that were already seen are skipped.
+func countXIDError(device deviceMetrics, fieldValue dcgm.FieldValue_v2) {
+	if last, ok := device.Metrics["DCGM_FI_DEV_XID_ERRORS"]; ok && last.lastFieldValue != nil && last.lastFieldValue.Ts >= fieldValue.Ts {
+		return
+	}
+	xid, ok := asInt64(fieldValue)
+	if !ok || xid <= 0 {
+		return
+	}
+	device.XIDErrors[xid]++
+}
+
 // getDeviceMetrics returns a deep copy of client.devices
 func (client *dcgmClient) getDeviceMetrics() map[uint]deviceMetrics {
 	out := map[uint]deviceMetrics{}
 	for gpuIndex, device := range client.devices {
-		newMetrics := MetricsMap{}
-		for key, value := range device.Metrics {
-			newValue := *value
-			newMetrics[key] = &newValue
+		newXIDErrors := make(map[int64]int64, len(device.XIDErrors))
+		for xid, count := range device.XIDErrors {
+			newXIDErrors[xid] = count
+		}
+		newInstances := make(map[dcgm.GroupEntityPair]migInstanceMetrics, len(device.Instances))
+		for entity, instance := range device.Instances {
+			instance.Metrics = instance.Metrics.copy()
+			newInstances[entity] = instance
 		}
 		// device is already a copy here
-		device.Metrics = newMetrics
+		device.Metrics = device.Metrics.copy()
+		device.XIDErrors = newXIDErrors
+		device.Instances = newInstances
 		out[gpuIndex] = device
 	}
 	return out
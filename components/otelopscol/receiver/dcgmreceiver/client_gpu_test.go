@@ -66,12 +66,12 @@ func defaultClientSettings() *dcgmClientSettings {
 // model
 func TestSupportedProfilingFieldsWithGolden(t *testing.T) {
 	clientSettings := defaultClientSettings()
-	client, err := newClient(clientSettings, zaptest.NewLogger(t))
+	client, err := newClient(clientSettings, dcgmLibrary{}, zaptest.NewLogger(t))
 	require.Nil(t, err, "cannot initialize DCGM. Install and run DCGM before running tests.")
 	defer client.cleanup()
 
 	allFields := toFieldIDs(clientSettings.fields)
-	supportedProfilingFields, err := getSupportedProfilingFields()
+	supportedProfilingFields, err := getSupportedProfilingFields(dcgmLibrary{})
 	require.Nil(t, err)
 	enabledFields, unavailableFields := filterSupportedFields(allFields, supportedProfilingFields)
 
@@ -135,7 +135,7 @@ func getModelGoldenFilePath(t *testing.T, model string) string {
 }
 
 func TestNewDcgmClientWithGpuPresent(t *testing.T) {
-	client, err := newClient(defaultClientSettings(), zaptest.NewLogger(t))
+	client, err := newClient(defaultClientSettings(), dcgmLibrary{}, zaptest.NewLogger(t))
 	require.Nil(t, err, "cannot initialize DCGM. Install and run DCGM before running tests.")
 
 	assert.NotNil(t, client)
@@ -145,7 +145,7 @@ func TestNewDcgmClientWithGpuPresent(t *testing.T) {
 
 func TestCollectGpuProfilingMetrics(t *testing.T) {
 	clientSettings := defaultClientSettings()
-	client, err := newClient(clientSettings, zaptest.NewLogger(t))
+	client, err := newClient(clientSettings, dcgmLibrary{}, zaptest.NewLogger(t))
 	defer client.cleanup()
 	require.Nil(t, err, "cannot initialize DCGM. Install and run DCGM before running tests.")
 	var maxCollectionInterval = 60 * time.Second
@@ -22,8 +22,11 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/NVIDIA/go-dcgm/pkg/dcgm"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"go.uber.org/zap/zaptest"
@@ -44,9 +47,267 @@ func TestNewDcgmClientOnInitializationError(t *testing.T) {
 		return nil
 	})))
 
-	client, err := newClient(&dcgmClientSettings{endpoint: defaultEndpoint}, logger)
+	client, err := newClient(&dcgmClientSettings{endpoint: defaultEndpoint}, dcgmLibrary{}, logger)
 	assert.Equal(t, seenDcgmConnectionWarning, true)
 	assert.True(t, errors.Is(err, ErrDcgmInitialization))
 	assert.Regexp(t, ".*Unable to connect.*", err)
 	assert.Nil(t, client)
 }
+
+func newFakeClient(t *testing.T, fake *fakeDcgm, fields ...string) *dcgmClient {
+	client, err := newClient(&dcgmClientSettings{
+		endpoint:        defaultEndpoint,
+		pollingInterval: fake.sampleInterval,
+		fields:          fields,
+	}, fake, zaptest.NewLogger(t))
+	require.NoError(t, err)
+	return client
+}
+
+func TestNewClientWithFakeDcgm(t *testing.T) {
+	fake := newFakeDcgm(t, dcgm.Device{UUID: "GPU-0"}, dcgm.Device{UUID: "GPU-1"})
+	fake.supportedProfilingFields = []dcgm.Short{dcgm.DCGM_FI_PROF_SM_ACTIVE}
+
+	client := newFakeClient(t, fake, "DCGM_FI_DEV_GPU_UTIL", "DCGM_FI_PROF_SM_ACTIVE", "DCGM_FI_PROF_SM_OCCUPANCY")
+	assert.Equal(t, []dcgm.Short{dcgm.DCGM_FI_DEV_GPU_UTIL, dcgm.DCGM_FI_PROF_SM_ACTIVE}, client.enabledFieldIDs)
+	assert.Equal(t, []dcgm.GroupEntityPair{{EntityGroupId: dcgm.FE_GPU, EntityId: 0}, {EntityGroupId: dcgm.FE_GPU, EntityId: 1}}, fake.groups[client.deviceGroup])
+	assert.Equal(t, client.enabledFieldIDs, fake.fieldGroups[client.enabledFieldGroup])
+	assert.Equal(t, int64(time.Second/time.Microsecond), fake.watches[client.enabledFieldGroup])
+
+	client.cleanup()
+	assert.True(t, fake.isClean())
+}
+
+func TestNewClientWithoutProfilingSupport(t *testing.T) {
+	fake := newFakeDcgm(t, dcgm.Device{UUID: "GPU-0"})
+	fake.profilingErr = errors.New("profiling module not loaded")
+
+	client := newFakeClient(t, fake, "DCGM_FI_DEV_GPU_UTIL", "DCGM_FI_PROF_SM_ACTIVE")
+	assert.Equal(t, []dcgm.Short{dcgm.DCGM_FI_DEV_GPU_UTIL}, client.enabledFieldIDs)
+	client.cleanup()
+}
+
+func TestCollectWithFakeDcgm(t *testing.T) {
+	fake := newFakeDcgm(t, dcgm.Device{UUID: "GPU-0", Identifiers: dcgm.DeviceIdentifiers{Model: "NVIDIA L4"}})
+	fake.values[dcgm.DCGM_FI_DEV_GPU_UTIL] = func(_ dcgm.GroupEntityPair, sample int) any {
+		if sample == 0 {
+			// Fields are blank until DCGM has sampled them.
+			return dcgm.DCGM_FT_INT64_BLANK
+		}
+		return int64(10 * sample)
+	}
+	fake.values[dcgm.DCGM_FI_DEV_FB_USED] = func(dcgm.GroupEntityPair, int) any {
+		return dcgm.DCGM_FT_INT64_NOT_PERMISSIONED
+	}
+	fake.values[dcgm.DCGM_FI_DEV_TOTAL_ENERGY_CONSUMPTION] = func(_ dcgm.GroupEntityPair, sample int) any {
+		return int64(1000 + 500*sample)
+	}
+	fake.values[dcgm.DCGM_FI_DEV_POWER_USAGE] = func(dcgm.GroupEntityPair, int) any {
+		return 75.0
+	}
+
+	client := newFakeClient(t, fake, "DCGM_FI_DEV_GPU_UTIL", "DCGM_FI_DEV_FB_USED", "DCGM_FI_DEV_TOTAL_ENERGY_CONSUMPTION", "DCGM_FI_DEV_POWER_USAGE")
+	defer client.cleanup()
+
+	_, err := client.collect()
+	require.NoError(t, err)
+	metrics := client.getDeviceMetrics()[0].Metrics
+	_, ok := metrics.LastFloat64("DCGM_FI_DEV_GPU_UTIL")
+	assert.False(t, ok)
+
+	for i := 0; i < 3; i++ {
+		_, err = client.collect()
+		require.NoError(t, err)
+	}
+	device := client.getDeviceMetrics()[0]
+	assert.Equal(t, "GPU-0", device.UUID)
+	assert.Equal(t, "NVIDIA L4", device.ModelName)
+
+	util, ok := device.Metrics.LastFloat64("DCGM_FI_DEV_GPU_UTIL")
+	assert.True(t, ok)
+	assert.Equal(t, 30.0, util)
+	// Samples without permission are dropped.
+	_, ok = device.Metrics.LastInt64("DCGM_FI_DEV_FB_USED")
+	assert.False(t, ok)
+	assert.Equal(t, 4, client.deviceMetricToFailedQueryCount["device0.DCGM_FI_DEV_FB_USED"])
+	// 4 samples, 1s apart.
+	energy, ok := device.Metrics.CumulativeTotal("DCGM_FI_DEV_TOTAL_ENERGY_CONSUMPTION")
+	assert.True(t, ok)
+	assert.Equal(t, int64(1500), energy)
+	power, ok := device.Metrics.IntegratedRate("DCGM_FI_DEV_POWER_USAGE")
+	assert.True(t, ok)
+	assert.Equal(t, int64(225), power)
+}
+
+func TestCollectOnPollingErrorWithFakeDcgm(t *testing.T) {
+	fake := newFakeDcgm(t, dcgm.Device{UUID: "GPU-0"})
+	fake.pollErr = errors.New("connection lost")
+
+	client := newFakeClient(t, fake, "DCGM_FI_DEV_GPU_UTIL")
+	defer client.cleanup()
+
+	_, err := client.collect()
+	assert.ErrorIs(t, err, fake.pollErr)
+	assert.Empty(t, client.getDeviceMetrics())
+}
+
+func TestCollectXIDErrors(t *testing.T) {
+	fake := newFakeDcgm(t, dcgm.Device{UUID: "GPU-0"}, dcgm.Device{UUID: "GPU-1"})
+	fake.values[dcgm.DCGM_FI_DEV_XID_ERRORS] = func(entity dcgm.GroupEntityPair, sample int) any {
+		gpuIndex := entity.EntityId
+		switch {
+		case gpuIndex == 0 && sample == 0:
+			return int64(79)
+		case gpuIndex == 0 && sample == 1:
+			return int64(13)
+		case gpuIndex == 1 && sample == 0:
+			return dcgm.DCGM_FT_INT64_BLANK
+		case sample == 2:
+			return int64(gpuIndex*48 + (1-gpuIndex)*79)
+		}
+		return nil
+	}
+
+	client := newFakeClient(t, fake, "DCGM_FI_DEV_XID_ERRORS")
+	defer client.cleanup()
+
+	for i := 0; i < 2; i++ {
+		_, err := client.collect()
+		require.NoError(t, err)
+	}
+	devices := client.getDeviceMetrics()
+	assert.Equal(t, map[int64]int64{79: 1, 13: 1}, devices[0].XIDErrors)
+	assert.Empty(t, devices[1].XIDErrors)
+
+	_, err := client.collect()
+	require.NoError(t, err)
+	devices = client.getDeviceMetrics()
+	assert.Equal(t, map[int64]int64{79: 2, 13: 1}, devices[0].XIDErrors)
+	assert.Equal(t, map[int64]int64{48: 1}, devices[1].XIDErrors)
+
+	// The returned counts are a copy.
+	devices[0].XIDErrors[79] = 100
+	assert.Equal(t, int64(2), client.getDeviceMetrics()[0].XIDErrors[79])
+}
+
+func TestCountXIDErrorSkipsSeenSamples(t *testing.T) {
+	device := deviceMetrics{Metrics: MetricsMap{}, XIDErrors: map[int64]int64{}}
+	sample := fieldValueInt64(t, 10, 79)
+
+	countXIDError(device, sample)
+	device.Metrics["DCGM_FI_DEV_XID_ERRORS"] = &metricStats{}
+	device.Metrics["DCGM_FI_DEV_XID_ERRORS"].Update(sample)
+	countXIDError(device, sample)
+	countXIDError(device, fieldValueInt64(t, 20, 0))
+
+	assert.Equal(t, map[int64]int64{79: 1}, device.XIDErrors)
+}
+
+func TestDiscoverRequestedFieldsXIDErrors(t *testing.T) {
+	config := createDefaultConfig().(*Config)
+	assert.NotContains(t, discoverRequestedFields(config), "DCGM_FI_DEV_XID_ERRORS")
+	config.Metrics.GpuDcgmXidErrors.Enabled = true
+	assert.Contains(t, discoverRequestedFields(config), "DCGM_FI_DEV_XID_ERRORS")
+}
+
+// testMigHierarchy is GPU 0 split into a 3g and a 4g GPU instance, with a 1c
+// compute instance on the 3g one, and a GPU instance of a GPU that isn't
+// supported.
+var testMigHierarchy = []dcgm.MigHierarchyInfo_v2{
+	{
+		Entity: dcgm.GroupEntityPair{EntityGroupId: dcgm.FE_GPU_CI, EntityId: 0},
+		Parent: dcgm.GroupEntityPair{EntityGroupId: dcgm.FE_GPU_I, EntityId: 0},
+		Info:   dcgm.MigEntityInfo{NvmlInstanceId: 1, NvmlComputeInstanceId: 0, NvmlProfileSlices: 1},
+	},
+	{
+		Entity: dcgm.GroupEntityPair{EntityGroupId: dcgm.FE_GPU_I, EntityId: 0},
+		Parent: dcgm.GroupEntityPair{EntityGroupId: dcgm.FE_GPU, EntityId: 0},
+		Info:   dcgm.MigEntityInfo{NvmlInstanceId: 1, NvmlProfileSlices: 3},
+	},
+	{
+		Entity: dcgm.GroupEntityPair{EntityGroupId: dcgm.FE_GPU_I, EntityId: 1},
+		Parent: dcgm.GroupEntityPair{EntityGroupId: dcgm.FE_GPU, EntityId: 0},
+		Info:   dcgm.MigEntityInfo{NvmlInstanceId: 2, NvmlProfileSlices: 4},
+	},
+	{
+		Entity: dcgm.GroupEntityPair{EntityGroupId: dcgm.FE_GPU_I, EntityId: 2},
+		Parent: dcgm.GroupEntityPair{EntityGroupId: dcgm.FE_GPU, EntityId: 7},
+		Info:   dcgm.MigEntityInfo{NvmlInstanceId: 1, NvmlProfileSlices: 7},
+	},
+}
+
+func TestDiscoverMigInstances(t *testing.T) {
+	fake := newFakeDcgm(t, dcgm.Device{UUID: "GPU-0"})
+	fake.migHierarchy = testMigHierarchy
+
+	instances, err := discoverMigInstances(fake, []uint{0})
+	require.NoError(t, err)
+	gi0 := dcgm.GroupEntityPair{EntityGroupId: dcgm.FE_GPU_I, EntityId: 0}
+	gi1 := dcgm.GroupEntityPair{EntityGroupId: dcgm.FE_GPU_I, EntityId: 1}
+	ci0 := dcgm.GroupEntityPair{EntityGroupId: dcgm.FE_GPU_CI, EntityId: 0}
+	assert.Equal(t, map[dcgm.GroupEntityPair]migInstance{
+		gi0: {Entity: gi0, GPUIndex: 0, Slices: "3g", GPUInstanceID: 1},
+		gi1: {Entity: gi1, GPUIndex: 0, Slices: "4g", GPUInstanceID: 2},
+		ci0: {Entity: ci0, GPUIndex: 0, Slices: "1c.3g", GPUInstanceID: 1, ComputeInstanceID: 0},
+	}, instances)
+	assert.Equal(t, []dcgm.GroupEntityPair{gi0, gi1, ci0}, sortedMigEntities(instances))
+
+	fake.migHierarchy = nil
+	instances, err = discoverMigInstances(fake, []uint{0})
+	require.NoError(t, err)
+	assert.Empty(t, instances)
+}
+
+func TestCollectMigInstances(t *testing.T) {
+	fake := newFakeDcgm(t, dcgm.Device{UUID: "GPU-0"})
+	fake.migHierarchy = testMigHierarchy
+	fake.supportedProfilingFields = []dcgm.Short{dcgm.DCGM_FI_PROF_GR_ENGINE_ACTIVE}
+	fake.values[dcgm.DCGM_FI_PROF_GR_ENGINE_ACTIVE] = func(entity dcgm.GroupEntityPair, _ int) any {
+		return 0.1*float64(entity.EntityGroupId) + 0.01*float64(entity.EntityId)
+	}
+	fake.values[dcgm.DCGM_FI_DEV_GPU_UTIL] = func(entity dcgm.GroupEntityPair, _ int) any {
+		if entity.EntityGroupId != dcgm.FE_GPU {
+			return dcgm.DCGM_FT_INT64_NOT_SUPPORTED
+		}
+		return int64(20)
+	}
+
+	client := newFakeClient(t, fake, "DCGM_FI_PROF_GR_ENGINE_ACTIVE", "DCGM_FI_DEV_GPU_UTIL")
+	assert.Equal(t, []dcgm.GroupEntityPair{
+		{EntityGroupId: dcgm.FE_GPU, EntityId: 0},
+		{EntityGroupId: dcgm.FE_GPU_I, EntityId: 0},
+		{EntityGroupId: dcgm.FE_GPU_I, EntityId: 1},
+		{EntityGroupId: dcgm.FE_GPU_CI, EntityId: 0},
+	}, fake.groups[client.deviceGroup])
+
+	_, err := client.collect()
+	require.NoError(t, err)
+	device := client.getDeviceMetrics()[0]
+	util, ok := device.Metrics.LastFloat64("DCGM_FI_PROF_GR_ENGINE_ACTIVE")
+	assert.True(t, ok)
+	assert.InDelta(t, 0.1, util, 1e-9)
+	require.Len(t, device.Instances, 3)
+	ci := device.Instances[dcgm.GroupEntityPair{EntityGroupId: dcgm.FE_GPU_CI, EntityId: 0}]
+	assert.Equal(t, "1c.3g", ci.Slices)
+	assert.True(t, ci.isComputeInstance())
+	util, ok = ci.Metrics.LastFloat64("DCGM_FI_PROF_GR_ENGINE_ACTIVE")
+	assert.True(t, ok)
+	assert.InDelta(t, 0.5, util, 1e-9)
+	gi := device.Instances[dcgm.GroupEntityPair{EntityGroupId: dcgm.FE_GPU_I, EntityId: 1}]
+	assert.False(t, gi.isComputeInstance())
+	util, ok = gi.Metrics.LastFloat64("DCGM_FI_PROF_GR_ENGINE_ACTIVE")
+	assert.True(t, ok)
+	assert.InDelta(t, 0.41, util, 1e-9)
+	// Device fields are not supported on MIG instances, which is expected.
+	_, ok = gi.Metrics.LastFloat64("DCGM_FI_DEV_GPU_UTIL")
+	assert.False(t, ok)
+	assert.Empty(t, client.deviceMetricToFailedQueryCount)
+
+	// The returned instance metrics are a copy.
+	delete(ci.Metrics, "DCGM_FI_PROF_GR_ENGINE_ACTIVE")
+	ci = client.getDeviceMetrics()[0].Instances[ci.Entity]
+	assert.Contains(t, ci.Metrics, "DCGM_FI_PROF_GR_ENGINE_ACTIVE")
+
+	client.cleanup()
+	assert.True(t, fake.isClean())
+}
@@ -15,6 +15,8 @@
 package dcgmreceiver
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	"go.opentelemetry.io/collector/config/confignet"
@@ -30,4 +32,69 @@ type Config struct {
 	scraperhelper.ControllerConfig `mapstructure:",squash"`
 	confignet.TCPAddrConfig        `mapstructure:",squash"`
 	Metrics                        metadata.MetricsConfig `mapstructure:"metrics"`
+	// CustomFields are additional DCGM fields collected as custom metrics.
+	CustomFields []CustomFieldConfig `mapstructure:"custom_fields"`
+}
+
+// CustomFieldType is how the values of a custom field are reported.
+type CustomFieldType string
+
+const (
+	// CustomFieldTypeGauge reports the last value of the field.
+	CustomFieldTypeGauge CustomFieldType = "gauge"
+	// CustomFieldTypeCumulative reports the increase of a counter field since
+	// it was first collected.
+	CustomFieldTypeCumulative CustomFieldType = "cumulative"
+	// CustomFieldTypeIntegratedRate reports a per-second rate field, such as
+	// a power usage, integrated over time.
+	CustomFieldTypeIntegratedRate CustomFieldType = "integrated_rate"
+)
+
+// CustomFieldConfig is a DCGM field collected as a custom metric.
+type CustomFieldConfig struct {
+	// Field is the DCGM field name, e.g. DCGM_FI_DEV_ROW_REMAP_PENDING.
+	Field string `mapstructure:"field"`
+	// Metric is the name of the metric reporting the field.
+	Metric      string `mapstructure:"metric"`
+	Description string `mapstructure:"description"`
+	Unit        string `mapstructure:"unit"`
+	// Type is how the values are reported; the default is gauge.
+	Type CustomFieldType `mapstructure:"type"`
+	// Attributes are added to the data points of the metric.
+	Attributes map[string]string `mapstructure:"attributes"`
+}
+
+func (c *Config) Validate() error {
+	var errs []error
+	metrics := map[string]CustomFieldConfig{}
+	for _, f := range c.CustomFields {
+		if f.Field == "" {
+			errs = append(errs, errors.New("no field specified for one of the custom fields"))
+		}
+		if f.Metric == "" {
+			errs = append(errs, fmt.Errorf("no metric specified for custom field %q", f.Field))
+			continue
+		}
+		switch f.Type {
+		case "", CustomFieldTypeGauge, CustomFieldTypeCumulative, CustomFieldTypeIntegratedRate:
+		default:
+			errs = append(errs, fmt.Errorf("invalid type %q for custom metric %q, must be one of [gauge, cumulative, integrated_rate]", f.Type, f.Metric))
+		}
+		// Several fields can be reported as the same metric, with different
+		// attributes.
+		if other, ok := metrics[f.Metric]; ok && (other.customType() != f.customType() || other.Unit != f.Unit || other.Description != f.Description) {
+			errs = append(errs, fmt.Errorf("custom metric %q is defined with different types, units or descriptions", f.Metric))
+		}
+		metrics[f.Metric] = f
+	}
+	errs = append(errs, validateCustomFieldNames(c.CustomFields))
+	return errors.Join(errs...)
+}
+
+// customType returns the type of the custom field, defaulting to gauge.
+func (f CustomFieldConfig) customType() CustomFieldType {
+	if f.Type == "" {
+		return CustomFieldTypeGauge
+	}
+	return f.Type
 }
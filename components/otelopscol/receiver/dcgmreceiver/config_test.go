@@ -0,0 +1,73 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dcgmreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCustomFields(t *testing.T) {
+	tests := []struct {
+		name         string
+		customFields []CustomFieldConfig
+		expectedErr  string
+	}{
+		{
+			name: "valid",
+			customFields: []CustomFieldConfig{
+				{Field: "DCGM_FI_DEV_ROW_REMAP_PENDING", Metric: "gpu.dcgm.row_remap.pending"},
+				{Field: "DCGM_FI_DEV_RETIRED_SBE", Metric: "gpu.dcgm.retired_pages", Type: CustomFieldTypeCumulative, Attributes: map[string]string{"cause": "sbe"}},
+				{Field: "DCGM_FI_DEV_RETIRED_DBE", Metric: "gpu.dcgm.retired_pages", Type: CustomFieldTypeCumulative, Attributes: map[string]string{"cause": "dbe"}},
+			},
+		},
+		{
+			name:         "missing field",
+			customFields: []CustomFieldConfig{{Metric: "gpu.dcgm.custom"}},
+			expectedErr:  "no field specified for one of the custom fields",
+		},
+		{
+			name:         "missing metric",
+			customFields: []CustomFieldConfig{{Field: "DCGM_FI_DEV_ROW_REMAP_PENDING"}},
+			expectedErr:  `no metric specified for custom field "DCGM_FI_DEV_ROW_REMAP_PENDING"`,
+		},
+		{
+			name:         "invalid type",
+			customFields: []CustomFieldConfig{{Field: "DCGM_FI_DEV_ROW_REMAP_PENDING", Metric: "gpu.dcgm.custom", Type: "histogram"}},
+			expectedErr:  `invalid type "histogram" for custom metric "gpu.dcgm.custom"`,
+		},
+		{
+			name: "conflicting metric definitions",
+			customFields: []CustomFieldConfig{
+				{Field: "DCGM_FI_DEV_RETIRED_SBE", Metric: "gpu.dcgm.retired_pages", Type: CustomFieldTypeCumulative},
+				{Field: "DCGM_FI_DEV_RETIRED_DBE", Metric: "gpu.dcgm.retired_pages"},
+			},
+			expectedErr: `custom metric "gpu.dcgm.retired_pages" is defined with different types, units or descriptions`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.CustomFields = test.customFields
+			err := cfg.Validate()
+			if test.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.expectedErr)
+			}
+		})
+	}
+}
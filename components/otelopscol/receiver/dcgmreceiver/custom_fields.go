@@ -0,0 +1,201 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build gpu
+// +build gpu
+
+package dcgmreceiver
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NVIDIA/go-dcgm/pkg/dcgm"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/GoogleCloudPlatform/opentelemetry-operations-collector/components/otelopscol/receiver/dcgmreceiver/internal/metadata"
+)
+
+// nonNumericFields are the DCGM fields whose values are strings or binary
+// blobs, which can't be reported as metrics. The field types are only known
+// once connected to DCGM, so they are listed here to be rejected when the
+// config is validated.
+var nonNumericFields = map[string]bool{
+	"DCGM_FI_DRIVER_VERSION":                   true,
+	"DCGM_FI_NVML_VERSION":                     true,
+	"DCGM_FI_PROCESS_NAME":                     true,
+	"DCGM_FI_DEV_NAME":                         true,
+	"DCGM_FI_DEV_BRAND":                        true,
+	"DCGM_FI_DEV_SERIAL":                       true,
+	"DCGM_FI_DEV_UUID":                         true,
+	"DCGM_FI_DEV_OEM_INFOROM_VER":              true,
+	"DCGM_FI_DEV_ECC_INFOROM_VER":              true,
+	"DCGM_FI_DEV_POWER_INFOROM_VER":            true,
+	"DCGM_FI_DEV_INFOROM_IMAGE_VER":            true,
+	"DCGM_FI_DEV_VBIOS_VERSION":                true,
+	"DCGM_FI_DEV_PCI_BUSID":                    true,
+	"DCGM_FI_DEV_CPU_MODEL":                    true,
+	"DCGM_FI_DEV_CPU_VENDOR":                   true,
+	"DCGM_FI_DEV_NVSWITCH_LINK_DEVICE_UUID":    true,
+	"DCGM_FI_DEV_VGPU_VM_ID":                   true,
+	"DCGM_FI_DEV_VGPU_VM_NAME":                 true,
+	"DCGM_FI_DEV_VGPU_TYPE_NAME":               true,
+	"DCGM_FI_DEV_VGPU_TYPE_CLASS":              true,
+	"DCGM_FI_DEV_VGPU_TYPE_LICENSE":            true,
+	"DCGM_FI_DEV_VGPU_DRIVER_VERSION":          true,
+	"DCGM_FI_DEV_VGPU_PCI_ID":                  true,
+	"DCGM_FI_DEV_VGPU_UUID":                    true,
+	"DCGM_FI_DEV_VGPU_INSTANCE_LICENSE_STATE":  true,
+	"DCGM_FI_SYNC_BOOST":                       true,
+	"DCGM_FI_DEV_SUPPORTED_CLOCKS":             true,
+	"DCGM_FI_DEV_ACCOUNTING_DATA":              true,
+	"DCGM_FI_DEV_SUPPORTED_TYPE_INFO":          true,
+	"DCGM_FI_DEV_CREATABLE_VGPU_TYPE_IDS":      true,
+	"DCGM_FI_DEV_SUPPORTED_VGPU_TYPE_IDS":      true,
+	"DCGM_FI_DEV_VGPU_INSTANCE_IDS":            true,
+	"DCGM_FI_DEV_VGPU_UTILIZATIONS":            true,
+	"DCGM_FI_DEV_VGPU_PER_PROCESS_UTILIZATION": true,
+	"DCGM_FI_DEV_VGPU_TYPE_INFO":               true,
+	"DCGM_FI_DEV_VGPU_ENC_STATS":               true,
+	"DCGM_FI_DEV_VGPU_ENC_SESSIONS_INFO":       true,
+	"DCGM_FI_DEV_VGPU_FBC_STATS":               true,
+	"DCGM_FI_DEV_VGPU_FBC_SESSIONS_INFO":       true,
+	"DCGM_FI_DEV_ENC_STATS":                    true,
+	"DCGM_FI_DEV_FBC_STATS":                    true,
+	"DCGM_FI_DEV_FBC_SESSIONS_INFO":            true,
+	"DCGM_FI_DEV_MIG_GI_INFO":                  true,
+	"DCGM_FI_DEV_MIG_CI_INFO":                  true,
+}
+
+// validateCustomFieldNames checks that the custom fields are numeric fields
+// known to DCGM.
+func validateCustomFieldNames(fields []CustomFieldConfig) error {
+	var errs []error
+	for _, f := range fields {
+		// DCGM_FI also has the DCGM_FT_* field types.
+		if _, ok := dcgm.DCGM_FI[f.Field]; !ok || !strings.HasPrefix(f.Field, "DCGM_FI_") {
+			errs = append(errs, fmt.Errorf("unknown DCGM field %q for custom metric %q", f.Field, f.Metric))
+		} else if nonNumericFields[f.Field] {
+			errs = append(errs, fmt.Errorf("DCGM field %q for custom metric %q is not numeric", f.Field, f.Metric))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// customMetricsBuilder builds the metrics of the custom fields, which the
+// generated MetricsBuilder doesn't know about.
+type customMetricsBuilder struct {
+	fields    []CustomFieldConfig
+	startTime pcommon.Timestamp
+	version   string
+	// resources are the custom metrics recorded since the last emit, by
+	// resource.
+	resources []pmetric.ResourceMetrics
+}
+
+func newCustomMetricsBuilder(fields []CustomFieldConfig, startTime pcommon.Timestamp, version string) *customMetricsBuilder {
+	return &customMetricsBuilder{fields: fields, startTime: startTime, version: version}
+}
+
+// record records the custom metrics of a device or MIG instance.
+func (b *customMetricsBuilder) record(resource pcommon.Resource, now pcommon.Timestamp, metrics MetricsMap) {
+	ms := pmetric.NewMetricSlice()
+	for _, f := range b.fields {
+		var dp pmetric.NumberDataPoint
+		switch f.customType() {
+		case CustomFieldTypeGauge:
+			v, ok := metrics.LastFloat64(f.Field)
+			if !ok {
+				continue
+			}
+			dp = customMetric(ms, f).Gauge().DataPoints().AppendEmpty()
+			dp.SetDoubleValue(v)
+		case CustomFieldTypeCumulative:
+			v, ok := metrics.CumulativeTotal(f.Field)
+			if !ok {
+				continue
+			}
+			dp = customMetric(ms, f).Sum().DataPoints().AppendEmpty()
+			dp.SetIntValue(v)
+			dp.SetStartTimestamp(b.startTime)
+		case CustomFieldTypeIntegratedRate:
+			v, ok := metrics.IntegratedRate(f.Field)
+			if !ok {
+				continue
+			}
+			dp = customMetric(ms, f).Sum().DataPoints().AppendEmpty()
+			dp.SetIntValue(v)
+			dp.SetStartTimestamp(b.startTime)
+		}
+		dp.SetTimestamp(now)
+		for k, v := range f.Attributes {
+			dp.Attributes().PutStr(k, v)
+		}
+	}
+	if ms.Len() == 0 {
+		return
+	}
+	rm := pmetric.NewResourceMetrics()
+	resource.CopyTo(rm.Resource())
+	ms.MoveAndAppendTo(rm.ScopeMetrics().AppendEmpty().Metrics())
+	b.resources = append(b.resources, rm)
+}
+
+// customMetric returns the metric of f in ms, adding it if it's not there.
+func customMetric(ms pmetric.MetricSlice, f CustomFieldConfig) pmetric.Metric {
+	for i := 0; i < ms.Len(); i++ {
+		if ms.At(i).Name() == f.Metric {
+			return ms.At(i)
+		}
+	}
+	m := ms.AppendEmpty()
+	m.SetName(f.Metric)
+	m.SetDescription(f.Description)
+	m.SetUnit(f.Unit)
+	if f.customType() == CustomFieldTypeGauge {
+		m.SetEmptyGauge()
+	} else {
+		m.SetEmptySum()
+		m.Sum().SetIsMonotonic(true)
+		m.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	}
+	return m
+}
+
+// emit adds the custom metrics recorded since the last emit to md, alongside
+// the other metrics of their resource.
+func (b *customMetricsBuilder) emit(md pmetric.Metrics) {
+	for _, custom := range b.resources {
+		var scope pmetric.ScopeMetrics
+		found := false
+		for i := 0; i < md.ResourceMetrics().Len() && !found; i++ {
+			rm := md.ResourceMetrics().At(i)
+			if rm.Resource().Attributes().Equal(custom.Resource().Attributes()) && rm.ScopeMetrics().Len() > 0 {
+				scope = rm.ScopeMetrics().At(0)
+				found = true
+			}
+		}
+		if !found {
+			rm := md.ResourceMetrics().AppendEmpty()
+			custom.Resource().CopyTo(rm.Resource())
+			scope = rm.ScopeMetrics().AppendEmpty()
+			scope.Scope().SetName(metadata.ScopeName)
+			scope.Scope().SetVersion(b.version)
+		}
+		custom.ScopeMetrics().At(0).Metrics().MoveAndAppendTo(scope.Metrics())
+	}
+	b.resources = nil
+}
@@ -0,0 +1,184 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build gpu
+// +build gpu
+
+package dcgmreceiver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/go-dcgm/pkg/dcgm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestValidateCustomFieldNames(t *testing.T) {
+	validate := func(field string) error {
+		config := createDefaultConfig().(*Config)
+		config.CustomFields = []CustomFieldConfig{{Field: field, Metric: "gpu.dcgm.custom"}}
+		return config.Validate()
+	}
+	assert.NoError(t, validate("DCGM_FI_DEV_ROW_REMAP_PENDING"))
+	assert.EqualError(t, validate("DCGM_FI_DEV_NOPE"), `unknown DCGM field "DCGM_FI_DEV_NOPE" for custom metric "gpu.dcgm.custom"`)
+	assert.EqualError(t, validate("DCGM_FT_INT64"), `unknown DCGM field "DCGM_FT_INT64" for custom metric "gpu.dcgm.custom"`)
+	assert.EqualError(t, validate("DCGM_FI_DEV_NAME"), `DCGM field "DCGM_FI_DEV_NAME" for custom metric "gpu.dcgm.custom" is not numeric`)
+	assert.EqualError(t, validate("DCGM_FI_DEV_SUPPORTED_CLOCKS"), `DCGM field "DCGM_FI_DEV_SUPPORTED_CLOCKS" for custom metric "gpu.dcgm.custom" is not numeric`)
+}
+
+func TestDiscoverRequestedFieldsCustomFields(t *testing.T) {
+	config := createDefaultConfig().(*Config)
+	config.CustomFields = []CustomFieldConfig{
+		{Field: "DCGM_FI_DEV_ROW_REMAP_PENDING", Metric: "gpu.dcgm.row_remap.pending"},
+		// Already requested for gpu.dcgm.utilization.
+		{Field: "DCGM_FI_DEV_GPU_UTIL", Metric: "gpu.dcgm.util"},
+	}
+	fields := discoverRequestedFields(config)
+	assert.Contains(t, fields, "DCGM_FI_DEV_ROW_REMAP_PENDING")
+	count := 0
+	for _, f := range fields {
+		if f == "DCGM_FI_DEV_GPU_UTIL" {
+			count++
+		}
+	}
+	assert.Equal(t, 1, count)
+}
+
+func TestCustomMetricsBuilder(t *testing.T) {
+	start := pcommon.NewTimestampFromTime(time.Unix(100, 0))
+	now := pcommon.NewTimestampFromTime(time.Unix(200, 0))
+	cmb := newCustomMetricsBuilder([]CustomFieldConfig{
+		{Field: "DCGM_FI_DEV_ROW_REMAP_PENDING", Metric: "gpu.dcgm.row_remap.pending", Unit: "1"},
+		{Field: "DCGM_FI_DEV_RETIRED_SBE", Metric: "gpu.dcgm.retired_pages", Unit: "{pages}", Type: CustomFieldTypeCumulative, Attributes: map[string]string{"cause": "sbe"}},
+		{Field: "DCGM_FI_DEV_RETIRED_DBE", Metric: "gpu.dcgm.retired_pages", Unit: "{pages}", Type: CustomFieldTypeCumulative, Attributes: map[string]string{"cause": "dbe"}},
+		{Field: "DCGM_FI_DEV_POWER_USAGE", Metric: "gpu.dcgm.energy", Unit: "J", Type: CustomFieldTypeIntegratedRate},
+	}, start, "1.2.3")
+
+	metrics := MetricsMap{}
+	update := func(name string, values ...dcgm.FieldValue_v2) {
+		metrics[name] = &metricStats{}
+		for _, v := range values {
+			metrics[name].Update(v)
+		}
+	}
+	update("DCGM_FI_DEV_ROW_REMAP_PENDING", fieldValueInt64(t, 10, 1))
+	update("DCGM_FI_DEV_RETIRED_SBE", fieldValueInt64(t, 10, 2), fieldValueInt64(t, 20, 5))
+	update("DCGM_FI_DEV_RETIRED_DBE", fieldValueInt64(t, 10, 0), fieldValueInt64(t, 20, 1))
+	update("DCGM_FI_DEV_POWER_USAGE", fieldValueFloat64(t, 0, 100.0), fieldValueFloat64(t, 2e6, 50.0))
+
+	md := pmetric.NewMetrics()
+	existing := md.ResourceMetrics().AppendEmpty()
+	existing.Resource().Attributes().PutStr("gpu.number", "0")
+	existing.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName("gpu.dcgm.utilization")
+
+	gpu0 := pcommon.NewResource()
+	gpu0.Attributes().PutStr("gpu.number", "0")
+	cmb.record(gpu0, now, metrics)
+	gpu1 := pcommon.NewResource()
+	gpu1.Attributes().PutStr("gpu.number", "1")
+	cmb.record(gpu1, now, MetricsMap{"DCGM_FI_DEV_ROW_REMAP_PENDING": metrics["DCGM_FI_DEV_ROW_REMAP_PENDING"]})
+	// Resources without any custom metrics are left alone.
+	cmb.record(pcommon.NewResource(), now, MetricsMap{})
+	cmb.emit(md)
+
+	require.Equal(t, 2, md.ResourceMetrics().Len())
+	ms := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 4, ms.Len())
+	assert.Equal(t, "gpu.dcgm.utilization", ms.At(0).Name())
+
+	pending := ms.At(1)
+	assert.Equal(t, "gpu.dcgm.row_remap.pending", pending.Name())
+	assert.Equal(t, "1", pending.Unit())
+	assert.Equal(t, pmetric.MetricTypeGauge, pending.Type())
+	assert.Equal(t, 1.0, pending.Gauge().DataPoints().At(0).DoubleValue())
+	assert.Equal(t, now, pending.Gauge().DataPoints().At(0).Timestamp())
+
+	retired := ms.At(2)
+	assert.Equal(t, "gpu.dcgm.retired_pages", retired.Name())
+	assert.True(t, retired.Sum().IsMonotonic())
+	assert.Equal(t, pmetric.AggregationTemporalityCumulative, retired.Sum().AggregationTemporality())
+	require.Equal(t, 2, retired.Sum().DataPoints().Len())
+	sbe := retired.Sum().DataPoints().At(0)
+	assert.Equal(t, int64(3), sbe.IntValue())
+	assert.Equal(t, start, sbe.StartTimestamp())
+	assert.Equal(t, map[string]any{"cause": "sbe"}, sbe.Attributes().AsRaw())
+	assert.Equal(t, int64(1), retired.Sum().DataPoints().At(1).IntValue())
+	assert.Equal(t, map[string]any{"cause": "dbe"}, retired.Sum().DataPoints().At(1).Attributes().AsRaw())
+
+	energy := ms.At(3)
+	assert.Equal(t, "gpu.dcgm.energy", energy.Name())
+	// 50W over 2s.
+	assert.Equal(t, int64(100), energy.Sum().DataPoints().At(0).IntValue())
+
+	rm := md.ResourceMetrics().At(1)
+	assert.Equal(t, map[string]any{"gpu.number": "1"}, rm.Resource().Attributes().AsRaw())
+	assert.Equal(t, "1.2.3", rm.ScopeMetrics().At(0).Scope().Version())
+	assert.Equal(t, 1, rm.ScopeMetrics().At(0).Metrics().Len())
+
+	// The recorded metrics are emitted once.
+	md = pmetric.NewMetrics()
+	cmb.emit(md)
+	assert.Equal(t, 0, md.ResourceMetrics().Len())
+}
+
+func TestScraperCustomFieldsWithFakeDcgm(t *testing.T) {
+	fake := newFakeDcgm(t, dcgm.Device{UUID: "GPU-0"})
+	fake.values[dcgm.DCGM_FI_DEV_ROW_REMAP_PENDING] = func(dcgm.GroupEntityPair, int) any {
+		return int64(1)
+	}
+
+	config := createDefaultConfig().(*Config)
+	config.CustomFields = []CustomFieldConfig{
+		{Field: "DCGM_FI_DEV_ROW_REMAP_PENDING", Metric: "gpu.dcgm.row_remap.pending"},
+		// Not supported by the fake GPU.
+		{Field: "DCGM_FI_PROF_PIPE_INT_ACTIVE", Metric: "gpu.dcgm.pipe.int.utilization"},
+	}
+	var settings receiver.Settings
+	settings.Logger = zaptest.NewLogger(t)
+	scraper := newDcgmScraper(config, settings)
+	scraper.dcgm = fake
+
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+	var metrics pmetric.Metrics
+	require.Eventually(t, func() bool {
+		var err error
+		metrics, err = scraper.scrape(context.Background())
+		require.NoError(t, err)
+		return metrics.MetricCount() > 0
+	}, 10*time.Second, 10*time.Millisecond)
+	fake.mu.Lock()
+	assert.Len(t, fake.fieldGroups, 1)
+	for _, fieldIDs := range fake.fieldGroups {
+		assert.Contains(t, fieldIDs, dcgm.Short(dcgm.DCGM_FI_DEV_ROW_REMAP_PENDING))
+		assert.NotContains(t, fieldIDs, dcgm.Short(dcgm.DCGM_FI_PROF_PIPE_INT_ACTIVE))
+	}
+	fake.mu.Unlock()
+	require.NoError(t, scraper.stop(context.Background()))
+
+	require.Equal(t, 1, metrics.ResourceMetrics().Len())
+	rm := metrics.ResourceMetrics().At(0)
+	gpuUUID, _ := rm.Resource().Attributes().Get("gpu.uuid")
+	assert.Equal(t, "GPU-0", gpuUUID.Str())
+	m := rm.ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, "gpu.dcgm.row_remap.pending", m.Name())
+	assert.Equal(t, 1.0, m.Gauge().DataPoints().At(0).DoubleValue())
+}
@@ -0,0 +1,240 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build gpu
+// +build gpu
+
+package dcgmreceiver
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/go-dcgm/pkg/dcgm"
+)
+
+// fakeDcgm is an in-memory dcgmAPI simulating a DCGM daemon. Each call to
+// GetValuesSince returns one sample of every watched field of every entity of
+// the group, and advances the clock by sampleInterval.
+type fakeDcgm struct {
+	t *testing.T
+
+	// devices are the simulated GPUs, by index.
+	devices []dcgm.Device
+	// supportedProfilingFields are the profiling fields supported by the
+	// GPUs. If profilingErr is set, it is returned instead.
+	supportedProfilingFields []dcgm.Short
+	profilingErr             error
+	// migHierarchy are the MIG GPU instances and compute instances.
+	migHierarchy []dcgm.MigHierarchyInfo_v2
+	// initFailures is the number of calls to Init that fail before they
+	// succeed.
+	initFailures int
+	// values returns the value of a field on a GPU or MIG instance for the
+	// given sample number: an int64, a float64, or nil if there is no sample.
+	// Blank or permission errors are simulated with the DCGM sentinel values,
+	// e.g. dcgm.DCGM_FT_INT64_BLANK.
+	values map[dcgm.Short]func(entity dcgm.GroupEntityPair, sample int) any
+	// pollErr is returned by GetValuesSince if set, by the first pollFailures
+	// calls if pollFailures is also set.
+	pollErr        error
+	pollFailures   int
+	sampleInterval time.Duration
+
+	mu          sync.Mutex
+	initCalls   int
+	pollCalls   int
+	cleanups    int
+	nextHandle  uintptr
+	groups      map[dcgm.GroupHandle][]dcgm.GroupEntityPair
+	fieldGroups map[dcgm.FieldHandle][]dcgm.Short
+	watches     map[dcgm.FieldHandle]int64
+	now         time.Time
+	sample      int
+}
+
+func newFakeDcgm(t *testing.T, devices ...dcgm.Device) *fakeDcgm {
+	return &fakeDcgm{
+		t:              t,
+		devices:        devices,
+		values:         map[dcgm.Short]func(dcgm.GroupEntityPair, int) any{},
+		sampleInterval: time.Second,
+		groups:         map[dcgm.GroupHandle][]dcgm.GroupEntityPair{},
+		fieldGroups:    map[dcgm.FieldHandle][]dcgm.Short{},
+		watches:        map[dcgm.FieldHandle]int64{},
+		now:            time.Now(),
+	}
+}
+
+func (f *fakeDcgm) Init(...string) (func(), error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.initCalls++
+	if f.initCalls <= f.initFailures {
+		return nil, fmt.Errorf("fake DCGM connection failure %d", f.initCalls)
+	}
+	return func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.cleanups++
+	}, nil
+}
+
+func (f *fakeDcgm) GetSupportedDevices() ([]uint, error) {
+	indices := make([]uint, len(f.devices))
+	for i := range f.devices {
+		indices[i] = uint(i)
+	}
+	return indices, nil
+}
+
+func (f *fakeDcgm) GetDeviceInfo(gpuIndex uint) (dcgm.Device, error) {
+	if gpuIndex >= uint(len(f.devices)) {
+		return dcgm.Device{}, fmt.Errorf("no GPU %d", gpuIndex)
+	}
+	return f.devices[gpuIndex], nil
+}
+
+func (f *fakeDcgm) GetSupportedMetricGroups(uint) ([]dcgm.MetricGroup, error) {
+	if f.profilingErr != nil {
+		return nil, f.profilingErr
+	}
+	group := dcgm.MetricGroup{}
+	for _, fieldID := range f.supportedProfilingFields {
+		group.FieldIds = append(group.FieldIds, uint(fieldID))
+	}
+	return []dcgm.MetricGroup{group}, nil
+}
+
+func (f *fakeDcgm) GetGpuInstanceHierarchy() (dcgm.MigHierarchy_v2, error) {
+	hierarchy := dcgm.MigHierarchy_v2{Count: uint(len(f.migHierarchy))}
+	copy(hierarchy.EntityList[:], f.migHierarchy)
+	return hierarchy, nil
+}
+
+func (f *fakeDcgm) newHandle() uintptr {
+	f.nextHandle++
+	return f.nextHandle
+}
+
+func (f *fakeDcgm) CreateGroup(string) (dcgm.GroupHandle, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var group dcgm.GroupHandle
+	group.SetHandle(f.newHandle())
+	f.groups[group] = nil
+	return group, nil
+}
+
+func (f *fakeDcgm) AddToGroup(group dcgm.GroupHandle, gpuIndex uint) error {
+	return f.AddEntityToGroup(group, dcgm.FE_GPU, gpuIndex)
+}
+
+func (f *fakeDcgm) AddEntityToGroup(group dcgm.GroupHandle, entityGroupID dcgm.Field_Entity_Group, entityID uint) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.groups[group]; !ok {
+		return fmt.Errorf("no such group")
+	}
+	f.groups[group] = append(f.groups[group], dcgm.GroupEntityPair{EntityGroupId: entityGroupID, EntityId: entityID})
+	return nil
+}
+
+func (f *fakeDcgm) DestroyGroup(group dcgm.GroupHandle) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.groups, group)
+	return nil
+}
+
+func (f *fakeDcgm) FieldGroupCreate(_ string, fieldIDs []dcgm.Short) (dcgm.FieldHandle, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var fieldGroup dcgm.FieldHandle
+	fieldGroup.SetHandle(f.newHandle())
+	f.fieldGroups[fieldGroup] = fieldIDs
+	return fieldGroup, nil
+}
+
+func (f *fakeDcgm) FieldGroupDestroy(fieldGroup dcgm.FieldHandle) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.fieldGroups, fieldGroup)
+	delete(f.watches, fieldGroup)
+	return nil
+}
+
+func (f *fakeDcgm) WatchFieldsWithGroupEx(fieldGroup dcgm.FieldHandle, group dcgm.GroupHandle, updateFreqUs int64, _ float64, _ int32) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.groups[group]; !ok {
+		return fmt.Errorf("no such group")
+	}
+	if _, ok := f.fieldGroups[fieldGroup]; !ok {
+		return fmt.Errorf("no such field group")
+	}
+	f.watches[fieldGroup] = updateFreqUs
+	return nil
+}
+
+func (f *fakeDcgm) GetValuesSince(group dcgm.GroupHandle, fieldGroup dcgm.FieldHandle, since time.Time) ([]dcgm.FieldValue_v2, time.Time, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.pollCalls++
+	if f.pollErr != nil && (f.pollFailures == 0 || f.pollCalls <= f.pollFailures) {
+		return nil, since, f.pollErr
+	}
+	if _, ok := f.watches[fieldGroup]; !ok {
+		return nil, since, fmt.Errorf("fields are not watched")
+	}
+
+	var fieldValues []dcgm.FieldValue_v2
+	ts := f.now.UnixMicro()
+	for _, entity := range f.groups[group] {
+		for _, fieldID := range f.fieldGroups[fieldGroup] {
+			valueFunc, ok := f.values[fieldID]
+			if !ok {
+				continue
+			}
+			var fv dcgm.FieldValue_v2
+			switch v := valueFunc(entity, f.sample).(type) {
+			case nil:
+				continue
+			case int64:
+				fv = fieldValueInt64(f.t, ts, v)
+			case float64:
+				fv = fieldValueFloat64(f.t, ts, v)
+			default:
+				f.t.Fatalf("unexpected value %v of field %d", v, fieldID)
+			}
+			fv.EntityGroupId = entity.EntityGroupId
+			fv.EntityId = entity.EntityId
+			fv.FieldId = uint(fieldID)
+			fieldValues = append(fieldValues, fv)
+		}
+	}
+	f.now = f.now.Add(f.sampleInterval)
+	f.sample++
+	return fieldValues, f.now, nil
+}
+
+// isClean returns whether every group and watch created on the fake was
+// destroyed, and every connection cleaned up.
+func (f *fakeDcgm) isClean() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.groups) == 0 && len(f.fieldGroups) == 0 && f.cleanups == f.initCalls-min(f.initCalls, f.initFailures)
+}
@@ -640,13 +640,25 @@ func (rac *ResourceAttributeConfig) Unmarshal(parser *confmap.Conf) error {
 
 // ResourceAttributesConfig provides config for dcgm resource attributes.
 type ResourceAttributesConfig struct {
-	GpuModel  ResourceAttributeConfig `mapstructure:"gpu.model"`
-	GpuNumber ResourceAttributeConfig `mapstructure:"gpu.number"`
-	GpuUUID   ResourceAttributeConfig `mapstructure:"gpu.uuid"`
+	GpuMigComputeInstanceID ResourceAttributeConfig `mapstructure:"gpu.mig.compute_instance.id"`
+	GpuMigGpuInstanceID     ResourceAttributeConfig `mapstructure:"gpu.mig.gpu_instance.id"`
+	GpuMigSlices            ResourceAttributeConfig `mapstructure:"gpu.mig.slices"`
+	GpuModel                ResourceAttributeConfig `mapstructure:"gpu.model"`
+	GpuNumber               ResourceAttributeConfig `mapstructure:"gpu.number"`
+	GpuUUID                 ResourceAttributeConfig `mapstructure:"gpu.uuid"`
 }
 
 func DefaultResourceAttributesConfig() ResourceAttributesConfig {
 	return ResourceAttributesConfig{
+		GpuMigComputeInstanceID: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		GpuMigGpuInstanceID: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		GpuMigSlices: ResourceAttributeConfig{
+			Enabled: true,
+		},
 		GpuModel: ResourceAttributeConfig{
 			Enabled: true,
 		},
@@ -90,9 +90,12 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
-					GpuModel:  ResourceAttributeConfig{Enabled: true},
-					GpuNumber: ResourceAttributeConfig{Enabled: true},
-					GpuUUID:   ResourceAttributeConfig{Enabled: true},
+					GpuMigComputeInstanceID: ResourceAttributeConfig{Enabled: true},
+					GpuMigGpuInstanceID:     ResourceAttributeConfig{Enabled: true},
+					GpuMigSlices:            ResourceAttributeConfig{Enabled: true},
+					GpuModel:                ResourceAttributeConfig{Enabled: true},
+					GpuNumber:               ResourceAttributeConfig{Enabled: true},
+					GpuUUID:                 ResourceAttributeConfig{Enabled: true},
 				},
 			},
 		},
@@ -164,9 +167,12 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
-					GpuModel:  ResourceAttributeConfig{Enabled: false},
-					GpuNumber: ResourceAttributeConfig{Enabled: false},
-					GpuUUID:   ResourceAttributeConfig{Enabled: false},
+					GpuMigComputeInstanceID: ResourceAttributeConfig{Enabled: false},
+					GpuMigGpuInstanceID:     ResourceAttributeConfig{Enabled: false},
+					GpuMigSlices:            ResourceAttributeConfig{Enabled: false},
+					GpuModel:                ResourceAttributeConfig{Enabled: false},
+					GpuNumber:               ResourceAttributeConfig{Enabled: false},
+					GpuUUID:                 ResourceAttributeConfig{Enabled: false},
 				},
 			},
 		},
@@ -286,17 +292,23 @@ func TestResourceAttributesConfig(t *testing.T) {
 		{
 			name: "all_set",
 			want: ResourceAttributesConfig{
-				GpuModel:  ResourceAttributeConfig{Enabled: true},
-				GpuNumber: ResourceAttributeConfig{Enabled: true},
-				GpuUUID:   ResourceAttributeConfig{Enabled: true},
+				GpuMigComputeInstanceID: ResourceAttributeConfig{Enabled: true},
+				GpuMigGpuInstanceID:     ResourceAttributeConfig{Enabled: true},
+				GpuMigSlices:            ResourceAttributeConfig{Enabled: true},
+				GpuModel:                ResourceAttributeConfig{Enabled: true},
+				GpuNumber:               ResourceAttributeConfig{Enabled: true},
+				GpuUUID:                 ResourceAttributeConfig{Enabled: true},
 			},
 		},
 		{
 			name: "none_set",
 			want: ResourceAttributesConfig{
-				GpuModel:  ResourceAttributeConfig{Enabled: false},
-				GpuNumber: ResourceAttributeConfig{Enabled: false},
-				GpuUUID:   ResourceAttributeConfig{Enabled: false},
+				GpuMigComputeInstanceID: ResourceAttributeConfig{Enabled: false},
+				GpuMigGpuInstanceID:     ResourceAttributeConfig{Enabled: false},
+				GpuMigSlices:            ResourceAttributeConfig{Enabled: false},
+				GpuModel:                ResourceAttributeConfig{Enabled: false},
+				GpuNumber:               ResourceAttributeConfig{Enabled: false},
+				GpuUUID:                 ResourceAttributeConfig{Enabled: false},
 			},
 		},
 	}
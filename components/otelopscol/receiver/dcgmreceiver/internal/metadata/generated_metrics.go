@@ -1422,6 +1422,24 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 		resourceAttributeIncludeFilter:          make(map[string]filter.Filter),
 		resourceAttributeExcludeFilter:          make(map[string]filter.Filter),
 	}
+	if mbc.ResourceAttributes.GpuMigComputeInstanceID.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["gpu.mig.compute_instance.id"] = filter.CreateFilter(mbc.ResourceAttributes.GpuMigComputeInstanceID.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.GpuMigComputeInstanceID.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["gpu.mig.compute_instance.id"] = filter.CreateFilter(mbc.ResourceAttributes.GpuMigComputeInstanceID.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.GpuMigGpuInstanceID.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["gpu.mig.gpu_instance.id"] = filter.CreateFilter(mbc.ResourceAttributes.GpuMigGpuInstanceID.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.GpuMigGpuInstanceID.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["gpu.mig.gpu_instance.id"] = filter.CreateFilter(mbc.ResourceAttributes.GpuMigGpuInstanceID.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.GpuMigSlices.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["gpu.mig.slices"] = filter.CreateFilter(mbc.ResourceAttributes.GpuMigSlices.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.GpuMigSlices.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["gpu.mig.slices"] = filter.CreateFilter(mbc.ResourceAttributes.GpuMigSlices.MetricsExclude)
+	}
 	if mbc.ResourceAttributes.GpuModel.MetricsInclude != nil {
 		mb.resourceAttributeIncludeFilter["gpu.model"] = filter.CreateFilter(mbc.ResourceAttributes.GpuModel.MetricsInclude)
 	}
@@ -153,6 +153,9 @@ func TestMetricsBuilder(t *testing.T) {
 			}
 
 			rb := mb.NewResourceBuilder()
+			rb.SetGpuMigComputeInstanceID("gpu.mig.compute_instance.id-val")
+			rb.SetGpuMigGpuInstanceID("gpu.mig.gpu_instance.id-val")
+			rb.SetGpuMigSlices("gpu.mig.slices-val")
 			rb.SetGpuModel("gpu.model-val")
 			rb.SetGpuNumber("gpu.number-val")
 			rb.SetGpuUUID("gpu.uuid-val")
@@ -21,6 +21,27 @@ func NewResourceBuilder(rac ResourceAttributesConfig) *ResourceBuilder {
 	}
 }
 
+// SetGpuMigComputeInstanceID sets provided value as "gpu.mig.compute_instance.id" attribute.
+func (rb *ResourceBuilder) SetGpuMigComputeInstanceID(val string) {
+	if rb.config.GpuMigComputeInstanceID.Enabled {
+		rb.res.Attributes().PutStr("gpu.mig.compute_instance.id", val)
+	}
+}
+
+// SetGpuMigGpuInstanceID sets provided value as "gpu.mig.gpu_instance.id" attribute.
+func (rb *ResourceBuilder) SetGpuMigGpuInstanceID(val string) {
+	if rb.config.GpuMigGpuInstanceID.Enabled {
+		rb.res.Attributes().PutStr("gpu.mig.gpu_instance.id", val)
+	}
+}
+
+// SetGpuMigSlices sets provided value as "gpu.mig.slices" attribute.
+func (rb *ResourceBuilder) SetGpuMigSlices(val string) {
+	if rb.config.GpuMigSlices.Enabled {
+		rb.res.Attributes().PutStr("gpu.mig.slices", val)
+	}
+}
+
 // SetGpuModel sets provided value as "gpu.model" attribute.
 func (rb *ResourceBuilder) SetGpuModel(val string) {
 	if rb.config.GpuModel.Enabled {
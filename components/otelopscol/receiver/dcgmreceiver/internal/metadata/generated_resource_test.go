@@ -13,6 +13,9 @@ func TestResourceBuilder(t *testing.T) {
 		t.Run(tt, func(t *testing.T) {
 			cfg := loadResourceAttributesConfig(t, tt)
 			rb := NewResourceBuilder(cfg)
+			rb.SetGpuMigComputeInstanceID("gpu.mig.compute_instance.id-val")
+			rb.SetGpuMigGpuInstanceID("gpu.mig.gpu_instance.id-val")
+			rb.SetGpuMigSlices("gpu.mig.slices-val")
 			rb.SetGpuModel("gpu.model-val")
 			rb.SetGpuNumber("gpu.number-val")
 			rb.SetGpuUUID("gpu.uuid-val")
@@ -22,15 +25,30 @@ func TestResourceBuilder(t *testing.T) {
 
 			switch tt {
 			case "default":
-				assert.Equal(t, 3, res.Attributes().Len())
+				assert.Equal(t, 6, res.Attributes().Len())
 			case "all_set":
-				assert.Equal(t, 3, res.Attributes().Len())
+				assert.Equal(t, 6, res.Attributes().Len())
 			case "none_set":
 				assert.Equal(t, 0, res.Attributes().Len())
 				return
 			default:
 				assert.Failf(t, "unexpected test case: %s", tt)
 			}
+			gpuMigComputeInstanceIDAttrVal, ok := res.Attributes().Get("gpu.mig.compute_instance.id")
+			assert.True(t, ok)
+			if ok {
+				assert.Equal(t, "gpu.mig.compute_instance.id-val", gpuMigComputeInstanceIDAttrVal.Str())
+			}
+			gpuMigGpuInstanceIDAttrVal, ok := res.Attributes().Get("gpu.mig.gpu_instance.id")
+			assert.True(t, ok)
+			if ok {
+				assert.Equal(t, "gpu.mig.gpu_instance.id-val", gpuMigGpuInstanceIDAttrVal.Str())
+			}
+			gpuMigSlicesAttrVal, ok := res.Attributes().Get("gpu.mig.slices")
+			assert.True(t, ok)
+			if ok {
+				assert.Equal(t, "gpu.mig.slices-val", gpuMigSlicesAttrVal.Str())
+			}
 			gpuModelAttrVal, ok := res.Attributes().Get("gpu.model")
 			assert.True(t, ok)
 			if ok {
@@ -21,6 +21,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -35,15 +36,17 @@ import (
 type dcgmScraper struct {
 	config           *Config
 	settings         receiver.Settings
+	dcgm             dcgmAPI
 	initRetryDelay   time.Duration
 	mb               *metadata.MetricsBuilder
+	cmb              *customMetricsBuilder
 	collectTriggerCh chan<- struct{}
 	metricsCh        <-chan map[uint]deviceMetrics
 	cancel           func()
 }
 
 func newDcgmScraper(config *Config, settings receiver.Settings) *dcgmScraper {
-	return &dcgmScraper{config: config, settings: settings, initRetryDelay: 10 * time.Second}
+	return &dcgmScraper{config: config, settings: settings, dcgm: dcgmLibrary{}, initRetryDelay: 10 * time.Second}
 }
 
 const scrapePollingInterval = 100 * time.Millisecond // TODO: Choose an appropriate value
@@ -59,7 +62,7 @@ func (s *dcgmScraper) initClient() (*dcgmClient, error) {
 		retryBlankValues: true,
 		maxRetries:       5,
 	}
-	client, err := newClient(clientSettings, s.settings.Logger)
+	client, err := newClient(clientSettings, s.dcgm, s.settings.Logger)
 	if err != nil {
 		s.settings.Logger.Sugar().Warn(err)
 		if errors.Is(err, ErrDcgmInitialization) {
@@ -78,6 +81,7 @@ func (s *dcgmScraper) start(ctx context.Context, _ component.Host) error {
 	mbConfig.Metrics = s.config.Metrics
 	s.mb = metadata.NewMetricsBuilder(
 		mbConfig, s.settings, metadata.WithStartTime(startTime))
+	s.cmb = newCustomMetricsBuilder(s.config.CustomFields, startTime, s.settings.BuildInfo.Version)
 
 	scrapeCtx, scrapeCancel := context.WithCancel(context.WithoutCancel(ctx))
 	g, scrapeCtx := errgroup.WithContext(scrapeCtx)
@@ -173,8 +177,12 @@ func discoverRequestedFields(config *Config) []string {
 		requestedFields = append(requestedFields, "DCGM_FI_DEV_ECC_DBE_VOL_TOTAL")
 	}
 	if config.Metrics.GpuDcgmXidErrors.Enabled {
-		// requestedFields = append(requestedFields, "")
-		func() {}() // no-op
+		requestedFields = append(requestedFields, "DCGM_FI_DEV_XID_ERRORS")
+	}
+	for _, f := range config.CustomFields {
+		if !slices.Contains(requestedFields, f.Field) {
+			requestedFields = append(requestedFields, f.Field)
+		}
 	}
 
 	return requestedFields
@@ -257,116 +265,149 @@ func (s *dcgmScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 		rb.SetGpuModel(gpu.ModelName)
 		gpuResource := rb.Emit()
 
-		v, ok := gpu.Metrics.LastFloat64("DCGM_FI_PROF_GR_ENGINE_ACTIVE")
-		if !ok {
-			v, ok = gpu.Metrics.LastFloat64("DCGM_FI_DEV_GPU_UTIL")
-			v /= 100.0 /* normalize */
-		}
-		if ok {
-			s.mb.RecordGpuDcgmUtilizationDataPoint(now, v)
-		}
-		if v, ok := gpu.Metrics.LastFloat64("DCGM_FI_PROF_SM_ACTIVE"); ok {
-			s.mb.RecordGpuDcgmSmUtilizationDataPoint(now, v)
-		}
-		if v, ok := gpu.Metrics.LastFloat64("DCGM_FI_PROF_SM_OCCUPANCY"); ok {
-			s.mb.RecordGpuDcgmSmOccupancyDataPoint(now, v)
-		}
-		if v, ok := gpu.Metrics.LastFloat64("DCGM_FI_PROF_PIPE_TENSOR_ACTIVE"); ok {
-			s.mb.RecordGpuDcgmPipeUtilizationDataPoint(now, v, metadata.AttributeGpuPipeTensor)
-		}
-		if v, ok := gpu.Metrics.LastFloat64("DCGM_FI_PROF_PIPE_FP64_ACTIVE"); ok {
-			s.mb.RecordGpuDcgmPipeUtilizationDataPoint(now, v, metadata.AttributeGpuPipeFp64)
-		}
-		if v, ok := gpu.Metrics.LastFloat64("DCGM_FI_PROF_PIPE_FP32_ACTIVE"); ok {
-			s.mb.RecordGpuDcgmPipeUtilizationDataPoint(now, v, metadata.AttributeGpuPipeFp32)
-		}
-		if v, ok := gpu.Metrics.LastFloat64("DCGM_FI_PROF_PIPE_FP16_ACTIVE"); ok {
-			s.mb.RecordGpuDcgmPipeUtilizationDataPoint(now, v, metadata.AttributeGpuPipeFp16)
-		}
-		if v, ok := gpu.Metrics.LastFloat64("DCGM_FI_DEV_ENC_UTIL"); ok {
-			s.mb.RecordGpuDcgmCodecEncoderUtilizationDataPoint(now, v/100.0) /* normalize */
-		}
-		if v, ok := gpu.Metrics.LastFloat64("DCGM_FI_DEV_DEC_UTIL"); ok {
-			s.mb.RecordGpuDcgmCodecDecoderUtilizationDataPoint(now, v/100.0) /* normalize */
-		}
-		if v, ok := gpu.Metrics.LastInt64("DCGM_FI_DEV_FB_FREE"); ok {
-			s.mb.RecordGpuDcgmMemoryBytesUsedDataPoint(now, 1e6*v, metadata.AttributeGpuMemoryStateFree) /* MBy to By */
-		}
-		if v, ok := gpu.Metrics.LastInt64("DCGM_FI_DEV_FB_USED"); ok {
-			s.mb.RecordGpuDcgmMemoryBytesUsedDataPoint(now, 1e6*v, metadata.AttributeGpuMemoryStateUsed) /* MBy to By */
-		}
-		if v, ok := gpu.Metrics.LastInt64("DCGM_FI_DEV_FB_RESERVED"); ok {
-			s.mb.RecordGpuDcgmMemoryBytesUsedDataPoint(now, 1e6*v, metadata.AttributeGpuMemoryStateReserved) /* MBy to By */
-		}
-		v, ok = gpu.Metrics.LastFloat64("DCGM_FI_PROF_DRAM_ACTIVE")
-		if !ok { // fallback
-			v, ok = gpu.Metrics.LastFloat64("DCGM_FI_DEV_MEM_COPY_UTIL")
-			v /= 100.0 /* normalize */
-		}
-		if ok {
-			s.mb.RecordGpuDcgmMemoryBandwidthUtilizationDataPoint(now, v)
-		}
-		if v, ok := gpu.Metrics.IntegratedRate("DCGM_FI_PROF_PCIE_TX_BYTES"); ok {
-			s.mb.RecordGpuDcgmPcieIoDataPoint(now, v, metadata.AttributeNetworkIoDirectionTransmit)
-		}
-		if v, ok := gpu.Metrics.IntegratedRate("DCGM_FI_PROF_PCIE_RX_BYTES"); ok {
-			s.mb.RecordGpuDcgmPcieIoDataPoint(now, v, metadata.AttributeNetworkIoDirectionReceive)
-		}
-		if v, ok := gpu.Metrics.IntegratedRate("DCGM_FI_PROF_NVLINK_TX_BYTES"); ok {
-			s.mb.RecordGpuDcgmNvlinkIoDataPoint(now, v, metadata.AttributeNetworkIoDirectionTransmit)
-		}
-		if v, ok := gpu.Metrics.IntegratedRate("DCGM_FI_PROF_NVLINK_RX_BYTES"); ok {
-			s.mb.RecordGpuDcgmNvlinkIoDataPoint(now, v, metadata.AttributeNetworkIoDirectionReceive)
-		}
-		i, ok := gpu.Metrics.CumulativeTotal("DCGM_FI_DEV_TOTAL_ENERGY_CONSUMPTION")
-		v = float64(i) / 1e3 /* mJ to J */
-		if !ok {             // fallback
-			i, ok = gpu.Metrics.IntegratedRate("DCGM_FI_DEV_POWER_USAGE")
-			v = float64(i)
-		}
-		if ok {
-			s.mb.RecordGpuDcgmEnergyConsumptionDataPoint(now, v)
-		}
-		if v, ok := gpu.Metrics.LastFloat64("DCGM_FI_DEV_GPU_TEMP"); ok {
-			s.mb.RecordGpuDcgmTemperatureDataPoint(now, v)
-		}
-		if v, ok := gpu.Metrics.LastFloat64("DCGM_FI_DEV_SM_CLOCK"); ok {
-			s.mb.RecordGpuDcgmClockFrequencyDataPoint(now, 1e6*v) /* MHz to Hz */
-		}
-		if v, ok := gpu.Metrics.CumulativeTotal("DCGM_FI_DEV_POWER_VIOLATION"); ok {
-			s.mb.RecordGpuDcgmClockThrottleDurationTimeDataPoint(now, float64(v)/1e9, metadata.AttributeGpuClockViolationPower) /* ns to s */
-		}
-		if v, ok := gpu.Metrics.CumulativeTotal("DCGM_FI_DEV_THERMAL_VIOLATION"); ok {
-			s.mb.RecordGpuDcgmClockThrottleDurationTimeDataPoint(now, float64(v)/1e9, metadata.AttributeGpuClockViolationThermal) /* ns to s */
-		}
-		if v, ok := gpu.Metrics.CumulativeTotal("DCGM_FI_DEV_SYNC_BOOST_VIOLATION"); ok {
-			s.mb.RecordGpuDcgmClockThrottleDurationTimeDataPoint(now, float64(v)/1e9, metadata.AttributeGpuClockViolationSyncBoost) /* ns to s */
-		}
-		if v, ok := gpu.Metrics.CumulativeTotal("DCGM_FI_DEV_BOARD_LIMIT_VIOLATION"); ok {
-			s.mb.RecordGpuDcgmClockThrottleDurationTimeDataPoint(now, float64(v)/1e9, metadata.AttributeGpuClockViolationBoardLimit) /* ns to s */
-		}
-		if v, ok := gpu.Metrics.CumulativeTotal("DCGM_FI_DEV_LOW_UTIL_VIOLATION"); ok {
-			s.mb.RecordGpuDcgmClockThrottleDurationTimeDataPoint(now, float64(v)/1e9, metadata.AttributeGpuClockViolationLowUtil) /* ns to s */
-		}
-		if v, ok := gpu.Metrics.CumulativeTotal("DCGM_FI_DEV_RELIABILITY_VIOLATION"); ok {
-			s.mb.RecordGpuDcgmClockThrottleDurationTimeDataPoint(now, float64(v)/1e9, metadata.AttributeGpuClockViolationReliability) /* ns to s */
-		}
-		if v, ok := gpu.Metrics.CumulativeTotal("DCGM_FI_DEV_TOTAL_APP_CLOCKS_VIOLATION"); ok {
-			s.mb.RecordGpuDcgmClockThrottleDurationTimeDataPoint(now, float64(v)/1e9, metadata.AttributeGpuClockViolationAppClock) /* ns to s */
-		}
-		if v, ok := gpu.Metrics.CumulativeTotal("DCGM_FI_DEV_TOTAL_BASE_CLOCKS_VIOLATION"); ok {
-			s.mb.RecordGpuDcgmClockThrottleDurationTimeDataPoint(now, float64(v)/1e9, metadata.AttributeGpuClockViolationBaseClock) /* ns to s */
-		}
-		if v, ok := gpu.Metrics.CumulativeTotal("DCGM_FI_DEV_ECC_SBE_VOL_TOTAL"); ok {
-			s.mb.RecordGpuDcgmEccErrorsDataPoint(now, v, metadata.AttributeGpuErrorTypeSbe)
+		s.recordMetrics(now, gpu.Metrics)
+		s.cmb.record(gpuResource, now, gpu.Metrics)
+
+		xids := make([]int64, 0, len(gpu.XIDErrors))
+		for xid := range gpu.XIDErrors {
+			xids = append(xids, xid)
 		}
-		if v, ok := gpu.Metrics.CumulativeTotal("DCGM_FI_DEV_ECC_DBE_VOL_TOTAL"); ok {
-			s.mb.RecordGpuDcgmEccErrorsDataPoint(now, v, metadata.AttributeGpuErrorTypeDbe)
+		slices.Sort(xids)
+		for _, xid := range xids {
+			s.mb.RecordGpuDcgmXidErrorsDataPoint(now, gpu.XIDErrors[xid], xid)
 		}
-		// TODO: XID errors.
-		// s.mb.RecordGpuDcgmXidErrorsDataPoint(now, metric.asInt64(), xid)
 		s.mb.EmitForResource(metadata.WithResource(gpuResource))
+
+		for _, entity := range sortedMigEntities(gpu.Instances) {
+			instance := gpu.Instances[entity]
+			rb := s.mb.NewResourceBuilder()
+			rb.SetGpuNumber(fmt.Sprintf("%d", gpuIndex))
+			rb.SetGpuUUID(gpu.UUID)
+			rb.SetGpuModel(gpu.ModelName)
+			rb.SetGpuMigSlices(instance.Slices)
+			rb.SetGpuMigGpuInstanceID(fmt.Sprintf("%d", instance.GPUInstanceID))
+			if instance.isComputeInstance() {
+				rb.SetGpuMigComputeInstanceID(fmt.Sprintf("%d", instance.ComputeInstanceID))
+			}
+			instanceResource := rb.Emit()
+
+			s.recordMetrics(now, instance.Metrics)
+			s.cmb.record(instanceResource, now, instance.Metrics)
+			s.mb.EmitForResource(metadata.WithResource(instanceResource))
+		}
 	}
 
-	return s.mb.Emit(), nil
+	md := s.mb.Emit()
+	s.cmb.emit(md)
+	return md, nil
+}
+
+// recordMetrics records the metrics of a device or MIG instance.
+func (s *dcgmScraper) recordMetrics(now pcommon.Timestamp, metrics MetricsMap) {
+	v, ok := metrics.LastFloat64("DCGM_FI_PROF_GR_ENGINE_ACTIVE")
+	if !ok {
+		v, ok = metrics.LastFloat64("DCGM_FI_DEV_GPU_UTIL")
+		v /= 100.0 /* normalize */
+	}
+	if ok {
+		s.mb.RecordGpuDcgmUtilizationDataPoint(now, v)
+	}
+	if v, ok := metrics.LastFloat64("DCGM_FI_PROF_SM_ACTIVE"); ok {
+		s.mb.RecordGpuDcgmSmUtilizationDataPoint(now, v)
+	}
+	if v, ok := metrics.LastFloat64("DCGM_FI_PROF_SM_OCCUPANCY"); ok {
+		s.mb.RecordGpuDcgmSmOccupancyDataPoint(now, v)
+	}
+	if v, ok := metrics.LastFloat64("DCGM_FI_PROF_PIPE_TENSOR_ACTIVE"); ok {
+		s.mb.RecordGpuDcgmPipeUtilizationDataPoint(now, v, metadata.AttributeGpuPipeTensor)
+	}
+	if v, ok := metrics.LastFloat64("DCGM_FI_PROF_PIPE_FP64_ACTIVE"); ok {
+		s.mb.RecordGpuDcgmPipeUtilizationDataPoint(now, v, metadata.AttributeGpuPipeFp64)
+	}
+	if v, ok := metrics.LastFloat64("DCGM_FI_PROF_PIPE_FP32_ACTIVE"); ok {
+		s.mb.RecordGpuDcgmPipeUtilizationDataPoint(now, v, metadata.AttributeGpuPipeFp32)
+	}
+	if v, ok := metrics.LastFloat64("DCGM_FI_PROF_PIPE_FP16_ACTIVE"); ok {
+		s.mb.RecordGpuDcgmPipeUtilizationDataPoint(now, v, metadata.AttributeGpuPipeFp16)
+	}
+	if v, ok := metrics.LastFloat64("DCGM_FI_DEV_ENC_UTIL"); ok {
+		s.mb.RecordGpuDcgmCodecEncoderUtilizationDataPoint(now, v/100.0) /* normalize */
+	}
+	if v, ok := metrics.LastFloat64("DCGM_FI_DEV_DEC_UTIL"); ok {
+		s.mb.RecordGpuDcgmCodecDecoderUtilizationDataPoint(now, v/100.0) /* normalize */
+	}
+	if v, ok := metrics.LastInt64("DCGM_FI_DEV_FB_FREE"); ok {
+		s.mb.RecordGpuDcgmMemoryBytesUsedDataPoint(now, 1e6*v, metadata.AttributeGpuMemoryStateFree) /* MBy to By */
+	}
+	if v, ok := metrics.LastInt64("DCGM_FI_DEV_FB_USED"); ok {
+		s.mb.RecordGpuDcgmMemoryBytesUsedDataPoint(now, 1e6*v, metadata.AttributeGpuMemoryStateUsed) /* MBy to By */
+	}
+	if v, ok := metrics.LastInt64("DCGM_FI_DEV_FB_RESERVED"); ok {
+		s.mb.RecordGpuDcgmMemoryBytesUsedDataPoint(now, 1e6*v, metadata.AttributeGpuMemoryStateReserved) /* MBy to By */
+	}
+	v, ok = metrics.LastFloat64("DCGM_FI_PROF_DRAM_ACTIVE")
+	if !ok { // fallback
+		v, ok = metrics.LastFloat64("DCGM_FI_DEV_MEM_COPY_UTIL")
+		v /= 100.0 /* normalize */
+	}
+	if ok {
+		s.mb.RecordGpuDcgmMemoryBandwidthUtilizationDataPoint(now, v)
+	}
+	if v, ok := metrics.IntegratedRate("DCGM_FI_PROF_PCIE_TX_BYTES"); ok {
+		s.mb.RecordGpuDcgmPcieIoDataPoint(now, v, metadata.AttributeNetworkIoDirectionTransmit)
+	}
+	if v, ok := metrics.IntegratedRate("DCGM_FI_PROF_PCIE_RX_BYTES"); ok {
+		s.mb.RecordGpuDcgmPcieIoDataPoint(now, v, metadata.AttributeNetworkIoDirectionReceive)
+	}
+	if v, ok := metrics.IntegratedRate("DCGM_FI_PROF_NVLINK_TX_BYTES"); ok {
+		s.mb.RecordGpuDcgmNvlinkIoDataPoint(now, v, metadata.AttributeNetworkIoDirectionTransmit)
+	}
+	if v, ok := metrics.IntegratedRate("DCGM_FI_PROF_NVLINK_RX_BYTES"); ok {
+		s.mb.RecordGpuDcgmNvlinkIoDataPoint(now, v, metadata.AttributeNetworkIoDirectionReceive)
+	}
+	i, ok := metrics.CumulativeTotal("DCGM_FI_DEV_TOTAL_ENERGY_CONSUMPTION")
+	v = float64(i) / 1e3 /* mJ to J */
+	if !ok {             // fallback
+		i, ok = metrics.IntegratedRate("DCGM_FI_DEV_POWER_USAGE")
+		v = float64(i)
+	}
+	if ok {
+		s.mb.RecordGpuDcgmEnergyConsumptionDataPoint(now, v)
+	}
+	if v, ok := metrics.LastFloat64("DCGM_FI_DEV_GPU_TEMP"); ok {
+		s.mb.RecordGpuDcgmTemperatureDataPoint(now, v)
+	}
+	if v, ok := metrics.LastFloat64("DCGM_FI_DEV_SM_CLOCK"); ok {
+		s.mb.RecordGpuDcgmClockFrequencyDataPoint(now, 1e6*v) /* MHz to Hz */
+	}
+	if v, ok := metrics.CumulativeTotal("DCGM_FI_DEV_POWER_VIOLATION"); ok {
+		s.mb.RecordGpuDcgmClockThrottleDurationTimeDataPoint(now, float64(v)/1e9, metadata.AttributeGpuClockViolationPower) /* ns to s */
+	}
+	if v, ok := metrics.CumulativeTotal("DCGM_FI_DEV_THERMAL_VIOLATION"); ok {
+		s.mb.RecordGpuDcgmClockThrottleDurationTimeDataPoint(now, float64(v)/1e9, metadata.AttributeGpuClockViolationThermal) /* ns to s */
+	}
+	if v, ok := metrics.CumulativeTotal("DCGM_FI_DEV_SYNC_BOOST_VIOLATION"); ok {
+		s.mb.RecordGpuDcgmClockThrottleDurationTimeDataPoint(now, float64(v)/1e9, metadata.AttributeGpuClockViolationSyncBoost) /* ns to s */
+	}
+	if v, ok := metrics.CumulativeTotal("DCGM_FI_DEV_BOARD_LIMIT_VIOLATION"); ok {
+		s.mb.RecordGpuDcgmClockThrottleDurationTimeDataPoint(now, float64(v)/1e9, metadata.AttributeGpuClockViolationBoardLimit) /* ns to s */
+	}
+	if v, ok := metrics.CumulativeTotal("DCGM_FI_DEV_LOW_UTIL_VIOLATION"); ok {
+		s.mb.RecordGpuDcgmClockThrottleDurationTimeDataPoint(now, float64(v)/1e9, metadata.AttributeGpuClockViolationLowUtil) /* ns to s */
+	}
+	if v, ok := metrics.CumulativeTotal("DCGM_FI_DEV_RELIABILITY_VIOLATION"); ok {
+		s.mb.RecordGpuDcgmClockThrottleDurationTimeDataPoint(now, float64(v)/1e9, metadata.AttributeGpuClockViolationReliability) /* ns to s */
+	}
+	if v, ok := metrics.CumulativeTotal("DCGM_FI_DEV_TOTAL_APP_CLOCKS_VIOLATION"); ok {
+		s.mb.RecordGpuDcgmClockThrottleDurationTimeDataPoint(now, float64(v)/1e9, metadata.AttributeGpuClockViolationAppClock) /* ns to s */
+	}
+	if v, ok := metrics.CumulativeTotal("DCGM_FI_DEV_TOTAL_BASE_CLOCKS_VIOLATION"); ok {
+		s.mb.RecordGpuDcgmClockThrottleDurationTimeDataPoint(now, float64(v)/1e9, metadata.AttributeGpuClockViolationBaseClock) /* ns to s */
+	}
+	if v, ok := metrics.CumulativeTotal("DCGM_FI_DEV_ECC_SBE_VOL_TOTAL"); ok {
+		s.mb.RecordGpuDcgmEccErrorsDataPoint(now, v, metadata.AttributeGpuErrorTypeSbe)
+	}
+	if v, ok := metrics.CumulativeTotal("DCGM_FI_DEV_ECC_DBE_VOL_TOTAL"); ok {
+		s.mb.RecordGpuDcgmEccErrorsDataPoint(now, v, metadata.AttributeGpuErrorTypeDbe)
+	}
 }
@@ -19,13 +19,17 @@ package dcgmreceiver
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
+	"github.com/NVIDIA/go-dcgm/pkg/dcgm"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -69,3 +73,128 @@ func TestScraperWithoutDcgm(t *testing.T) {
 	err = scraper.stop(context.Background())
 	assert.NoError(t, err)
 }
+
+func TestScraperReconnectsWithFakeDcgm(t *testing.T) {
+	fake := newFakeDcgm(t, dcgm.Device{UUID: "GPU-0", Identifiers: dcgm.DeviceIdentifiers{Model: "NVIDIA L4"}})
+	fake.initFailures = 2
+	fake.values[dcgm.DCGM_FI_DEV_GPU_UTIL] = func(dcgm.GroupEntityPair, int) any {
+		return int64(50)
+	}
+
+	var settings receiver.Settings
+	settings.Logger = zaptest.NewLogger(t)
+	scraper := newDcgmScraper(createDefaultConfig().(*Config), settings)
+	scraper.dcgm = fake
+	scraper.initRetryDelay = 0 // retry immediately
+
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	var metrics pmetric.Metrics
+	require.Eventually(t, func() bool {
+		var err error
+		metrics, err = scraper.scrape(context.Background())
+		require.NoError(t, err)
+		return metrics.MetricCount() > 0
+	}, 10*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, scraper.stop(context.Background()))
+
+	// The scraper can connect again while it's being stopped.
+	fake.mu.Lock()
+	assert.GreaterOrEqual(t, fake.initCalls, 3)
+	fake.mu.Unlock()
+	assert.True(t, fake.isClean())
+
+	rm := metrics.ResourceMetrics().At(0)
+	gpuUUID, _ := rm.Resource().Attributes().Get("gpu.uuid")
+	assert.Equal(t, "GPU-0", gpuUUID.Str())
+	m := rm.ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, "gpu.dcgm.utilization", m.Name())
+	assert.Equal(t, 0.5, m.Gauge().DataPoints().At(0).DoubleValue())
+}
+
+func TestScraperRecoversFromPollingErrorsWithFakeDcgm(t *testing.T) {
+	fake := newFakeDcgm(t, dcgm.Device{UUID: "GPU-0"})
+	fake.pollErr = errors.New("connection lost")
+	fake.pollFailures = 3
+	fake.values[dcgm.DCGM_FI_DEV_GPU_UTIL] = func(dcgm.GroupEntityPair, int) any {
+		return int64(50)
+	}
+
+	var settings receiver.Settings
+	settings.Logger = zaptest.NewLogger(t)
+	scraper := newDcgmScraper(createDefaultConfig().(*Config), settings)
+	scraper.dcgm = fake
+
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+	// Each scrape triggers at most one poll, so the first one can't see a
+	// successful poll.
+	metrics, err := scraper.scrape(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, metrics.MetricCount())
+
+	require.Eventually(t, func() bool {
+		var err error
+		metrics, err = scraper.scrape(context.Background())
+		require.NoError(t, err)
+		return metrics.MetricCount() > 0
+	}, 10*time.Second, 10*time.Millisecond)
+	require.NoError(t, scraper.stop(context.Background()))
+	assert.True(t, fake.isClean())
+
+	// The scraper keeps polling the same connection after polling errors.
+	fake.mu.Lock()
+	assert.Greater(t, fake.pollCalls, fake.pollFailures)
+	assert.Equal(t, 1, fake.initCalls)
+	fake.mu.Unlock()
+
+	m := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, "gpu.dcgm.utilization", m.Name())
+	assert.Equal(t, 0.5, m.Gauge().DataPoints().At(0).DoubleValue())
+}
+
+func TestScraperMigInstancesWithFakeDcgm(t *testing.T) {
+	fake := newFakeDcgm(t, dcgm.Device{UUID: "GPU-0", Identifiers: dcgm.DeviceIdentifiers{Model: "NVIDIA A100"}})
+	fake.migHierarchy = testMigHierarchy
+	fake.supportedProfilingFields = []dcgm.Short{dcgm.DCGM_FI_PROF_GR_ENGINE_ACTIVE}
+	fake.values[dcgm.DCGM_FI_PROF_GR_ENGINE_ACTIVE] = func(dcgm.GroupEntityPair, int) any {
+		return 0.25
+	}
+
+	var settings receiver.Settings
+	settings.Logger = zaptest.NewLogger(t)
+	scraper := newDcgmScraper(createDefaultConfig().(*Config), settings)
+	scraper.dcgm = fake
+
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+	var metrics pmetric.Metrics
+	require.Eventually(t, func() bool {
+		var err error
+		metrics, err = scraper.scrape(context.Background())
+		require.NoError(t, err)
+		return metrics.ResourceMetrics().Len() == 4
+	}, 10*time.Second, 10*time.Millisecond)
+	require.NoError(t, scraper.stop(context.Background()))
+
+	var resources []map[string]any
+	for i := 0; i < metrics.ResourceMetrics().Len(); i++ {
+		rm := metrics.ResourceMetrics().At(i)
+		resources = append(resources, rm.Resource().Attributes().AsRaw())
+		m := rm.ScopeMetrics().At(0).Metrics().At(0)
+		assert.Equal(t, "gpu.dcgm.utilization", m.Name())
+		assert.Equal(t, 0.25, m.Gauge().DataPoints().At(0).DoubleValue())
+	}
+	gpu := map[string]any{"gpu.number": "0", "gpu.uuid": "GPU-0", "gpu.model": "NVIDIA A100"}
+	withMig := func(attrs map[string]any) map[string]any {
+		for k, v := range gpu {
+			attrs[k] = v
+		}
+		return attrs
+	}
+	assert.Equal(t, []map[string]any{
+		gpu,
+		withMig(map[string]any{"gpu.mig.slices": "3g", "gpu.mig.gpu_instance.id": "1"}),
+		withMig(map[string]any{"gpu.mig.slices": "4g", "gpu.mig.gpu_instance.id": "2"}),
+		withMig(map[string]any{"gpu.mig.slices": "1c.3g", "gpu.mig.gpu_instance.id": "1", "gpu.mig.compute_instance.id": "0"}),
+	}, resources)
+}
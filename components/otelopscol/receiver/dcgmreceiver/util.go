@@ -113,6 +113,16 @@ func (m MetricsMap) CumulativeTotal(name string) (int64, bool) {
 	return 0, false
 }
 
+// copy returns a copy of m that is not affected by later updates to m.
+func (m MetricsMap) copy() MetricsMap {
+	out := make(MetricsMap, len(m))
+	for key, value := range m {
+		newValue := *value
+		out[key] = &newValue
+	}
+	return out
+}
+
 var (
 	errBlankValue       = fmt.Errorf("unspecified blank value")
 	errDataNotFound     = fmt.Errorf("data not found")
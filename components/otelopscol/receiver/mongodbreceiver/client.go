@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/go-version"
 	"go.mongodb.org/mongo-driver/bson"
@@ -37,6 +38,11 @@ type client interface {
 	DBStats(ctx context.Context, DBName string) (bson.M, error)
 	TopStats(ctx context.Context) (bson.M, error)
 	IndexStats(ctx context.Context, DBName, collectionName string) ([]bson.M, error)
+	ReplSetGetStatus(ctx context.Context) (bson.M, error)
+	OplogStats(ctx context.Context) (bson.M, error)
+	OplogWindow(ctx context.Context) (time.Duration, error)
+	BalancerStatus(ctx context.Context) (bson.M, error)
+	ChunkCounts(ctx context.Context) ([]bson.M, error)
 }
 
 // mongodbClient is a mongodb metric scraper client
@@ -49,7 +55,11 @@ type mongodbClient struct {
 // NewClient creates a new client to connect and query mongo for the
 // mongodbreceiver
 func NewClient(ctx context.Context, config *Config, logger *zap.Logger) (client, error) {
-	driver, err := mongo.Connect(ctx, config.ClientOptions())
+	clientOptions, err := config.ClientOptions()
+	if err != nil {
+		return nil, err
+	}
+	driver, err := mongo.Connect(ctx, clientOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -92,9 +102,9 @@ func (c *mongodbClient) TopStats(ctx context.Context) (bson.M, error) {
 // ListCollectionNames returns a list of collection names for a given database
 // SetAuthorizedCollections allows a user without the required privilege to run the command ListCollections.
 // more information can be found here: https://pkg.go.dev/go.mongodb.org/mongo-driver@v1.9.0/mongo#Database.ListCollectionNames
-func (c *mongodbClient) ListCollectionNames(_ context.Context, database string) ([]string, error) {
+func (c *mongodbClient) ListCollectionNames(ctx context.Context, database string) ([]string, error) {
 	lcOpts := options.ListCollections().SetAuthorizedCollections(true)
-	return c.Database(database).ListCollectionNames(context.Background(), bson.D{}, lcOpts)
+	return c.Database(database).ListCollectionNames(ctx, bson.D{}, lcOpts)
 }
 
 // IndexStats returns the index stats per collection for a given database
@@ -102,19 +112,86 @@ func (c *mongodbClient) ListCollectionNames(_ context.Context, database string)
 func (c *mongodbClient) IndexStats(ctx context.Context, database, collectionName string) ([]bson.M, error) {
 	db := c.Client.Database(database)
 	collection := db.Collection(collectionName)
-	cursor, err := collection.Aggregate(context.Background(), mongo.Pipeline{bson.D{primitive.E{Key: "$indexStats", Value: bson.M{}}}})
+	cursor, err := collection.Aggregate(ctx, mongo.Pipeline{bson.D{primitive.E{Key: "$indexStats", Value: bson.M{}}}})
 	if err != nil {
 		return nil, err
 	}
 	defer cursor.Close(ctx)
 
 	var indexStats []bson.M
-	if err = cursor.All(context.Background(), &indexStats); err != nil {
+	if err = cursor.All(ctx, &indexStats); err != nil {
 		return nil, err
 	}
 	return indexStats, nil
 }
 
+// ReplSetGetStatus is an admin command that returns the result of db.adminCommand({ replSetGetStatus: 1 })
+// more information can be found here: https://www.mongodb.com/docs/manual/reference/command/replSetGetStatus/
+func (c *mongodbClient) ReplSetGetStatus(ctx context.Context) (bson.M, error) {
+	return c.RunCommand(ctx, "admin", bson.M{"replSetGetStatus": 1})
+}
+
+// OplogStats returns the result of db.getSiblingDB("local").runCommand({ collStats: "oplog.rs" })
+// more information can be found here: https://www.mongodb.com/docs/manual/reference/command/collStats/
+func (c *mongodbClient) OplogStats(ctx context.Context) (bson.M, error) {
+	return c.RunCommand(ctx, "local", bson.M{"collStats": "oplog.rs"})
+}
+
+// OplogWindow returns the time between the first and the last entries of the oplog
+// more information can be found here: https://www.mongodb.com/docs/manual/core/replica-set-oplog/
+func (c *mongodbClient) OplogWindow(ctx context.Context) (time.Duration, error) {
+	oplog := c.Database("local").Collection("oplog.rs")
+	first, err := oplogTimestamp(ctx, oplog, 1)
+	if err != nil {
+		return 0, fmt.Errorf("unable to get first oplog entry: %w", err)
+	}
+	last, err := oplogTimestamp(ctx, oplog, -1)
+	if err != nil {
+		return 0, fmt.Errorf("unable to get last oplog entry: %w", err)
+	}
+	return time.Duration(last.T-first.T) * time.Second, nil
+}
+
+// oplogTimestamp returns the timestamp of the first oplog entry in natural order, or the last one if order is -1.
+func oplogTimestamp(ctx context.Context, oplog *mongo.Collection, order int) (primitive.Timestamp, error) {
+	opts := options.FindOne().SetSort(bson.D{{Key: "$natural", Value: order}}).SetProjection(bson.M{"ts": 1})
+	var entry struct {
+		Ts primitive.Timestamp `bson:"ts"`
+	}
+	if err := oplog.FindOne(ctx, bson.D{}, opts).Decode(&entry); err != nil {
+		return primitive.Timestamp{}, err
+	}
+	return entry.Ts, nil
+}
+
+// BalancerStatus is an admin command that returns the result of db.adminCommand({ balancerStatus: 1 }), only on mongos
+// more information can be found here: https://www.mongodb.com/docs/manual/reference/command/balancerStatus/
+func (c *mongodbClient) BalancerStatus(ctx context.Context) (bson.M, error) {
+	return c.RunCommand(ctx, "admin", bson.M{"balancerStatus": 1})
+}
+
+// ChunkCounts returns the number of chunks per shard, as documents with the shard in _id and the number of chunks in count
+// more information can be found here: https://www.mongodb.com/docs/manual/reference/config-database/#mongodb-data-config.chunks
+func (c *mongodbClient) ChunkCounts(ctx context.Context) ([]bson.M, error) {
+	chunks := c.Database("config").Collection("chunks")
+	cursor, err := chunks.Aggregate(ctx, mongo.Pipeline{
+		bson.D{primitive.E{Key: "$group", Value: bson.D{
+			primitive.E{Key: "_id", Value: "$shard"},
+			primitive.E{Key: "count", Value: bson.M{"$sum": 1}},
+		}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var chunkCounts []bson.M
+	if err = cursor.All(ctx, &chunkCounts); err != nil {
+		return nil, err
+	}
+	return chunkCounts, nil
+}
+
 // GetVersion returns a result of the version of mongo the client is connected to so adjustments in collection protocol can
 // be determined
 func (c *mongodbClient) GetVersion(ctx context.Context) (*version.Version, error) {
@@ -18,14 +18,86 @@ import (
 	"context"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/go-version"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.uber.org/zap"
 )
 
+// fakeClient is a client whose responses are set up with On.
+type fakeClient struct{ mock.Mock }
+
+func (fc *fakeClient) ListDatabaseNames(ctx context.Context, filters interface{}, opts ...*options.ListDatabasesOptions) ([]string, error) {
+	args := fc.Called(ctx, filters, opts)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (fc *fakeClient) ListCollectionNames(ctx context.Context, dbName string) ([]string, error) {
+	args := fc.Called(ctx, dbName)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (fc *fakeClient) Disconnect(ctx context.Context) error {
+	args := fc.Called(ctx)
+	return args.Error(0)
+}
+
+func (fc *fakeClient) GetVersion(ctx context.Context) (*version.Version, error) {
+	args := fc.Called(ctx)
+	return args.Get(0).(*version.Version), args.Error(1)
+}
+
+func (fc *fakeClient) ServerStatus(ctx context.Context, dbName string) (bson.M, error) {
+	args := fc.Called(ctx, dbName)
+	return args.Get(0).(bson.M), args.Error(1)
+}
+
+func (fc *fakeClient) DBStats(ctx context.Context, dbName string) (bson.M, error) {
+	args := fc.Called(ctx, dbName)
+	return args.Get(0).(bson.M), args.Error(1)
+}
+
+func (fc *fakeClient) TopStats(ctx context.Context) (bson.M, error) {
+	args := fc.Called(ctx)
+	return args.Get(0).(bson.M), args.Error(1)
+}
+
+func (fc *fakeClient) IndexStats(ctx context.Context, dbName, collectionName string) ([]bson.M, error) {
+	args := fc.Called(ctx, dbName, collectionName)
+	return args.Get(0).([]bson.M), args.Error(1)
+}
+
+func (fc *fakeClient) ReplSetGetStatus(ctx context.Context) (bson.M, error) {
+	args := fc.Called(ctx)
+	return args.Get(0).(bson.M), args.Error(1)
+}
+
+func (fc *fakeClient) OplogStats(ctx context.Context) (bson.M, error) {
+	args := fc.Called(ctx)
+	return args.Get(0).(bson.M), args.Error(1)
+}
+
+func (fc *fakeClient) OplogWindow(ctx context.Context) (time.Duration, error) {
+	args := fc.Called(ctx)
+	return args.Get(0).(time.Duration), args.Error(1)
+}
+
+func (fc *fakeClient) BalancerStatus(ctx context.Context) (bson.M, error) {
+	args := fc.Called(ctx)
+	return args.Get(0).(bson.M), args.Error(1)
+}
+
+func (fc *fakeClient) ChunkCounts(ctx context.Context) ([]bson.M, error) {
+	args := fc.Called(ctx)
+	return args.Get(0).([]bson.M), args.Error(1)
+}
+
 func TestListDatabaseNames(t *testing.T) {
 	mont := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
 
@@ -194,6 +266,62 @@ func TestGetVersionFailures(t *testing.T) {
 
 }
 
+func TestOplogWindow(t *testing.T) {
+	mont := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mont.Run("oplog window", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "local.oplog.rs", mtest.FirstBatch, bson.D{
+				primitive.E{Key: "ts", Value: primitive.Timestamp{T: 1700000000, I: 1}},
+			}),
+			mtest.CreateCursorResponse(0, "local.oplog.rs", mtest.FirstBatch, bson.D{
+				primitive.E{Key: "ts", Value: primitive.Timestamp{T: 1700003600, I: 4}},
+			}),
+		)
+		client := mongodbClient{
+			Client: mt.Client,
+			logger: zap.NewNop(),
+		}
+
+		window, err := client.OplogWindow(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, time.Hour, window)
+	})
+
+	mont.Run("empty oplog", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "local.oplog.rs", mtest.FirstBatch))
+		client := mongodbClient{
+			Client: mt.Client,
+			logger: zap.NewNop(),
+		}
+
+		_, err := client.OplogWindow(context.Background())
+		require.ErrorContains(t, err, "unable to get first oplog entry")
+	})
+}
+
+func TestChunkCounts(t *testing.T) {
+	mont := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mont.Run("chunk counts", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "config.chunks", mtest.FirstBatch,
+			bson.D{primitive.E{Key: "_id", Value: "shard01"}, primitive.E{Key: "count", Value: int32(12)}},
+			bson.D{primitive.E{Key: "_id", Value: "shard02"}, primitive.E{Key: "count", Value: int32(9)}},
+		))
+		client := mongodbClient{
+			Client: mt.Client,
+			logger: zap.NewNop(),
+		}
+
+		chunkCounts, err := client.ChunkCounts(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []bson.M{
+			{"_id": "shard01", "count": int32(12)},
+			{"_id": "shard02", "count": int32(9)},
+		}, chunkCounts)
+	})
+}
+
 func loadDBStats() (bson.D, error) {
 	return loadTestFile("./testdata/dbstats.json")
 }
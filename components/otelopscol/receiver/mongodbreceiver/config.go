@@ -18,11 +18,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"slices"
 	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/connstring"
 	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/scraper/scraperhelper"
 	"go.uber.org/multierr"
@@ -30,34 +37,119 @@ import (
 	"github.com/GoogleCloudPlatform/opentelemetry-operations-collector/components/otelopscol/receiver/mongodbreceiver/internal/metadata"
 )
 
+const defaultMaxConcurrency = 4
+
+// The authentication mechanisms that can be set in auth_mechanism
+// https://www.mongodb.com/docs/manual/core/authentication/#authentication-mechanisms
+const (
+	authMechanismSCRAMSHA1   = "SCRAM-SHA-1"
+	authMechanismSCRAMSHA256 = "SCRAM-SHA-256"
+	authMechanismX509        = "MONGODB-X509"
+	authMechanismAWS         = "MONGODB-AWS"
+	authMechanismPlain       = "PLAIN"
+)
+
+var authMechanisms = []string{authMechanismSCRAMSHA1, authMechanismSCRAMSHA256, authMechanismX509, authMechanismAWS, authMechanismPlain}
+
 type Config struct {
 	scraperhelper.ControllerConfig `mapstructure:",squash"`
 	configtls.ClientConfig         `mapstructure:"tls,omitempty"`
 	// Metrics defines which metrics to enable for the scraper
-	Metrics    metadata.MetricsConfig `mapstructure:"metrics"`
-	Hosts      []confignet.AddrConfig `mapstructure:"hosts"`
-	Username   string                 `mapstructure:"username"`
-	Password   string                 `mapstructure:"password"`
-	ReplicaSet string                 `mapstructure:"replica_set,omitempty"`
-	Timeout    time.Duration          `mapstructure:"timeout"`
+	Metrics metadata.MetricsConfig `mapstructure:"metrics"`
+	// URI is a connection string, e.g. mongodb+srv://cluster0.example.mongodb.net/?authSource=admin
+	// If set, Hosts is ignored. The other settings take precedence over its options.
+	// It is opaque since it may contain credentials.
+	URI      configopaque.String    `mapstructure:"uri"`
+	Hosts    []confignet.AddrConfig `mapstructure:"hosts"`
+	Username string                 `mapstructure:"username"`
+	Password configopaque.String    `mapstructure:"password"`
+	// PasswordFile is a file containing the password, read whenever connecting
+	PasswordFile string `mapstructure:"password_file"`
+	// AuthMechanism is the authentication mechanism, e.g. SCRAM-SHA-256; by default it is negotiated with the server
+	AuthMechanism string `mapstructure:"auth_mechanism"`
+	// AuthSource is the database the user is authenticated against
+	AuthSource string `mapstructure:"auth_source"`
+	// DirectConnection connects to the hosts only, rather than discovering the topology
+	DirectConnection bool `mapstructure:"direct_connection"`
+	// ReadPreference is the read preference mode, e.g. secondaryPreferred
+	ReadPreference string        `mapstructure:"read_preference"`
+	ReplicaSet     string        `mapstructure:"replica_set,omitempty"`
+	Timeout        time.Duration `mapstructure:"timeout"`
+	// ScrapeTimeout bounds each scrape; by default it is the collection interval
+	ScrapeTimeout time.Duration `mapstructure:"scrape_timeout"`
+	// Databases filters the databases whose metrics are collected
+	Databases FilterConfig `mapstructure:"databases"`
+	// Collections filters the collections whose index metrics are collected
+	Collections FilterConfig `mapstructure:"collections"`
+	// MaxConcurrency is the maximum number of database and collection commands run at the same time
+	MaxConcurrency int `mapstructure:"max_concurrency"`
 }
 
-func (c *Config) Validate() error {
-	if len(c.Hosts) == 0 {
-		return errors.New("no hosts were specified in the config")
+// FilterConfig includes and excludes names by glob patterns, as supported by path.Match.
+// If Include is empty, all names are included. Exclude takes precedence over Include.
+type FilterConfig struct {
+	Include []string `mapstructure:"include"`
+	Exclude []string `mapstructure:"exclude"`
+}
+
+func (f FilterConfig) validate(name string) error {
+	var err error
+	for _, pattern := range append(append([]string{}, f.Include...), f.Exclude...) {
+		if _, matchErr := path.Match(pattern, ""); matchErr != nil {
+			err = multierr.Append(err, fmt.Errorf("invalid %s pattern %q: %w", name, pattern, matchErr))
+		}
 	}
+	return err
+}
+
+// matches reports whether any of names is included and none is excluded.
+func (f FilterConfig) matches(names ...string) bool {
+	return (len(f.Include) == 0 || matchesAny(f.Include, names)) && !matchesAny(f.Exclude, names)
+}
+
+func matchesAny(patterns []string, names []string) bool {
+	for _, pattern := range patterns {
+		for _, name := range names {
+			if ok, _ := path.Match(pattern, name); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
 
+func (c *Config) Validate() error {
 	var err error
-	for _, host := range c.Hosts {
-		if host.Endpoint == "" {
-			err = multierr.Append(err, errors.New("no endpoint specified for one of the hosts"))
+	if c.URI != "" {
+		err = multierr.Append(err, validateURI(string(c.URI)))
+	} else {
+		if len(c.Hosts) == 0 {
+			return errors.New("no hosts were specified in the config")
+		}
+		for _, host := range c.Hosts {
+			if host.Endpoint == "" {
+				err = multierr.Append(err, errors.New("no endpoint specified for one of the hosts"))
+			}
+		}
+	}
+
+	err = multierr.Append(err, c.validateAuth())
+
+	if c.ReadPreference != "" {
+		if _, modeErr := readpref.ModeFromString(c.ReadPreference); modeErr != nil {
+			err = multierr.Append(err, fmt.Errorf("invalid read_preference %q", c.ReadPreference))
 		}
 	}
 
-	if c.Username != "" && c.Password == "" {
-		err = multierr.Append(err, errors.New("username provided without password"))
-	} else if c.Username == "" && c.Password != "" {
-		err = multierr.Append(err, errors.New("password provided without user"))
+	err = multierr.Append(err, c.Databases.validate("databases"))
+	err = multierr.Append(err, c.Collections.validate("collections"))
+
+	if c.MaxConcurrency < 0 {
+		err = multierr.Append(err, errors.New("max_concurrency must not be negative"))
+	}
+
+	if c.ScrapeTimeout < 0 {
+		err = multierr.Append(err, errors.New("scrape_timeout must not be negative"))
 	}
 
 	if _, tlsErr := c.LoadTLSConfig(context.Background()); tlsErr != nil {
@@ -67,10 +159,112 @@ func (c *Config) Validate() error {
 	return err
 }
 
-func (c *Config) ClientOptions() *options.ClientOptions {
+// scrapeTimeout returns the timeout of a scrape.
+func (c *Config) scrapeTimeout() time.Duration {
+	if c.ScrapeTimeout > 0 {
+		return c.ScrapeTimeout
+	}
+	return c.CollectionInterval
+}
+
+// validateURI checks the scheme of a connection string, and its options
+// unless it is an SRV one, which can only be parsed by looking it up.
+func validateURI(uri string) error {
+	switch {
+	case strings.HasPrefix(uri, connstring.SchemeMongoDBSRV+"://"):
+		return nil
+	case strings.HasPrefix(uri, connstring.SchemeMongoDB+"://"):
+		if _, err := connstring.ParseAndValidate(uri); err != nil {
+			return fmt.Errorf("invalid uri: %w", err)
+		}
+		return nil
+	}
+	return errors.New("uri must start with mongodb:// or mongodb+srv://")
+}
+
+// uriUsername returns the user name in the connection string uri, if any.
+func uriUsername(uri string) string {
+	_, rest, ok := strings.Cut(uri, "://")
+	if !ok {
+		return ""
+	}
+	// The user info comes before the hosts, which end at the first "/" or
+	// "?"; characters such as "@" and "/" are escaped in the user info.
+	if i := strings.IndexAny(rest, "/?"); i >= 0 {
+		rest = rest[:i]
+	}
+	userInfo, _, ok := strings.Cut(rest, "@")
+	if !ok {
+		return ""
+	}
+	username, _, _ := strings.Cut(userInfo, ":")
+	if unescaped, err := url.PathUnescape(username); err == nil {
+		return unescaped
+	}
+	return username
+}
+
+func (c *Config) validateAuth() error {
+	var err error
+	if c.AuthMechanism != "" && !slices.Contains(authMechanisms, c.AuthMechanism) {
+		err = multierr.Append(err, fmt.Errorf("unsupported auth_mechanism %q, must be one of %s", c.AuthMechanism, strings.Join(authMechanisms, ", ")))
+	}
+
+	hasPassword := c.Password != "" || c.PasswordFile != ""
+	// The user may also be in the uri, with the password in password_file.
+	hasUser := c.Username != "" || uriUsername(string(c.URI)) != ""
+	if c.Password != "" && c.PasswordFile != "" {
+		err = multierr.Append(err, errors.New("password and password_file are mutually exclusive"))
+	}
+
+	switch c.AuthMechanism {
+	case authMechanismX509:
+		// The user is authenticated by the client certificate, and the user
+		// name defaults to its subject.
+		if hasPassword {
+			err = multierr.Append(err, fmt.Errorf("password provided with auth_mechanism %s", authMechanismX509))
+		}
+		if c.CertFile == "" && c.CertPem == "" {
+			err = multierr.Append(err, fmt.Errorf("auth_mechanism %s requires a tls client certificate", authMechanismX509))
+		}
+	case authMechanismAWS:
+		// The credentials may also come from the environment, so the
+		// username and password are optional, but go together.
+		if c.Username != "" && !hasPassword {
+			err = multierr.Append(err, errors.New("username provided without password"))
+		} else if !hasUser && hasPassword {
+			err = multierr.Append(err, errors.New("password provided without user"))
+		}
+	default:
+		if c.Username != "" && !hasPassword {
+			err = multierr.Append(err, errors.New("username provided without password"))
+		} else if !hasUser && hasPassword {
+			err = multierr.Append(err, errors.New("password provided without user"))
+		}
+	}
+	return err
+}
+
+// password returns the password, from password_file if set.
+func (c *Config) password() (string, error) {
+	if c.PasswordFile == "" {
+		return string(c.Password), nil
+	}
+	b, err := os.ReadFile(c.PasswordFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read password_file: %w", err)
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
+func (c *Config) ClientOptions() (*options.ClientOptions, error) {
 	clientOptions := options.Client()
-	connString := fmt.Sprintf("mongodb://%s", strings.Join(c.hostlist(), ","))
-	clientOptions.ApplyURI(connString)
+	if c.URI != "" {
+		clientOptions.ApplyURI(string(c.URI))
+	} else {
+		connString := fmt.Sprintf("mongodb://%s", strings.Join(c.hostlist(), ","))
+		clientOptions.ApplyURI(connString)
+	}
 
 	if c.Timeout > 0 {
 		clientOptions.SetConnectTimeout(c.Timeout)
@@ -85,14 +279,55 @@ func (c *Config) ClientOptions() *options.ClientOptions {
 		clientOptions.SetReplicaSet(c.ReplicaSet)
 	}
 
-	if c.Username != "" && c.Password != "" {
-		clientOptions.SetAuth(options.Credential{
-			Username: c.Username,
-			Password: c.Password,
-		})
+	if c.DirectConnection {
+		clientOptions.SetDirect(true)
+	}
+
+	if c.ReadPreference != "" {
+		mode, err := readpref.ModeFromString(c.ReadPreference)
+		if err != nil {
+			return nil, fmt.Errorf("invalid read_preference %q", c.ReadPreference)
+		}
+		rp, err := readpref.New(mode)
+		if err != nil {
+			return nil, err
+		}
+		clientOptions.SetReadPreference(rp)
+	}
+
+	if c.Username != "" || c.Password != "" || c.PasswordFile != "" || c.AuthMechanism != "" || c.AuthSource != "" {
+		password, err := c.password()
+		if err != nil {
+			return nil, err
+		}
+		credential := options.Credential{
+			AuthMechanism: c.AuthMechanism,
+			AuthSource:    c.AuthSource,
+			Username:      c.Username,
+			Password:      password,
+			PasswordSet:   password != "",
+		}
+		// Keep the credentials of the uri that aren't overridden.
+		if clientOptions.Auth != nil {
+			if credential.AuthMechanism == "" {
+				credential.AuthMechanism = clientOptions.Auth.AuthMechanism
+				credential.AuthMechanismProperties = clientOptions.Auth.AuthMechanismProperties
+			}
+			if credential.AuthSource == "" {
+				credential.AuthSource = clientOptions.Auth.AuthSource
+			}
+			if credential.Username == "" {
+				credential.Username = clientOptions.Auth.Username
+				if !credential.PasswordSet {
+					credential.Password = clientOptions.Auth.Password
+					credential.PasswordSet = clientOptions.Auth.PasswordSet
+				}
+			}
+		}
+		clientOptions.SetAuth(credential)
 	}
 
-	return clientOptions
+	return clientOptions, nil
 }
 
 func (c *Config) hostlist() []string {
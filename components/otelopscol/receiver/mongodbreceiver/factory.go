@@ -49,8 +49,9 @@ func createDefaultConfig() component.Config {
 				Transport: confignet.TransportTypeTCP,
 			},
 		},
-		Metrics:      metadata.DefaultMetricsConfig(),
-		ClientConfig: configtls.ClientConfig{},
+		Metrics:        metadata.DefaultMetricsConfig(),
+		ClientConfig:   configtls.ClientConfig{},
+		MaxConcurrency: defaultMaxConcurrency,
 	}
 }
 
@@ -977,6 +977,213 @@ func (ms *MongodbOperationTimeMetricConfig) Validate() error {
 	return nil
 }
 
+// MongodbOplogMaxSizeMetricConfig provides config for the mongodb.oplog.max_size metric.
+type MongodbOplogMaxSizeMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+}
+
+func (ms *MongodbOplogMaxSizeMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+// MongodbOplogSizeMetricConfig provides config for the mongodb.oplog.size metric.
+type MongodbOplogSizeMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+}
+
+func (ms *MongodbOplogSizeMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+// MongodbOplogWindowMetricConfig provides config for the mongodb.oplog.window metric.
+type MongodbOplogWindowMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+}
+
+func (ms *MongodbOplogWindowMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+// MongodbReplsetMemberHealthMetricAttributeKey specifies the key of an attribute for the mongodb.replset.member.health metric.
+type MongodbReplsetMemberHealthMetricAttributeKey string
+
+const (
+	MongodbReplsetMemberHealthMetricAttributeKeyReplicaSet MongodbReplsetMemberHealthMetricAttributeKey = "replica_set"
+	MongodbReplsetMemberHealthMetricAttributeKeyMember     MongodbReplsetMemberHealthMetricAttributeKey = "member"
+)
+
+// MongodbReplsetMemberHealthMetricConfig provides config for the mongodb.replset.member.health metric.
+type MongodbReplsetMemberHealthMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                         `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []MongodbReplsetMemberHealthMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *MongodbReplsetMemberHealthMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *MongodbReplsetMemberHealthMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case MongodbReplsetMemberHealthMetricAttributeKeyReplicaSet, MongodbReplsetMemberHealthMetricAttributeKeyMember:
+		default:
+			return fmt.Errorf("metric mongodb.replset.member.health doesn't have an attribute %v, valid attributes: [replica_set, member]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// MongodbReplsetMemberReplicationLagMetricAttributeKey specifies the key of an attribute for the mongodb.replset.member.replication_lag metric.
+type MongodbReplsetMemberReplicationLagMetricAttributeKey string
+
+const (
+	MongodbReplsetMemberReplicationLagMetricAttributeKeyReplicaSet MongodbReplsetMemberReplicationLagMetricAttributeKey = "replica_set"
+	MongodbReplsetMemberReplicationLagMetricAttributeKeyMember     MongodbReplsetMemberReplicationLagMetricAttributeKey = "member"
+)
+
+// MongodbReplsetMemberReplicationLagMetricConfig provides config for the mongodb.replset.member.replication_lag metric.
+type MongodbReplsetMemberReplicationLagMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                                 `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []MongodbReplsetMemberReplicationLagMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *MongodbReplsetMemberReplicationLagMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *MongodbReplsetMemberReplicationLagMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case MongodbReplsetMemberReplicationLagMetricAttributeKeyReplicaSet, MongodbReplsetMemberReplicationLagMetricAttributeKeyMember:
+		default:
+			return fmt.Errorf("metric mongodb.replset.member.replication_lag doesn't have an attribute %v, valid attributes: [replica_set, member]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// MongodbReplsetMemberStateMetricAttributeKey specifies the key of an attribute for the mongodb.replset.member.state metric.
+type MongodbReplsetMemberStateMetricAttributeKey string
+
+const (
+	MongodbReplsetMemberStateMetricAttributeKeyReplicaSet MongodbReplsetMemberStateMetricAttributeKey = "replica_set"
+	MongodbReplsetMemberStateMetricAttributeKeyMember     MongodbReplsetMemberStateMetricAttributeKey = "member"
+)
+
+// MongodbReplsetMemberStateMetricConfig provides config for the mongodb.replset.member.state metric.
+type MongodbReplsetMemberStateMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                        `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []MongodbReplsetMemberStateMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *MongodbReplsetMemberStateMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *MongodbReplsetMemberStateMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case MongodbReplsetMemberStateMetricAttributeKeyReplicaSet, MongodbReplsetMemberStateMetricAttributeKeyMember:
+		default:
+			return fmt.Errorf("metric mongodb.replset.member.state doesn't have an attribute %v, valid attributes: [replica_set, member]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
 // MongodbSessionCountMetricConfig provides config for the mongodb.session.count metric.
 type MongodbSessionCountMetricConfig struct {
 	Enabled          bool `mapstructure:"enabled"`
@@ -997,6 +1204,94 @@ func (ms *MongodbSessionCountMetricConfig) Unmarshal(parser *confmap.Conf) error
 	return nil
 }
 
+// MongodbShardingBalancerEnabledMetricConfig provides config for the mongodb.sharding.balancer.enabled metric.
+type MongodbShardingBalancerEnabledMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+}
+
+func (ms *MongodbShardingBalancerEnabledMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+// MongodbShardingBalancerRoundCountMetricConfig provides config for the mongodb.sharding.balancer.round.count metric.
+type MongodbShardingBalancerRoundCountMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+}
+
+func (ms *MongodbShardingBalancerRoundCountMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+// MongodbShardingChunkCountMetricAttributeKey specifies the key of an attribute for the mongodb.sharding.chunk.count metric.
+type MongodbShardingChunkCountMetricAttributeKey string
+
+const (
+	MongodbShardingChunkCountMetricAttributeKeyShard MongodbShardingChunkCountMetricAttributeKey = "shard"
+)
+
+// MongodbShardingChunkCountMetricConfig provides config for the mongodb.sharding.chunk.count metric.
+type MongodbShardingChunkCountMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                        `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []MongodbShardingChunkCountMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *MongodbShardingChunkCountMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *MongodbShardingChunkCountMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case MongodbShardingChunkCountMetricAttributeKeyShard:
+		default:
+			return fmt.Errorf("metric mongodb.sharding.chunk.count doesn't have an attribute %v, valid attributes: [shard]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
 // MongodbStorageSizeMetricAttributeKey specifies the key of an attribute for the mongodb.storage.size metric.
 type MongodbStorageSizeMetricAttributeKey string
 
@@ -1047,32 +1342,41 @@ func (ms *MongodbStorageSizeMetricConfig) Validate() error {
 
 // MetricsConfig provides config for mongodb metrics.
 type MetricsConfig struct {
-	MongodbCacheOperations        MongodbCacheOperationsMetricConfig        `mapstructure:"mongodb.cache.operations"`
-	MongodbCollectionCount        MongodbCollectionCountMetricConfig        `mapstructure:"mongodb.collection.count"`
-	MongodbConnectionCount        MongodbConnectionCountMetricConfig        `mapstructure:"mongodb.connection.count"`
-	MongodbCursorCount            MongodbCursorCountMetricConfig            `mapstructure:"mongodb.cursor.count"`
-	MongodbCursorTimeoutCount     MongodbCursorTimeoutCountMetricConfig     `mapstructure:"mongodb.cursor.timeout.count"`
-	MongodbDataSize               MongodbDataSizeMetricConfig               `mapstructure:"mongodb.data.size"`
-	MongodbDatabaseCount          MongodbDatabaseCountMetricConfig          `mapstructure:"mongodb.database.count"`
-	MongodbDocumentOperationCount MongodbDocumentOperationCountMetricConfig `mapstructure:"mongodb.document.operation.count"`
-	MongodbExtentCount            MongodbExtentCountMetricConfig            `mapstructure:"mongodb.extent.count"`
-	MongodbGlobalLockTime         MongodbGlobalLockTimeMetricConfig         `mapstructure:"mongodb.global_lock.time"`
-	MongodbIndexAccessCount       MongodbIndexAccessCountMetricConfig       `mapstructure:"mongodb.index.access.count"`
-	MongodbIndexCount             MongodbIndexCountMetricConfig             `mapstructure:"mongodb.index.count"`
-	MongodbIndexSize              MongodbIndexSizeMetricConfig              `mapstructure:"mongodb.index.size"`
-	MongodbLockAcquireCount       MongodbLockAcquireCountMetricConfig       `mapstructure:"mongodb.lock.acquire.count"`
-	MongodbLockAcquireTime        MongodbLockAcquireTimeMetricConfig        `mapstructure:"mongodb.lock.acquire.time"`
-	MongodbLockAcquireWaitCount   MongodbLockAcquireWaitCountMetricConfig   `mapstructure:"mongodb.lock.acquire.wait_count"`
-	MongodbLockDeadlockCount      MongodbLockDeadlockCountMetricConfig      `mapstructure:"mongodb.lock.deadlock.count"`
-	MongodbMemoryUsage            MongodbMemoryUsageMetricConfig            `mapstructure:"mongodb.memory.usage"`
-	MongodbNetworkIoReceive       MongodbNetworkIoReceiveMetricConfig       `mapstructure:"mongodb.network.io.receive"`
-	MongodbNetworkIoTransmit      MongodbNetworkIoTransmitMetricConfig      `mapstructure:"mongodb.network.io.transmit"`
-	MongodbNetworkRequestCount    MongodbNetworkRequestCountMetricConfig    `mapstructure:"mongodb.network.request.count"`
-	MongodbObjectCount            MongodbObjectCountMetricConfig            `mapstructure:"mongodb.object.count"`
-	MongodbOperationCount         MongodbOperationCountMetricConfig         `mapstructure:"mongodb.operation.count"`
-	MongodbOperationTime          MongodbOperationTimeMetricConfig          `mapstructure:"mongodb.operation.time"`
-	MongodbSessionCount           MongodbSessionCountMetricConfig           `mapstructure:"mongodb.session.count"`
-	MongodbStorageSize            MongodbStorageSizeMetricConfig            `mapstructure:"mongodb.storage.size"`
+	MongodbCacheOperations             MongodbCacheOperationsMetricConfig             `mapstructure:"mongodb.cache.operations"`
+	MongodbCollectionCount             MongodbCollectionCountMetricConfig             `mapstructure:"mongodb.collection.count"`
+	MongodbConnectionCount             MongodbConnectionCountMetricConfig             `mapstructure:"mongodb.connection.count"`
+	MongodbCursorCount                 MongodbCursorCountMetricConfig                 `mapstructure:"mongodb.cursor.count"`
+	MongodbCursorTimeoutCount          MongodbCursorTimeoutCountMetricConfig          `mapstructure:"mongodb.cursor.timeout.count"`
+	MongodbDataSize                    MongodbDataSizeMetricConfig                    `mapstructure:"mongodb.data.size"`
+	MongodbDatabaseCount               MongodbDatabaseCountMetricConfig               `mapstructure:"mongodb.database.count"`
+	MongodbDocumentOperationCount      MongodbDocumentOperationCountMetricConfig      `mapstructure:"mongodb.document.operation.count"`
+	MongodbExtentCount                 MongodbExtentCountMetricConfig                 `mapstructure:"mongodb.extent.count"`
+	MongodbGlobalLockTime              MongodbGlobalLockTimeMetricConfig              `mapstructure:"mongodb.global_lock.time"`
+	MongodbIndexAccessCount            MongodbIndexAccessCountMetricConfig            `mapstructure:"mongodb.index.access.count"`
+	MongodbIndexCount                  MongodbIndexCountMetricConfig                  `mapstructure:"mongodb.index.count"`
+	MongodbIndexSize                   MongodbIndexSizeMetricConfig                   `mapstructure:"mongodb.index.size"`
+	MongodbLockAcquireCount            MongodbLockAcquireCountMetricConfig            `mapstructure:"mongodb.lock.acquire.count"`
+	MongodbLockAcquireTime             MongodbLockAcquireTimeMetricConfig             `mapstructure:"mongodb.lock.acquire.time"`
+	MongodbLockAcquireWaitCount        MongodbLockAcquireWaitCountMetricConfig        `mapstructure:"mongodb.lock.acquire.wait_count"`
+	MongodbLockDeadlockCount           MongodbLockDeadlockCountMetricConfig           `mapstructure:"mongodb.lock.deadlock.count"`
+	MongodbMemoryUsage                 MongodbMemoryUsageMetricConfig                 `mapstructure:"mongodb.memory.usage"`
+	MongodbNetworkIoReceive            MongodbNetworkIoReceiveMetricConfig            `mapstructure:"mongodb.network.io.receive"`
+	MongodbNetworkIoTransmit           MongodbNetworkIoTransmitMetricConfig           `mapstructure:"mongodb.network.io.transmit"`
+	MongodbNetworkRequestCount         MongodbNetworkRequestCountMetricConfig         `mapstructure:"mongodb.network.request.count"`
+	MongodbObjectCount                 MongodbObjectCountMetricConfig                 `mapstructure:"mongodb.object.count"`
+	MongodbOperationCount              MongodbOperationCountMetricConfig              `mapstructure:"mongodb.operation.count"`
+	MongodbOperationTime               MongodbOperationTimeMetricConfig               `mapstructure:"mongodb.operation.time"`
+	MongodbOplogMaxSize                MongodbOplogMaxSizeMetricConfig                `mapstructure:"mongodb.oplog.max_size"`
+	MongodbOplogSize                   MongodbOplogSizeMetricConfig                   `mapstructure:"mongodb.oplog.size"`
+	MongodbOplogWindow                 MongodbOplogWindowMetricConfig                 `mapstructure:"mongodb.oplog.window"`
+	MongodbReplsetMemberHealth         MongodbReplsetMemberHealthMetricConfig         `mapstructure:"mongodb.replset.member.health"`
+	MongodbReplsetMemberReplicationLag MongodbReplsetMemberReplicationLagMetricConfig `mapstructure:"mongodb.replset.member.replication_lag"`
+	MongodbReplsetMemberState          MongodbReplsetMemberStateMetricConfig          `mapstructure:"mongodb.replset.member.state"`
+	MongodbSessionCount                MongodbSessionCountMetricConfig                `mapstructure:"mongodb.session.count"`
+	MongodbShardingBalancerEnabled     MongodbShardingBalancerEnabledMetricConfig     `mapstructure:"mongodb.sharding.balancer.enabled"`
+	MongodbShardingBalancerRoundCount  MongodbShardingBalancerRoundCountMetricConfig  `mapstructure:"mongodb.sharding.balancer.round.count"`
+	MongodbShardingChunkCount          MongodbShardingChunkCountMetricConfig          `mapstructure:"mongodb.sharding.chunk.count"`
+	MongodbStorageSize                 MongodbStorageSizeMetricConfig                 `mapstructure:"mongodb.storage.size"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -1183,9 +1487,44 @@ func DefaultMetricsConfig() MetricsConfig {
 			AggregationStrategy: AggregationStrategySum,
 			EnabledAttributes:   []MongodbOperationTimeMetricAttributeKey{MongodbOperationTimeMetricAttributeKeyOperation},
 		},
+		MongodbOplogMaxSize: MongodbOplogMaxSizeMetricConfig{
+			Enabled: true,
+		},
+		MongodbOplogSize: MongodbOplogSizeMetricConfig{
+			Enabled: true,
+		},
+		MongodbOplogWindow: MongodbOplogWindowMetricConfig{
+			Enabled: true,
+		},
+		MongodbReplsetMemberHealth: MongodbReplsetMemberHealthMetricConfig{
+			Enabled:             true,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []MongodbReplsetMemberHealthMetricAttributeKey{MongodbReplsetMemberHealthMetricAttributeKeyReplicaSet, MongodbReplsetMemberHealthMetricAttributeKeyMember},
+		},
+		MongodbReplsetMemberReplicationLag: MongodbReplsetMemberReplicationLagMetricConfig{
+			Enabled:             true,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []MongodbReplsetMemberReplicationLagMetricAttributeKey{MongodbReplsetMemberReplicationLagMetricAttributeKeyReplicaSet, MongodbReplsetMemberReplicationLagMetricAttributeKeyMember},
+		},
+		MongodbReplsetMemberState: MongodbReplsetMemberStateMetricConfig{
+			Enabled:             true,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []MongodbReplsetMemberStateMetricAttributeKey{MongodbReplsetMemberStateMetricAttributeKeyReplicaSet, MongodbReplsetMemberStateMetricAttributeKeyMember},
+		},
 		MongodbSessionCount: MongodbSessionCountMetricConfig{
 			Enabled: true,
 		},
+		MongodbShardingBalancerEnabled: MongodbShardingBalancerEnabledMetricConfig{
+			Enabled: true,
+		},
+		MongodbShardingBalancerRoundCount: MongodbShardingBalancerRoundCountMetricConfig{
+			Enabled: true,
+		},
+		MongodbShardingChunkCount: MongodbShardingChunkCountMetricConfig{
+			Enabled:             true,
+			AggregationStrategy: AggregationStrategySum,
+			EnabledAttributes:   []MongodbShardingChunkCountMetricAttributeKey{MongodbShardingChunkCountMetricAttributeKeyShard},
+		},
 		MongodbStorageSize: MongodbStorageSizeMetricConfig{
 			Enabled:             true,
 			AggregationStrategy: AggregationStrategySum,
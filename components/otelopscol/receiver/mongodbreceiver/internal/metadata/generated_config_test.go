@@ -132,9 +132,44 @@ func TestMetricsBuilderConfig(t *testing.T) {
 						AggregationStrategy: AggregationStrategySum,
 						EnabledAttributes:   []MongodbOperationTimeMetricAttributeKey{MongodbOperationTimeMetricAttributeKeyOperation},
 					},
+					MongodbOplogMaxSize: MongodbOplogMaxSizeMetricConfig{
+						Enabled: true,
+					},
+					MongodbOplogSize: MongodbOplogSizeMetricConfig{
+						Enabled: true,
+					},
+					MongodbOplogWindow: MongodbOplogWindowMetricConfig{
+						Enabled: true,
+					},
+					MongodbReplsetMemberHealth: MongodbReplsetMemberHealthMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []MongodbReplsetMemberHealthMetricAttributeKey{MongodbReplsetMemberHealthMetricAttributeKeyReplicaSet, MongodbReplsetMemberHealthMetricAttributeKeyMember},
+					},
+					MongodbReplsetMemberReplicationLag: MongodbReplsetMemberReplicationLagMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []MongodbReplsetMemberReplicationLagMetricAttributeKey{MongodbReplsetMemberReplicationLagMetricAttributeKeyReplicaSet, MongodbReplsetMemberReplicationLagMetricAttributeKeyMember},
+					},
+					MongodbReplsetMemberState: MongodbReplsetMemberStateMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []MongodbReplsetMemberStateMetricAttributeKey{MongodbReplsetMemberStateMetricAttributeKeyReplicaSet, MongodbReplsetMemberStateMetricAttributeKeyMember},
+					},
 					MongodbSessionCount: MongodbSessionCountMetricConfig{
 						Enabled: true,
 					},
+					MongodbShardingBalancerEnabled: MongodbShardingBalancerEnabledMetricConfig{
+						Enabled: true,
+					},
+					MongodbShardingBalancerRoundCount: MongodbShardingBalancerRoundCountMetricConfig{
+						Enabled: true,
+					},
+					MongodbShardingChunkCount: MongodbShardingChunkCountMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategySum,
+						EnabledAttributes:   []MongodbShardingChunkCountMetricAttributeKey{MongodbShardingChunkCountMetricAttributeKeyShard},
+					},
 					MongodbStorageSize: MongodbStorageSizeMetricConfig{
 						Enabled:             true,
 						AggregationStrategy: AggregationStrategySum,
@@ -256,9 +291,44 @@ func TestMetricsBuilderConfig(t *testing.T) {
 						AggregationStrategy: AggregationStrategySum,
 						EnabledAttributes:   []MongodbOperationTimeMetricAttributeKey{MongodbOperationTimeMetricAttributeKeyOperation},
 					},
+					MongodbOplogMaxSize: MongodbOplogMaxSizeMetricConfig{
+						Enabled: false,
+					},
+					MongodbOplogSize: MongodbOplogSizeMetricConfig{
+						Enabled: false,
+					},
+					MongodbOplogWindow: MongodbOplogWindowMetricConfig{
+						Enabled: false,
+					},
+					MongodbReplsetMemberHealth: MongodbReplsetMemberHealthMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []MongodbReplsetMemberHealthMetricAttributeKey{MongodbReplsetMemberHealthMetricAttributeKeyReplicaSet, MongodbReplsetMemberHealthMetricAttributeKeyMember},
+					},
+					MongodbReplsetMemberReplicationLag: MongodbReplsetMemberReplicationLagMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []MongodbReplsetMemberReplicationLagMetricAttributeKey{MongodbReplsetMemberReplicationLagMetricAttributeKeyReplicaSet, MongodbReplsetMemberReplicationLagMetricAttributeKeyMember},
+					},
+					MongodbReplsetMemberState: MongodbReplsetMemberStateMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []MongodbReplsetMemberStateMetricAttributeKey{MongodbReplsetMemberStateMetricAttributeKeyReplicaSet, MongodbReplsetMemberStateMetricAttributeKeyMember},
+					},
 					MongodbSessionCount: MongodbSessionCountMetricConfig{
 						Enabled: false,
 					},
+					MongodbShardingBalancerEnabled: MongodbShardingBalancerEnabledMetricConfig{
+						Enabled: false,
+					},
+					MongodbShardingBalancerRoundCount: MongodbShardingBalancerRoundCountMetricConfig{
+						Enabled: false,
+					},
+					MongodbShardingChunkCount: MongodbShardingChunkCountMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategySum,
+						EnabledAttributes:   []MongodbShardingChunkCountMetricAttributeKey{MongodbShardingChunkCountMetricAttributeKeyShard},
+					},
 					MongodbStorageSize: MongodbStorageSizeMetricConfig{
 						Enabled:             false,
 						AggregationStrategy: AggregationStrategySum,
@@ -274,7 +344,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			cfg := loadMetricsBuilderConfig(t, tt.name)
-			diff := cmp.Diff(tt.want, cfg, cmpopts.IgnoreUnexported(MongodbCacheOperationsMetricConfig{}, MongodbCollectionCountMetricConfig{}, MongodbConnectionCountMetricConfig{}, MongodbCursorCountMetricConfig{}, MongodbCursorTimeoutCountMetricConfig{}, MongodbDataSizeMetricConfig{}, MongodbDatabaseCountMetricConfig{}, MongodbDocumentOperationCountMetricConfig{}, MongodbExtentCountMetricConfig{}, MongodbGlobalLockTimeMetricConfig{}, MongodbIndexAccessCountMetricConfig{}, MongodbIndexCountMetricConfig{}, MongodbIndexSizeMetricConfig{}, MongodbLockAcquireCountMetricConfig{}, MongodbLockAcquireTimeMetricConfig{}, MongodbLockAcquireWaitCountMetricConfig{}, MongodbLockDeadlockCountMetricConfig{}, MongodbMemoryUsageMetricConfig{}, MongodbNetworkIoReceiveMetricConfig{}, MongodbNetworkIoTransmitMetricConfig{}, MongodbNetworkRequestCountMetricConfig{}, MongodbObjectCountMetricConfig{}, MongodbOperationCountMetricConfig{}, MongodbOperationTimeMetricConfig{}, MongodbSessionCountMetricConfig{}, MongodbStorageSizeMetricConfig{}, ResourceAttributeConfig{}))
+			diff := cmp.Diff(tt.want, cfg, cmpopts.IgnoreUnexported(MongodbCacheOperationsMetricConfig{}, MongodbCollectionCountMetricConfig{}, MongodbConnectionCountMetricConfig{}, MongodbCursorCountMetricConfig{}, MongodbCursorTimeoutCountMetricConfig{}, MongodbDataSizeMetricConfig{}, MongodbDatabaseCountMetricConfig{}, MongodbDocumentOperationCountMetricConfig{}, MongodbExtentCountMetricConfig{}, MongodbGlobalLockTimeMetricConfig{}, MongodbIndexAccessCountMetricConfig{}, MongodbIndexCountMetricConfig{}, MongodbIndexSizeMetricConfig{}, MongodbLockAcquireCountMetricConfig{}, MongodbLockAcquireTimeMetricConfig{}, MongodbLockAcquireWaitCountMetricConfig{}, MongodbLockDeadlockCountMetricConfig{}, MongodbMemoryUsageMetricConfig{}, MongodbNetworkIoReceiveMetricConfig{}, MongodbNetworkIoTransmitMetricConfig{}, MongodbNetworkRequestCountMetricConfig{}, MongodbObjectCountMetricConfig{}, MongodbOperationCountMetricConfig{}, MongodbOperationTimeMetricConfig{}, MongodbOplogMaxSizeMetricConfig{}, MongodbOplogSizeMetricConfig{}, MongodbOplogWindowMetricConfig{}, MongodbReplsetMemberHealthMetricConfig{}, MongodbReplsetMemberReplicationLagMetricConfig{}, MongodbReplsetMemberStateMetricConfig{}, MongodbSessionCountMetricConfig{}, MongodbShardingBalancerEnabledMetricConfig{}, MongodbShardingBalancerRoundCountMetricConfig{}, MongodbShardingChunkCountMetricConfig{}, MongodbStorageSizeMetricConfig{}, ResourceAttributeConfig{}))
 			require.Emptyf(t, diff, "Config mismatch (-expected +actual):\n%s", diff)
 		})
 	}
@@ -483,6 +553,54 @@ func TestMongodbOperationTimeMetricsConfig_Validate(t *testing.T) {
 	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
 }
 
+func TestMongodbReplsetMemberHealthMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().MongodbReplsetMemberHealth
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []MongodbReplsetMemberHealthMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric mongodb.replset.member.health doesn't have an attribute invalid, valid attributes: [replica_set, member]")
+
+	cfg = DefaultMetricsConfig().MongodbReplsetMemberHealth
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestMongodbReplsetMemberReplicationLagMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().MongodbReplsetMemberReplicationLag
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []MongodbReplsetMemberReplicationLagMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric mongodb.replset.member.replication_lag doesn't have an attribute invalid, valid attributes: [replica_set, member]")
+
+	cfg = DefaultMetricsConfig().MongodbReplsetMemberReplicationLag
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestMongodbReplsetMemberStateMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().MongodbReplsetMemberState
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []MongodbReplsetMemberStateMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric mongodb.replset.member.state doesn't have an attribute invalid, valid attributes: [replica_set, member]")
+
+	cfg = DefaultMetricsConfig().MongodbReplsetMemberState
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestMongodbShardingChunkCountMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().MongodbShardingChunkCount
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []MongodbShardingChunkCountMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric mongodb.sharding.chunk.count doesn't have an attribute invalid, valid attributes: [shard]")
+
+	cfg = DefaultMetricsConfig().MongodbShardingChunkCount
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
 func TestMongodbStorageSizeMetricsConfig_Validate(t *testing.T) {
 	cfg := DefaultMetricsConfig().MongodbStorageSize
 	require.NoError(t, cfg.Validate())
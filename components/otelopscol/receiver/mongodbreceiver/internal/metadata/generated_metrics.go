@@ -317,9 +317,40 @@ var MetricsInfo = metricsInfo{
 		Name:       "mongodb.operation.time",
 		Attributes: []string{"operation"},
 	},
+	MongodbOplogMaxSize: metricInfo{
+		Name: "mongodb.oplog.max_size",
+	},
+	MongodbOplogSize: metricInfo{
+		Name: "mongodb.oplog.size",
+	},
+	MongodbOplogWindow: metricInfo{
+		Name: "mongodb.oplog.window",
+	},
+	MongodbReplsetMemberHealth: metricInfo{
+		Name:       "mongodb.replset.member.health",
+		Attributes: []string{"replica_set", "member"},
+	},
+	MongodbReplsetMemberReplicationLag: metricInfo{
+		Name:       "mongodb.replset.member.replication_lag",
+		Attributes: []string{"replica_set", "member"},
+	},
+	MongodbReplsetMemberState: metricInfo{
+		Name:       "mongodb.replset.member.state",
+		Attributes: []string{"replica_set", "member"},
+	},
 	MongodbSessionCount: metricInfo{
 		Name: "mongodb.session.count",
 	},
+	MongodbShardingBalancerEnabled: metricInfo{
+		Name: "mongodb.sharding.balancer.enabled",
+	},
+	MongodbShardingBalancerRoundCount: metricInfo{
+		Name: "mongodb.sharding.balancer.round.count",
+	},
+	MongodbShardingChunkCount: metricInfo{
+		Name:       "mongodb.sharding.chunk.count",
+		Attributes: []string{"shard"},
+	},
 	MongodbStorageSize: metricInfo{
 		Name:       "mongodb.storage.size",
 		Attributes: []string{"database"},
@@ -327,32 +358,41 @@ var MetricsInfo = metricsInfo{
 }
 
 type metricsInfo struct {
-	MongodbCacheOperations        metricInfo
-	MongodbCollectionCount        metricInfo
-	MongodbConnectionCount        metricInfo
-	MongodbCursorCount            metricInfo
-	MongodbCursorTimeoutCount     metricInfo
-	MongodbDataSize               metricInfo
-	MongodbDatabaseCount          metricInfo
-	MongodbDocumentOperationCount metricInfo
-	MongodbExtentCount            metricInfo
-	MongodbGlobalLockTime         metricInfo
-	MongodbIndexAccessCount       metricInfo
-	MongodbIndexCount             metricInfo
-	MongodbIndexSize              metricInfo
-	MongodbLockAcquireCount       metricInfo
-	MongodbLockAcquireTime        metricInfo
-	MongodbLockAcquireWaitCount   metricInfo
-	MongodbLockDeadlockCount      metricInfo
-	MongodbMemoryUsage            metricInfo
-	MongodbNetworkIoReceive       metricInfo
-	MongodbNetworkIoTransmit      metricInfo
-	MongodbNetworkRequestCount    metricInfo
-	MongodbObjectCount            metricInfo
-	MongodbOperationCount         metricInfo
-	MongodbOperationTime          metricInfo
-	MongodbSessionCount           metricInfo
-	MongodbStorageSize            metricInfo
+	MongodbCacheOperations             metricInfo
+	MongodbCollectionCount             metricInfo
+	MongodbConnectionCount             metricInfo
+	MongodbCursorCount                 metricInfo
+	MongodbCursorTimeoutCount          metricInfo
+	MongodbDataSize                    metricInfo
+	MongodbDatabaseCount               metricInfo
+	MongodbDocumentOperationCount      metricInfo
+	MongodbExtentCount                 metricInfo
+	MongodbGlobalLockTime              metricInfo
+	MongodbIndexAccessCount            metricInfo
+	MongodbIndexCount                  metricInfo
+	MongodbIndexSize                   metricInfo
+	MongodbLockAcquireCount            metricInfo
+	MongodbLockAcquireTime             metricInfo
+	MongodbLockAcquireWaitCount        metricInfo
+	MongodbLockDeadlockCount           metricInfo
+	MongodbMemoryUsage                 metricInfo
+	MongodbNetworkIoReceive            metricInfo
+	MongodbNetworkIoTransmit           metricInfo
+	MongodbNetworkRequestCount         metricInfo
+	MongodbObjectCount                 metricInfo
+	MongodbOperationCount              metricInfo
+	MongodbOperationTime               metricInfo
+	MongodbOplogMaxSize                metricInfo
+	MongodbOplogSize                   metricInfo
+	MongodbOplogWindow                 metricInfo
+	MongodbReplsetMemberHealth         metricInfo
+	MongodbReplsetMemberReplicationLag metricInfo
+	MongodbReplsetMemberState          metricInfo
+	MongodbSessionCount                metricInfo
+	MongodbShardingBalancerEnabled     metricInfo
+	MongodbShardingBalancerRoundCount  metricInfo
+	MongodbShardingChunkCount          metricInfo
+	MongodbStorageSize                 metricInfo
 }
 
 type metricInfo struct {
@@ -2307,6 +2347,436 @@ func newMetricMongodbOperationTime(cfg MongodbOperationTimeMetricConfig) metricM
 	return m
 }
 
+type metricMongodbOplogMaxSize struct {
+	data     pmetric.Metric                  // data buffer for generated metric.
+	config   MongodbOplogMaxSizeMetricConfig // metric config provided by user.
+	capacity int                             // max observed number of data points added to the metric.
+}
+
+// init fills mongodb.oplog.max_size metric with initial data.
+func (m *metricMongodbOplogMaxSize) init() {
+	m.data.SetName("mongodb.oplog.max_size")
+	m.data.SetDescription("The maximum size of the oplog.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricMongodbOplogMaxSize) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMongodbOplogMaxSize) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMongodbOplogMaxSize) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMongodbOplogMaxSize(cfg MongodbOplogMaxSizeMetricConfig) metricMongodbOplogMaxSize {
+	m := metricMongodbOplogMaxSize{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricMongodbOplogSize struct {
+	data     pmetric.Metric               // data buffer for generated metric.
+	config   MongodbOplogSizeMetricConfig // metric config provided by user.
+	capacity int                          // max observed number of data points added to the metric.
+}
+
+// init fills mongodb.oplog.size metric with initial data.
+func (m *metricMongodbOplogSize) init() {
+	m.data.SetName("mongodb.oplog.size")
+	m.data.SetDescription("The size of the oplog.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricMongodbOplogSize) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMongodbOplogSize) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMongodbOplogSize) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMongodbOplogSize(cfg MongodbOplogSizeMetricConfig) metricMongodbOplogSize {
+	m := metricMongodbOplogSize{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricMongodbOplogWindow struct {
+	data     pmetric.Metric                 // data buffer for generated metric.
+	config   MongodbOplogWindowMetricConfig // metric config provided by user.
+	capacity int                            // max observed number of data points added to the metric.
+}
+
+// init fills mongodb.oplog.window metric with initial data.
+func (m *metricMongodbOplogWindow) init() {
+	m.data.SetName("mongodb.oplog.window")
+	m.data.SetDescription("The time between the first and the last operation in the oplog.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricMongodbOplogWindow) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMongodbOplogWindow) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMongodbOplogWindow) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMongodbOplogWindow(cfg MongodbOplogWindowMetricConfig) metricMongodbOplogWindow {
+	m := metricMongodbOplogWindow{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricMongodbReplsetMemberHealth struct {
+	data          pmetric.Metric                         // data buffer for generated metric.
+	config        MongodbReplsetMemberHealthMetricConfig // metric config provided by user.
+	capacity      int                                    // max observed number of data points added to the metric.
+	aggDataPoints []int64                                // slice containing number of aggregated datapoints at each index
+}
+
+// init fills mongodb.replset.member.health metric with initial data.
+func (m *metricMongodbReplsetMemberHealth) init() {
+	m.data.SetName("mongodb.replset.member.health")
+	m.data.SetDescription("Whether the replica set member is up (1) or down (0).")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricMongodbReplsetMemberHealth) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, replicaSetAttributeValue string, memberAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, MongodbReplsetMemberHealthMetricAttributeKeyReplicaSet) {
+		dp.Attributes().PutStr("replica_set", replicaSetAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, MongodbReplsetMemberHealthMetricAttributeKeyMember) {
+		dp.Attributes().PutStr("member", memberAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMongodbReplsetMemberHealth) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMongodbReplsetMemberHealth) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMongodbReplsetMemberHealth(cfg MongodbReplsetMemberHealthMetricConfig) metricMongodbReplsetMemberHealth {
+	m := metricMongodbReplsetMemberHealth{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricMongodbReplsetMemberReplicationLag struct {
+	data          pmetric.Metric                                 // data buffer for generated metric.
+	config        MongodbReplsetMemberReplicationLagMetricConfig // metric config provided by user.
+	capacity      int                                            // max observed number of data points added to the metric.
+	aggDataPoints []int64                                        // slice containing number of aggregated datapoints at each index
+}
+
+// init fills mongodb.replset.member.replication_lag metric with initial data.
+func (m *metricMongodbReplsetMemberReplicationLag) init() {
+	m.data.SetName("mongodb.replset.member.replication_lag")
+	m.data.SetDescription("How far the replica set member's last applied operation is behind the primary's.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricMongodbReplsetMemberReplicationLag) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, replicaSetAttributeValue string, memberAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, MongodbReplsetMemberReplicationLagMetricAttributeKeyReplicaSet) {
+		dp.Attributes().PutStr("replica_set", replicaSetAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, MongodbReplsetMemberReplicationLagMetricAttributeKeyMember) {
+		dp.Attributes().PutStr("member", memberAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMongodbReplsetMemberReplicationLag) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMongodbReplsetMemberReplicationLag) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMongodbReplsetMemberReplicationLag(cfg MongodbReplsetMemberReplicationLagMetricConfig) metricMongodbReplsetMemberReplicationLag {
+	m := metricMongodbReplsetMemberReplicationLag{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricMongodbReplsetMemberState struct {
+	data          pmetric.Metric                        // data buffer for generated metric.
+	config        MongodbReplsetMemberStateMetricConfig // metric config provided by user.
+	capacity      int                                   // max observed number of data points added to the metric.
+	aggDataPoints []int64                               // slice containing number of aggregated datapoints at each index
+}
+
+// init fills mongodb.replset.member.state metric with initial data.
+func (m *metricMongodbReplsetMemberState) init() {
+	m.data.SetName("mongodb.replset.member.state")
+	m.data.SetDescription("The state of the replica set member.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricMongodbReplsetMemberState) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, replicaSetAttributeValue string, memberAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, MongodbReplsetMemberStateMetricAttributeKeyReplicaSet) {
+		dp.Attributes().PutStr("replica_set", replicaSetAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, MongodbReplsetMemberStateMetricAttributeKeyMember) {
+		dp.Attributes().PutStr("member", memberAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMongodbReplsetMemberState) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMongodbReplsetMemberState) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMongodbReplsetMemberState(cfg MongodbReplsetMemberStateMetricConfig) metricMongodbReplsetMemberState {
+	m := metricMongodbReplsetMemberState{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricMongodbSessionCount struct {
 	data     pmetric.Metric                  // data buffer for generated metric.
 	config   MongodbSessionCountMetricConfig // metric config provided by user.
@@ -2359,6 +2829,199 @@ func newMetricMongodbSessionCount(cfg MongodbSessionCountMetricConfig) metricMon
 	return m
 }
 
+type metricMongodbShardingBalancerEnabled struct {
+	data     pmetric.Metric                             // data buffer for generated metric.
+	config   MongodbShardingBalancerEnabledMetricConfig // metric config provided by user.
+	capacity int                                        // max observed number of data points added to the metric.
+}
+
+// init fills mongodb.sharding.balancer.enabled metric with initial data.
+func (m *metricMongodbShardingBalancerEnabled) init() {
+	m.data.SetName("mongodb.sharding.balancer.enabled")
+	m.data.SetDescription("Whether the balancer is enabled (1) or not (0).")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricMongodbShardingBalancerEnabled) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMongodbShardingBalancerEnabled) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMongodbShardingBalancerEnabled) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMongodbShardingBalancerEnabled(cfg MongodbShardingBalancerEnabledMetricConfig) metricMongodbShardingBalancerEnabled {
+	m := metricMongodbShardingBalancerEnabled{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricMongodbShardingBalancerRoundCount struct {
+	data     pmetric.Metric                                // data buffer for generated metric.
+	config   MongodbShardingBalancerRoundCountMetricConfig // metric config provided by user.
+	capacity int                                           // max observed number of data points added to the metric.
+}
+
+// init fills mongodb.sharding.balancer.round.count metric with initial data.
+func (m *metricMongodbShardingBalancerRoundCount) init() {
+	m.data.SetName("mongodb.sharding.balancer.round.count")
+	m.data.SetDescription("The number of balancer rounds since the config server primary started.")
+	m.data.SetUnit("{rounds}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricMongodbShardingBalancerRoundCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMongodbShardingBalancerRoundCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMongodbShardingBalancerRoundCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMongodbShardingBalancerRoundCount(cfg MongodbShardingBalancerRoundCountMetricConfig) metricMongodbShardingBalancerRoundCount {
+	m := metricMongodbShardingBalancerRoundCount{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricMongodbShardingChunkCount struct {
+	data          pmetric.Metric                        // data buffer for generated metric.
+	config        MongodbShardingChunkCountMetricConfig // metric config provided by user.
+	capacity      int                                   // max observed number of data points added to the metric.
+	aggDataPoints []int64                               // slice containing number of aggregated datapoints at each index
+}
+
+// init fills mongodb.sharding.chunk.count metric with initial data.
+func (m *metricMongodbShardingChunkCount) init() {
+	m.data.SetName("mongodb.sharding.chunk.count")
+	m.data.SetDescription("The number of chunks on the shard.")
+	m.data.SetUnit("{chunks}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricMongodbShardingChunkCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, shardAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, MongodbShardingChunkCountMetricAttributeKeyShard) {
+		dp.Attributes().PutStr("shard", shardAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Sum().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMongodbShardingChunkCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMongodbShardingChunkCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Sum().DataPoints().At(i).SetIntValue(m.data.Sum().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMongodbShardingChunkCount(cfg MongodbShardingChunkCountMetricConfig) metricMongodbShardingChunkCount {
+	m := metricMongodbShardingChunkCount{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricMongodbStorageSize struct {
 	data          pmetric.Metric                 // data buffer for generated metric.
 	config        MongodbStorageSizeMetricConfig // metric config provided by user.
@@ -2453,39 +3116,48 @@ func newMetricMongodbStorageSize(cfg MongodbStorageSizeMetricConfig) metricMongo
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
-	config                              MetricsBuilderConfig // config of the metrics builder.
-	startTime                           pcommon.Timestamp    // start time that will be applied to all recorded data points.
-	metricsCapacity                     int                  // maximum observed number of metrics per resource.
-	metricsBuffer                       pmetric.Metrics      // accumulates metrics data before emitting.
-	buildInfo                           component.BuildInfo  // contains version information.
-	resourceAttributeIncludeFilter      map[string]filter.Filter
-	resourceAttributeExcludeFilter      map[string]filter.Filter
-	metricMongodbCacheOperations        metricMongodbCacheOperations
-	metricMongodbCollectionCount        metricMongodbCollectionCount
-	metricMongodbConnectionCount        metricMongodbConnectionCount
-	metricMongodbCursorCount            metricMongodbCursorCount
-	metricMongodbCursorTimeoutCount     metricMongodbCursorTimeoutCount
-	metricMongodbDataSize               metricMongodbDataSize
-	metricMongodbDatabaseCount          metricMongodbDatabaseCount
-	metricMongodbDocumentOperationCount metricMongodbDocumentOperationCount
-	metricMongodbExtentCount            metricMongodbExtentCount
-	metricMongodbGlobalLockTime         metricMongodbGlobalLockTime
-	metricMongodbIndexAccessCount       metricMongodbIndexAccessCount
-	metricMongodbIndexCount             metricMongodbIndexCount
-	metricMongodbIndexSize              metricMongodbIndexSize
-	metricMongodbLockAcquireCount       metricMongodbLockAcquireCount
-	metricMongodbLockAcquireTime        metricMongodbLockAcquireTime
-	metricMongodbLockAcquireWaitCount   metricMongodbLockAcquireWaitCount
-	metricMongodbLockDeadlockCount      metricMongodbLockDeadlockCount
-	metricMongodbMemoryUsage            metricMongodbMemoryUsage
-	metricMongodbNetworkIoReceive       metricMongodbNetworkIoReceive
-	metricMongodbNetworkIoTransmit      metricMongodbNetworkIoTransmit
-	metricMongodbNetworkRequestCount    metricMongodbNetworkRequestCount
-	metricMongodbObjectCount            metricMongodbObjectCount
-	metricMongodbOperationCount         metricMongodbOperationCount
-	metricMongodbOperationTime          metricMongodbOperationTime
-	metricMongodbSessionCount           metricMongodbSessionCount
-	metricMongodbStorageSize            metricMongodbStorageSize
+	config                                   MetricsBuilderConfig // config of the metrics builder.
+	startTime                                pcommon.Timestamp    // start time that will be applied to all recorded data points.
+	metricsCapacity                          int                  // maximum observed number of metrics per resource.
+	metricsBuffer                            pmetric.Metrics      // accumulates metrics data before emitting.
+	buildInfo                                component.BuildInfo  // contains version information.
+	resourceAttributeIncludeFilter           map[string]filter.Filter
+	resourceAttributeExcludeFilter           map[string]filter.Filter
+	metricMongodbCacheOperations             metricMongodbCacheOperations
+	metricMongodbCollectionCount             metricMongodbCollectionCount
+	metricMongodbConnectionCount             metricMongodbConnectionCount
+	metricMongodbCursorCount                 metricMongodbCursorCount
+	metricMongodbCursorTimeoutCount          metricMongodbCursorTimeoutCount
+	metricMongodbDataSize                    metricMongodbDataSize
+	metricMongodbDatabaseCount               metricMongodbDatabaseCount
+	metricMongodbDocumentOperationCount      metricMongodbDocumentOperationCount
+	metricMongodbExtentCount                 metricMongodbExtentCount
+	metricMongodbGlobalLockTime              metricMongodbGlobalLockTime
+	metricMongodbIndexAccessCount            metricMongodbIndexAccessCount
+	metricMongodbIndexCount                  metricMongodbIndexCount
+	metricMongodbIndexSize                   metricMongodbIndexSize
+	metricMongodbLockAcquireCount            metricMongodbLockAcquireCount
+	metricMongodbLockAcquireTime             metricMongodbLockAcquireTime
+	metricMongodbLockAcquireWaitCount        metricMongodbLockAcquireWaitCount
+	metricMongodbLockDeadlockCount           metricMongodbLockDeadlockCount
+	metricMongodbMemoryUsage                 metricMongodbMemoryUsage
+	metricMongodbNetworkIoReceive            metricMongodbNetworkIoReceive
+	metricMongodbNetworkIoTransmit           metricMongodbNetworkIoTransmit
+	metricMongodbNetworkRequestCount         metricMongodbNetworkRequestCount
+	metricMongodbObjectCount                 metricMongodbObjectCount
+	metricMongodbOperationCount              metricMongodbOperationCount
+	metricMongodbOperationTime               metricMongodbOperationTime
+	metricMongodbOplogMaxSize                metricMongodbOplogMaxSize
+	metricMongodbOplogSize                   metricMongodbOplogSize
+	metricMongodbOplogWindow                 metricMongodbOplogWindow
+	metricMongodbReplsetMemberHealth         metricMongodbReplsetMemberHealth
+	metricMongodbReplsetMemberReplicationLag metricMongodbReplsetMemberReplicationLag
+	metricMongodbReplsetMemberState          metricMongodbReplsetMemberState
+	metricMongodbSessionCount                metricMongodbSessionCount
+	metricMongodbShardingBalancerEnabled     metricMongodbShardingBalancerEnabled
+	metricMongodbShardingBalancerRoundCount  metricMongodbShardingBalancerRoundCount
+	metricMongodbShardingChunkCount          metricMongodbShardingChunkCount
+	metricMongodbStorageSize                 metricMongodbStorageSize
 }
 
 // MetricBuilderOption applies changes to default metrics builder.
@@ -2507,38 +3179,47 @@ func WithStartTime(startTime pcommon.Timestamp) MetricBuilderOption {
 }
 func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, options ...MetricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		config:                              mbc,
-		startTime:                           pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                       pmetric.NewMetrics(),
-		buildInfo:                           settings.BuildInfo,
-		metricMongodbCacheOperations:        newMetricMongodbCacheOperations(mbc.Metrics.MongodbCacheOperations),
-		metricMongodbCollectionCount:        newMetricMongodbCollectionCount(mbc.Metrics.MongodbCollectionCount),
-		metricMongodbConnectionCount:        newMetricMongodbConnectionCount(mbc.Metrics.MongodbConnectionCount),
-		metricMongodbCursorCount:            newMetricMongodbCursorCount(mbc.Metrics.MongodbCursorCount),
-		metricMongodbCursorTimeoutCount:     newMetricMongodbCursorTimeoutCount(mbc.Metrics.MongodbCursorTimeoutCount),
-		metricMongodbDataSize:               newMetricMongodbDataSize(mbc.Metrics.MongodbDataSize),
-		metricMongodbDatabaseCount:          newMetricMongodbDatabaseCount(mbc.Metrics.MongodbDatabaseCount),
-		metricMongodbDocumentOperationCount: newMetricMongodbDocumentOperationCount(mbc.Metrics.MongodbDocumentOperationCount),
-		metricMongodbExtentCount:            newMetricMongodbExtentCount(mbc.Metrics.MongodbExtentCount),
-		metricMongodbGlobalLockTime:         newMetricMongodbGlobalLockTime(mbc.Metrics.MongodbGlobalLockTime),
-		metricMongodbIndexAccessCount:       newMetricMongodbIndexAccessCount(mbc.Metrics.MongodbIndexAccessCount),
-		metricMongodbIndexCount:             newMetricMongodbIndexCount(mbc.Metrics.MongodbIndexCount),
-		metricMongodbIndexSize:              newMetricMongodbIndexSize(mbc.Metrics.MongodbIndexSize),
-		metricMongodbLockAcquireCount:       newMetricMongodbLockAcquireCount(mbc.Metrics.MongodbLockAcquireCount),
-		metricMongodbLockAcquireTime:        newMetricMongodbLockAcquireTime(mbc.Metrics.MongodbLockAcquireTime),
-		metricMongodbLockAcquireWaitCount:   newMetricMongodbLockAcquireWaitCount(mbc.Metrics.MongodbLockAcquireWaitCount),
-		metricMongodbLockDeadlockCount:      newMetricMongodbLockDeadlockCount(mbc.Metrics.MongodbLockDeadlockCount),
-		metricMongodbMemoryUsage:            newMetricMongodbMemoryUsage(mbc.Metrics.MongodbMemoryUsage),
-		metricMongodbNetworkIoReceive:       newMetricMongodbNetworkIoReceive(mbc.Metrics.MongodbNetworkIoReceive),
-		metricMongodbNetworkIoTransmit:      newMetricMongodbNetworkIoTransmit(mbc.Metrics.MongodbNetworkIoTransmit),
-		metricMongodbNetworkRequestCount:    newMetricMongodbNetworkRequestCount(mbc.Metrics.MongodbNetworkRequestCount),
-		metricMongodbObjectCount:            newMetricMongodbObjectCount(mbc.Metrics.MongodbObjectCount),
-		metricMongodbOperationCount:         newMetricMongodbOperationCount(mbc.Metrics.MongodbOperationCount),
-		metricMongodbOperationTime:          newMetricMongodbOperationTime(mbc.Metrics.MongodbOperationTime),
-		metricMongodbSessionCount:           newMetricMongodbSessionCount(mbc.Metrics.MongodbSessionCount),
-		metricMongodbStorageSize:            newMetricMongodbStorageSize(mbc.Metrics.MongodbStorageSize),
-		resourceAttributeIncludeFilter:      make(map[string]filter.Filter),
-		resourceAttributeExcludeFilter:      make(map[string]filter.Filter),
+		config:                                   mbc,
+		startTime:                                pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                            pmetric.NewMetrics(),
+		buildInfo:                                settings.BuildInfo,
+		metricMongodbCacheOperations:             newMetricMongodbCacheOperations(mbc.Metrics.MongodbCacheOperations),
+		metricMongodbCollectionCount:             newMetricMongodbCollectionCount(mbc.Metrics.MongodbCollectionCount),
+		metricMongodbConnectionCount:             newMetricMongodbConnectionCount(mbc.Metrics.MongodbConnectionCount),
+		metricMongodbCursorCount:                 newMetricMongodbCursorCount(mbc.Metrics.MongodbCursorCount),
+		metricMongodbCursorTimeoutCount:          newMetricMongodbCursorTimeoutCount(mbc.Metrics.MongodbCursorTimeoutCount),
+		metricMongodbDataSize:                    newMetricMongodbDataSize(mbc.Metrics.MongodbDataSize),
+		metricMongodbDatabaseCount:               newMetricMongodbDatabaseCount(mbc.Metrics.MongodbDatabaseCount),
+		metricMongodbDocumentOperationCount:      newMetricMongodbDocumentOperationCount(mbc.Metrics.MongodbDocumentOperationCount),
+		metricMongodbExtentCount:                 newMetricMongodbExtentCount(mbc.Metrics.MongodbExtentCount),
+		metricMongodbGlobalLockTime:              newMetricMongodbGlobalLockTime(mbc.Metrics.MongodbGlobalLockTime),
+		metricMongodbIndexAccessCount:            newMetricMongodbIndexAccessCount(mbc.Metrics.MongodbIndexAccessCount),
+		metricMongodbIndexCount:                  newMetricMongodbIndexCount(mbc.Metrics.MongodbIndexCount),
+		metricMongodbIndexSize:                   newMetricMongodbIndexSize(mbc.Metrics.MongodbIndexSize),
+		metricMongodbLockAcquireCount:            newMetricMongodbLockAcquireCount(mbc.Metrics.MongodbLockAcquireCount),
+		metricMongodbLockAcquireTime:             newMetricMongodbLockAcquireTime(mbc.Metrics.MongodbLockAcquireTime),
+		metricMongodbLockAcquireWaitCount:        newMetricMongodbLockAcquireWaitCount(mbc.Metrics.MongodbLockAcquireWaitCount),
+		metricMongodbLockDeadlockCount:           newMetricMongodbLockDeadlockCount(mbc.Metrics.MongodbLockDeadlockCount),
+		metricMongodbMemoryUsage:                 newMetricMongodbMemoryUsage(mbc.Metrics.MongodbMemoryUsage),
+		metricMongodbNetworkIoReceive:            newMetricMongodbNetworkIoReceive(mbc.Metrics.MongodbNetworkIoReceive),
+		metricMongodbNetworkIoTransmit:           newMetricMongodbNetworkIoTransmit(mbc.Metrics.MongodbNetworkIoTransmit),
+		metricMongodbNetworkRequestCount:         newMetricMongodbNetworkRequestCount(mbc.Metrics.MongodbNetworkRequestCount),
+		metricMongodbObjectCount:                 newMetricMongodbObjectCount(mbc.Metrics.MongodbObjectCount),
+		metricMongodbOperationCount:              newMetricMongodbOperationCount(mbc.Metrics.MongodbOperationCount),
+		metricMongodbOperationTime:               newMetricMongodbOperationTime(mbc.Metrics.MongodbOperationTime),
+		metricMongodbOplogMaxSize:                newMetricMongodbOplogMaxSize(mbc.Metrics.MongodbOplogMaxSize),
+		metricMongodbOplogSize:                   newMetricMongodbOplogSize(mbc.Metrics.MongodbOplogSize),
+		metricMongodbOplogWindow:                 newMetricMongodbOplogWindow(mbc.Metrics.MongodbOplogWindow),
+		metricMongodbReplsetMemberHealth:         newMetricMongodbReplsetMemberHealth(mbc.Metrics.MongodbReplsetMemberHealth),
+		metricMongodbReplsetMemberReplicationLag: newMetricMongodbReplsetMemberReplicationLag(mbc.Metrics.MongodbReplsetMemberReplicationLag),
+		metricMongodbReplsetMemberState:          newMetricMongodbReplsetMemberState(mbc.Metrics.MongodbReplsetMemberState),
+		metricMongodbSessionCount:                newMetricMongodbSessionCount(mbc.Metrics.MongodbSessionCount),
+		metricMongodbShardingBalancerEnabled:     newMetricMongodbShardingBalancerEnabled(mbc.Metrics.MongodbShardingBalancerEnabled),
+		metricMongodbShardingBalancerRoundCount:  newMetricMongodbShardingBalancerRoundCount(mbc.Metrics.MongodbShardingBalancerRoundCount),
+		metricMongodbShardingChunkCount:          newMetricMongodbShardingChunkCount(mbc.Metrics.MongodbShardingChunkCount),
+		metricMongodbStorageSize:                 newMetricMongodbStorageSize(mbc.Metrics.MongodbStorageSize),
+		resourceAttributeIncludeFilter:           make(map[string]filter.Filter),
+		resourceAttributeExcludeFilter:           make(map[string]filter.Filter),
 	}
 	if mbc.ResourceAttributes.Database.MetricsInclude != nil {
 		mb.resourceAttributeIncludeFilter["database"] = filter.CreateFilter(mbc.ResourceAttributes.Database.MetricsInclude)
@@ -2639,7 +3320,16 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	mb.metricMongodbObjectCount.emit(ils.Metrics())
 	mb.metricMongodbOperationCount.emit(ils.Metrics())
 	mb.metricMongodbOperationTime.emit(ils.Metrics())
+	mb.metricMongodbOplogMaxSize.emit(ils.Metrics())
+	mb.metricMongodbOplogSize.emit(ils.Metrics())
+	mb.metricMongodbOplogWindow.emit(ils.Metrics())
+	mb.metricMongodbReplsetMemberHealth.emit(ils.Metrics())
+	mb.metricMongodbReplsetMemberReplicationLag.emit(ils.Metrics())
+	mb.metricMongodbReplsetMemberState.emit(ils.Metrics())
 	mb.metricMongodbSessionCount.emit(ils.Metrics())
+	mb.metricMongodbShardingBalancerEnabled.emit(ils.Metrics())
+	mb.metricMongodbShardingBalancerRoundCount.emit(ils.Metrics())
+	mb.metricMongodbShardingChunkCount.emit(ils.Metrics())
 	mb.metricMongodbStorageSize.emit(ils.Metrics())
 
 	for _, op := range options {
@@ -2792,11 +3482,56 @@ func (mb *MetricsBuilder) RecordMongodbOperationTimeDataPoint(ts pcommon.Timesta
 	mb.metricMongodbOperationTime.recordDataPoint(mb.startTime, ts, val, operationAttributeValue.String())
 }
 
+// RecordMongodbOplogMaxSizeDataPoint adds a data point to mongodb.oplog.max_size metric.
+func (mb *MetricsBuilder) RecordMongodbOplogMaxSizeDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricMongodbOplogMaxSize.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordMongodbOplogSizeDataPoint adds a data point to mongodb.oplog.size metric.
+func (mb *MetricsBuilder) RecordMongodbOplogSizeDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricMongodbOplogSize.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordMongodbOplogWindowDataPoint adds a data point to mongodb.oplog.window metric.
+func (mb *MetricsBuilder) RecordMongodbOplogWindowDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricMongodbOplogWindow.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordMongodbReplsetMemberHealthDataPoint adds a data point to mongodb.replset.member.health metric.
+func (mb *MetricsBuilder) RecordMongodbReplsetMemberHealthDataPoint(ts pcommon.Timestamp, val int64, replicaSetAttributeValue string, memberAttributeValue string) {
+	mb.metricMongodbReplsetMemberHealth.recordDataPoint(mb.startTime, ts, val, replicaSetAttributeValue, memberAttributeValue)
+}
+
+// RecordMongodbReplsetMemberReplicationLagDataPoint adds a data point to mongodb.replset.member.replication_lag metric.
+func (mb *MetricsBuilder) RecordMongodbReplsetMemberReplicationLagDataPoint(ts pcommon.Timestamp, val int64, replicaSetAttributeValue string, memberAttributeValue string) {
+	mb.metricMongodbReplsetMemberReplicationLag.recordDataPoint(mb.startTime, ts, val, replicaSetAttributeValue, memberAttributeValue)
+}
+
+// RecordMongodbReplsetMemberStateDataPoint adds a data point to mongodb.replset.member.state metric.
+func (mb *MetricsBuilder) RecordMongodbReplsetMemberStateDataPoint(ts pcommon.Timestamp, val int64, replicaSetAttributeValue string, memberAttributeValue string) {
+	mb.metricMongodbReplsetMemberState.recordDataPoint(mb.startTime, ts, val, replicaSetAttributeValue, memberAttributeValue)
+}
+
 // RecordMongodbSessionCountDataPoint adds a data point to mongodb.session.count metric.
 func (mb *MetricsBuilder) RecordMongodbSessionCountDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricMongodbSessionCount.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordMongodbShardingBalancerEnabledDataPoint adds a data point to mongodb.sharding.balancer.enabled metric.
+func (mb *MetricsBuilder) RecordMongodbShardingBalancerEnabledDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricMongodbShardingBalancerEnabled.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordMongodbShardingBalancerRoundCountDataPoint adds a data point to mongodb.sharding.balancer.round.count metric.
+func (mb *MetricsBuilder) RecordMongodbShardingBalancerRoundCountDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricMongodbShardingBalancerRoundCount.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordMongodbShardingChunkCountDataPoint adds a data point to mongodb.sharding.chunk.count metric.
+func (mb *MetricsBuilder) RecordMongodbShardingChunkCountDataPoint(ts pcommon.Timestamp, val int64, shardAttributeValue string) {
+	mb.metricMongodbShardingChunkCount.recordDataPoint(mb.startTime, ts, val, shardAttributeValue)
+}
+
 // RecordMongodbStorageSizeDataPoint adds a data point to mongodb.storage.size metric.
 func (mb *MetricsBuilder) RecordMongodbStorageSizeDataPoint(ts pcommon.Timestamp, val int64, databaseAttributeValue string) {
 	mb.metricMongodbStorageSize.recordDataPoint(mb.startTime, ts, val, databaseAttributeValue)
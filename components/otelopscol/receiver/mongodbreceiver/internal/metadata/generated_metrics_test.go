@@ -84,6 +84,10 @@ func TestMetricsBuilder(t *testing.T) {
 			aggMap["mongodb.object.count"] = mb.metricMongodbObjectCount.config.AggregationStrategy
 			aggMap["mongodb.operation.count"] = mb.metricMongodbOperationCount.config.AggregationStrategy
 			aggMap["mongodb.operation.time"] = mb.metricMongodbOperationTime.config.AggregationStrategy
+			aggMap["mongodb.replset.member.health"] = mb.metricMongodbReplsetMemberHealth.config.AggregationStrategy
+			aggMap["mongodb.replset.member.replication_lag"] = mb.metricMongodbReplsetMemberReplicationLag.config.AggregationStrategy
+			aggMap["mongodb.replset.member.state"] = mb.metricMongodbReplsetMemberState.config.AggregationStrategy
+			aggMap["mongodb.sharding.chunk.count"] = mb.metricMongodbShardingChunkCount.config.AggregationStrategy
 			aggMap["mongodb.storage.size"] = mb.metricMongodbStorageSize.config.AggregationStrategy
 
 			expectedWarnings := 0
@@ -218,9 +222,48 @@ func TestMetricsBuilder(t *testing.T) {
 			}
 			defaultMetricsCount++
 			allMetricsCount++
+			mb.RecordMongodbOplogMaxSizeDataPoint(ts, 1)
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordMongodbOplogSizeDataPoint(ts, 1)
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordMongodbOplogWindowDataPoint(ts, 1)
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordMongodbReplsetMemberHealthDataPoint(ts, 1, "replica_set-val", "member-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordMongodbReplsetMemberHealthDataPoint(ts, 3, "replica_set-val-2", "member-val-2")
+			}
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordMongodbReplsetMemberReplicationLagDataPoint(ts, 1, "replica_set-val", "member-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordMongodbReplsetMemberReplicationLagDataPoint(ts, 3, "replica_set-val-2", "member-val-2")
+			}
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordMongodbReplsetMemberStateDataPoint(ts, 1, "replica_set-val", "member-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordMongodbReplsetMemberStateDataPoint(ts, 3, "replica_set-val-2", "member-val-2")
+			}
+			defaultMetricsCount++
+			allMetricsCount++
 			mb.RecordMongodbSessionCountDataPoint(ts, 1)
 			defaultMetricsCount++
 			allMetricsCount++
+			mb.RecordMongodbShardingBalancerEnabledDataPoint(ts, 1)
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordMongodbShardingBalancerRoundCountDataPoint(ts, 1)
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordMongodbShardingChunkCountDataPoint(ts, 1, "shard-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordMongodbShardingChunkCountDataPoint(ts, 3, "shard-val-2")
+			}
+			defaultMetricsCount++
+			allMetricsCount++
 			mb.RecordMongodbStorageSizeDataPoint(ts, 1, "database-val")
 			if tt.name == "reaggregate_set" {
 				mb.RecordMongodbStorageSizeDataPoint(ts, 3, "database-val-2")
@@ -248,6 +291,10 @@ func TestMetricsBuilder(t *testing.T) {
 				assert.Empty(t, mb.metricMongodbObjectCount.aggDataPoints)
 				assert.Empty(t, mb.metricMongodbOperationCount.aggDataPoints)
 				assert.Empty(t, mb.metricMongodbOperationTime.aggDataPoints)
+				assert.Empty(t, mb.metricMongodbReplsetMemberHealth.aggDataPoints)
+				assert.Empty(t, mb.metricMongodbReplsetMemberReplicationLag.aggDataPoints)
+				assert.Empty(t, mb.metricMongodbReplsetMemberState.aggDataPoints)
+				assert.Empty(t, mb.metricMongodbShardingChunkCount.aggDataPoints)
 				assert.Empty(t, mb.metricMongodbStorageSize.aggDataPoints)
 			}
 
@@ -1182,6 +1229,181 @@ func TestMetricsBuilder(t *testing.T) {
 						_, ok := dp.Attributes().Get("operation")
 						assert.False(t, ok)
 					}
+				case "mongodb.oplog.max_size":
+					assert.False(t, validatedMetrics["mongodb.oplog.max_size"], "Found a duplicate in the metrics slice: mongodb.oplog.max_size")
+					validatedMetrics["mongodb.oplog.max_size"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, mi.Type())
+					assert.Equal(t, 1, mi.Sum().DataPoints().Len())
+					assert.Equal(t, "The maximum size of the oplog.", mi.Description())
+					assert.Equal(t, "By", mi.Unit())
+					assert.False(t, mi.Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, mi.Sum().AggregationTemporality())
+					dp := mi.Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "mongodb.oplog.size":
+					assert.False(t, validatedMetrics["mongodb.oplog.size"], "Found a duplicate in the metrics slice: mongodb.oplog.size")
+					validatedMetrics["mongodb.oplog.size"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, mi.Type())
+					assert.Equal(t, 1, mi.Sum().DataPoints().Len())
+					assert.Equal(t, "The size of the oplog.", mi.Description())
+					assert.Equal(t, "By", mi.Unit())
+					assert.False(t, mi.Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, mi.Sum().AggregationTemporality())
+					dp := mi.Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "mongodb.oplog.window":
+					assert.False(t, validatedMetrics["mongodb.oplog.window"], "Found a duplicate in the metrics slice: mongodb.oplog.window")
+					validatedMetrics["mongodb.oplog.window"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+					assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+					assert.Equal(t, "The time between the first and the last operation in the oplog.", mi.Description())
+					assert.Equal(t, "s", mi.Unit())
+					dp := mi.Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "mongodb.replset.member.health":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["mongodb.replset.member.health"], "Found a duplicate in the metrics slice: mongodb.replset.member.health")
+						validatedMetrics["mongodb.replset.member.health"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "Whether the replica set member is up (1) or down (0).", mi.Description())
+						assert.Equal(t, "1", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						replicaSetAttrVal, ok := dp.Attributes().Get("replica_set")
+						assert.True(t, ok)
+						assert.Equal(t, "replica_set-val", replicaSetAttrVal.Str())
+						memberAttrVal, ok := dp.Attributes().Get("member")
+						assert.True(t, ok)
+						assert.Equal(t, "member-val", memberAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["mongodb.replset.member.health"], "Found a duplicate in the metrics slice: mongodb.replset.member.health")
+						validatedMetrics["mongodb.replset.member.health"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "Whether the replica set member is up (1) or down (0).", mi.Description())
+						assert.Equal(t, "1", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["mongodb.replset.member.health"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("replica_set")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("member")
+						assert.False(t, ok)
+					}
+				case "mongodb.replset.member.replication_lag":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["mongodb.replset.member.replication_lag"], "Found a duplicate in the metrics slice: mongodb.replset.member.replication_lag")
+						validatedMetrics["mongodb.replset.member.replication_lag"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "How far the replica set member's last applied operation is behind the primary's.", mi.Description())
+						assert.Equal(t, "ms", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						replicaSetAttrVal, ok := dp.Attributes().Get("replica_set")
+						assert.True(t, ok)
+						assert.Equal(t, "replica_set-val", replicaSetAttrVal.Str())
+						memberAttrVal, ok := dp.Attributes().Get("member")
+						assert.True(t, ok)
+						assert.Equal(t, "member-val", memberAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["mongodb.replset.member.replication_lag"], "Found a duplicate in the metrics slice: mongodb.replset.member.replication_lag")
+						validatedMetrics["mongodb.replset.member.replication_lag"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "How far the replica set member's last applied operation is behind the primary's.", mi.Description())
+						assert.Equal(t, "ms", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["mongodb.replset.member.replication_lag"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("replica_set")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("member")
+						assert.False(t, ok)
+					}
+				case "mongodb.replset.member.state":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["mongodb.replset.member.state"], "Found a duplicate in the metrics slice: mongodb.replset.member.state")
+						validatedMetrics["mongodb.replset.member.state"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The state of the replica set member.", mi.Description())
+						assert.Equal(t, "1", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						replicaSetAttrVal, ok := dp.Attributes().Get("replica_set")
+						assert.True(t, ok)
+						assert.Equal(t, "replica_set-val", replicaSetAttrVal.Str())
+						memberAttrVal, ok := dp.Attributes().Get("member")
+						assert.True(t, ok)
+						assert.Equal(t, "member-val", memberAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["mongodb.replset.member.state"], "Found a duplicate in the metrics slice: mongodb.replset.member.state")
+						validatedMetrics["mongodb.replset.member.state"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The state of the replica set member.", mi.Description())
+						assert.Equal(t, "1", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["mongodb.replset.member.state"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("replica_set")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("member")
+						assert.False(t, ok)
+					}
 				case "mongodb.session.count":
 					assert.False(t, validatedMetrics["mongodb.session.count"], "Found a duplicate in the metrics slice: mongodb.session.count")
 					validatedMetrics["mongodb.session.count"] = true
@@ -1196,6 +1418,76 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "mongodb.sharding.balancer.enabled":
+					assert.False(t, validatedMetrics["mongodb.sharding.balancer.enabled"], "Found a duplicate in the metrics slice: mongodb.sharding.balancer.enabled")
+					validatedMetrics["mongodb.sharding.balancer.enabled"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+					assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+					assert.Equal(t, "Whether the balancer is enabled (1) or not (0).", mi.Description())
+					assert.Equal(t, "1", mi.Unit())
+					dp := mi.Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "mongodb.sharding.balancer.round.count":
+					assert.False(t, validatedMetrics["mongodb.sharding.balancer.round.count"], "Found a duplicate in the metrics slice: mongodb.sharding.balancer.round.count")
+					validatedMetrics["mongodb.sharding.balancer.round.count"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, mi.Type())
+					assert.Equal(t, 1, mi.Sum().DataPoints().Len())
+					assert.Equal(t, "The number of balancer rounds since the config server primary started.", mi.Description())
+					assert.Equal(t, "{rounds}", mi.Unit())
+					assert.True(t, mi.Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, mi.Sum().AggregationTemporality())
+					dp := mi.Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "mongodb.sharding.chunk.count":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["mongodb.sharding.chunk.count"], "Found a duplicate in the metrics slice: mongodb.sharding.chunk.count")
+						validatedMetrics["mongodb.sharding.chunk.count"] = true
+						assert.Equal(t, pmetric.MetricTypeSum, mi.Type())
+						assert.Equal(t, 1, mi.Sum().DataPoints().Len())
+						assert.Equal(t, "The number of chunks on the shard.", mi.Description())
+						assert.Equal(t, "{chunks}", mi.Unit())
+						assert.False(t, mi.Sum().IsMonotonic())
+						assert.Equal(t, pmetric.AggregationTemporalityCumulative, mi.Sum().AggregationTemporality())
+						dp := mi.Sum().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						shardAttrVal, ok := dp.Attributes().Get("shard")
+						assert.True(t, ok)
+						assert.Equal(t, "shard-val", shardAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["mongodb.sharding.chunk.count"], "Found a duplicate in the metrics slice: mongodb.sharding.chunk.count")
+						validatedMetrics["mongodb.sharding.chunk.count"] = true
+						assert.Equal(t, pmetric.MetricTypeSum, mi.Type())
+						assert.Equal(t, 1, mi.Sum().DataPoints().Len())
+						assert.Equal(t, "The number of chunks on the shard.", mi.Description())
+						assert.Equal(t, "{chunks}", mi.Unit())
+						assert.False(t, mi.Sum().IsMonotonic())
+						assert.Equal(t, pmetric.AggregationTemporalityCumulative, mi.Sum().AggregationTemporality())
+						dp := mi.Sum().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["mongodb.sharding.chunk.count"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("shard")
+						assert.False(t, ok)
+					}
 				case "mongodb.storage.size":
 					if tt.name != "reaggregate_set" {
 						assert.False(t, validatedMetrics["mongodb.storage.size"], "Found a duplicate in the metrics slice: mongodb.storage.size")
@@ -21,6 +21,7 @@ import (
 
 	"github.com/hashicorp/go-version"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/scraper/scrapererror"
 
@@ -62,6 +63,10 @@ var lockModeMap = map[string]metadata.AttributeLockMode{
 	"w": metadata.AttributeLockModeIntentExclusive,
 }
 
+// replicaSetStatePrimary is the replSetGetStatus state of the primary.
+// https://www.mongodb.com/docs/manual/reference/replica-states/
+const replicaSetStatePrimary = 1
+
 const (
 	collectMetricError          = "failed to collect metric %s: %w"
 	collectMetricWithAttributes = "failed to collect metric %s with attribute(s) %s: %w"
@@ -507,6 +512,128 @@ func (s *mongodbScraper) recordOperationTime(now pcommon.Timestamp, doc bson.M,
 	}
 }
 
+// Replica Set Status
+func (s *mongodbScraper) recordReplicaSetMembers(now pcommon.Timestamp, doc bson.M, errs *scrapererror.ScrapeErrors) {
+	metricName := "mongodb.replset.member.state"
+	replicaSet, ok := doc["set"].(string)
+	if !ok {
+		errs.AddPartial(1, fmt.Errorf(collectMetricError, metricName, errKeyNotFound))
+		return
+	}
+	members, ok := doc["members"].(bson.A)
+	if !ok {
+		errs.AddPartial(1, fmt.Errorf(collectMetricWithAttributes, metricName, replicaSet, errKeyNotFound))
+		return
+	}
+
+	// The replication lag of the members is relative to the primary, if there is one.
+	var primaryOptime primitive.DateTime
+	hasPrimary := false
+	for _, m := range members {
+		member, ok := m.(bson.M)
+		if !ok {
+			continue
+		}
+		if state, err := parseInt(member["state"]); err == nil && state == replicaSetStatePrimary {
+			primaryOptime, hasPrimary = member["optimeDate"].(primitive.DateTime)
+		}
+	}
+
+	for _, m := range members {
+		member, ok := m.(bson.M)
+		if !ok {
+			continue
+		}
+		memberName, ok := member["name"].(string)
+		if !ok {
+			errs.AddPartial(1, fmt.Errorf(collectMetricWithAttributes, metricName, replicaSet, errKeyNotFound))
+			continue
+		}
+		metricAttributes := fmt.Sprintf("%s, %s", replicaSet, memberName)
+
+		state, err := parseInt(member["state"])
+		if err != nil {
+			errs.AddPartial(1, fmt.Errorf(collectMetricWithAttributes, "mongodb.replset.member.state", metricAttributes, err))
+		} else {
+			s.mb.RecordMongodbReplsetMemberStateDataPoint(now, state, replicaSet, memberName)
+		}
+
+		health, err := parseInt(member["health"])
+		if err != nil {
+			errs.AddPartial(1, fmt.Errorf(collectMetricWithAttributes, "mongodb.replset.member.health", metricAttributes, err))
+		} else {
+			s.mb.RecordMongodbReplsetMemberHealthDataPoint(now, health, replicaSet, memberName)
+		}
+
+		// Arbiters hold no data, so they have no optime.
+		optime, ok := member["optimeDate"].(primitive.DateTime)
+		if hasPrimary && ok {
+			lag := primaryOptime.Time().Sub(optime.Time()).Milliseconds()
+			s.mb.RecordMongodbReplsetMemberReplicationLagDataPoint(now, lag, replicaSet, memberName)
+		}
+	}
+}
+
+// Oplog Stats
+func (s *mongodbScraper) recordOplogStats(now pcommon.Timestamp, doc bson.M, errs *scrapererror.ScrapeErrors) {
+	metricName := "mongodb.oplog.size"
+	val, err := collectMetric(doc, []string{"size"})
+	if err != nil {
+		errs.AddPartial(1, fmt.Errorf(collectMetricError, metricName, err))
+	} else {
+		s.mb.RecordMongodbOplogSizeDataPoint(now, val)
+	}
+
+	metricName = "mongodb.oplog.max_size"
+	val, err = collectMetric(doc, []string{"maxSize"})
+	if err != nil {
+		errs.AddPartial(1, fmt.Errorf(collectMetricError, metricName, err))
+	} else {
+		s.mb.RecordMongodbOplogMaxSizeDataPoint(now, val)
+	}
+}
+
+// Balancer Status
+func (s *mongodbScraper) recordBalancerStatus(now pcommon.Timestamp, doc bson.M, errs *scrapererror.ScrapeErrors) {
+	metricName := "mongodb.sharding.balancer.enabled"
+	mode, ok := doc["mode"].(string)
+	if !ok {
+		errs.AddPartial(1, fmt.Errorf(collectMetricError, metricName, errKeyNotFound))
+	} else {
+		var enabled int64
+		if mode != "off" {
+			enabled = 1
+		}
+		s.mb.RecordMongodbShardingBalancerEnabledDataPoint(now, enabled)
+	}
+
+	metricName = "mongodb.sharding.balancer.round.count"
+	val, err := collectMetric(doc, []string{"numBalancerRounds"})
+	if err != nil {
+		errs.AddPartial(1, fmt.Errorf(collectMetricError, metricName, err))
+	} else {
+		s.mb.RecordMongodbShardingBalancerRoundCountDataPoint(now, val)
+	}
+}
+
+// Chunk Counts
+func (s *mongodbScraper) recordChunkCounts(now pcommon.Timestamp, documents []bson.M, errs *scrapererror.ScrapeErrors) {
+	metricName := "mongodb.sharding.chunk.count"
+	for _, doc := range documents {
+		shard, ok := doc["_id"].(string)
+		if !ok {
+			errs.AddPartial(1, fmt.Errorf(collectMetricError, metricName, errKeyNotFound))
+			continue
+		}
+		val, err := collectMetric(doc, []string{"count"})
+		if err != nil {
+			errs.AddPartial(1, fmt.Errorf(collectMetricWithAttributes, metricName, shard, err))
+			continue
+		}
+		s.mb.RecordMongodbShardingChunkCountDataPoint(now, val, shard)
+	}
+}
+
 func aggregateOperationTimeValues(document bson.M, collectionPathNames []string, operationMap map[string]metadata.AttributeOperation) (map[string]int64, error) {
 	operationTotals := map[string]int64{}
 	for _, collectionPathName := range collectionPathNames {
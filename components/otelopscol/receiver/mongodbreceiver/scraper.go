@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/go-version"
@@ -37,7 +38,10 @@ type mongodbScraper struct {
 	config       *Config
 	client       client
 	mongoVersion *version.Version
-	mb           *metadata.MetricsBuilder
+	// mu guards mb and the scrape errors while databases and collections are
+	// collected concurrently.
+	mu sync.Mutex
+	mb *metadata.MetricsBuilder
 }
 
 func newMongodbScraper(settings receiver.Settings, config *Config) *mongodbScraper {
@@ -71,6 +75,14 @@ func (s *mongodbScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 		return pmetric.NewMetrics(), errors.New("no client was initialized before calling scrape")
 	}
 
+	// Bound the whole scrape, so that it doesn't overrun the collection
+	// interval.
+	if timeout := s.config.scrapeTimeout(); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
 	if s.mongoVersion == nil {
 		version, err := s.client.GetVersion(ctx)
 		if err != nil {
@@ -97,36 +109,42 @@ func (s *mongodbScraper) collectMetrics(ctx context.Context, errs *scrapererror.
 	s.collectAdminDatabase(ctx, now, errs)
 	s.collectTopStats(ctx, now, errs)
 
+	// The databases are collected first, along with the names of their
+	// collections, then the index stats of the collections.
+	var databaseTasks []func()
+	var collectionTasks []func()
 	for _, dbName := range dbNames {
-		s.collectDatabase(ctx, now, dbName, errs)
-		collectionNames, err := s.client.ListCollectionNames(ctx, dbName)
-		if err != nil {
-			errs.AddPartial(1, fmt.Errorf("failed to fetch collection names: %w", err))
-			return
-		}
-
-		// The indexStats aggregation is only available if version is >= 3.2
-		// https://www.mongodb.com/docs/v3.2/reference/operator/aggregation/indexStats/
-		mongo32, _ := version.NewVersion("3.2")
-		if s.mongoVersion.GreaterThanOrEqual(mongo32) {
-			for _, collectionName := range collectionNames {
-				s.collectIndexStats(ctx, now, dbName, collectionName, errs)
-			}
+		if !s.config.Databases.matches(dbName) {
+			continue
 		}
+		databaseTasks = append(databaseTasks, func() {
+			s.collectDatabase(ctx, now, dbName, errs)
+		}, func() {
+			tasks := s.collectionTasks(ctx, now, dbName, errs)
+			s.mu.Lock()
+			collectionTasks = append(collectionTasks, tasks...)
+			s.mu.Unlock()
+		})
 	}
+	runConcurrently(s.config.MaxConcurrency, databaseTasks)
+	runConcurrently(s.config.MaxConcurrency, collectionTasks)
 }
 
 func (s *mongodbScraper) collectDatabase(ctx context.Context, now pcommon.Timestamp, databaseName string, errs *scrapererror.ScrapeErrors) {
-	dbStats, err := s.client.DBStats(ctx, databaseName)
-	if err != nil {
-		errs.AddPartial(1, fmt.Errorf("failed to fetch database stats metrics: %w", err))
+	dbStats, dbStatsErr := s.client.DBStats(ctx, databaseName)
+	serverStatus, serverStatusErr := s.client.ServerStatus(ctx, databaseName)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if dbStatsErr != nil {
+		errs.AddPartial(1, fmt.Errorf("failed to fetch database stats metrics of %s: %w", databaseName, dbStatsErr))
 	} else {
 		s.recordDBStats(now, dbStats, databaseName, errs)
 	}
 
-	serverStatus, err := s.client.ServerStatus(ctx, databaseName)
-	if err != nil {
-		errs.AddPartial(1, fmt.Errorf("failed to fetch server status metrics: %w", err))
+	if serverStatusErr != nil {
+		errs.AddPartial(1, fmt.Errorf("failed to fetch server status metrics of %s: %w", databaseName, serverStatusErr))
 		return
 	}
 	s.recordNormalServerStats(now, serverStatus, databaseName, errs)
@@ -136,6 +154,36 @@ func (s *mongodbScraper) collectDatabase(ctx context.Context, now pcommon.Timest
 	s.mb.EmitForResource(metadata.WithResource(rb.Emit()))
 }
 
+// collectionTasks returns the tasks collecting the index stats of the
+// collections of the database. A failure only affects the database.
+func (s *mongodbScraper) collectionTasks(ctx context.Context, now pcommon.Timestamp, databaseName string, errs *scrapererror.ScrapeErrors) []func() {
+	// The indexStats aggregation is only available if version is >= 3.2
+	// https://www.mongodb.com/docs/v3.2/reference/operator/aggregation/indexStats/
+	mongo32, _ := version.NewVersion("3.2")
+	if !s.mongoVersion.GreaterThanOrEqual(mongo32) {
+		return nil
+	}
+
+	collectionNames, err := s.client.ListCollectionNames(ctx, databaseName)
+	if err != nil {
+		s.mu.Lock()
+		errs.AddPartial(1, fmt.Errorf("failed to fetch collection names of %s: %w", databaseName, err))
+		s.mu.Unlock()
+		return nil
+	}
+
+	var tasks []func()
+	for _, collectionName := range collectionNames {
+		if !s.config.Collections.matches(collectionName, databaseName+"."+collectionName) {
+			continue
+		}
+		tasks = append(tasks, func() {
+			s.collectIndexStats(ctx, now, databaseName, collectionName, errs)
+		})
+	}
+	return tasks
+}
+
 func (s *mongodbScraper) collectAdminDatabase(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
 	serverStatus, err := s.client.ServerStatus(ctx, "admin")
 	if err != nil {
@@ -144,6 +192,68 @@ func (s *mongodbScraper) collectAdminDatabase(ctx context.Context, now pcommon.T
 	}
 	s.recordAdminStats(now, serverStatus, errs)
 	s.mb.EmitForResource()
+
+	// Only replica set members report the repl section, and only mongos can
+	// report the state of the shards.
+	if _, ok := serverStatus["repl"]; ok {
+		s.collectReplication(ctx, now, errs)
+	}
+	if process, _ := serverStatus["process"].(string); process == "mongos" {
+		s.collectSharding(ctx, now, errs)
+	}
+}
+
+func (s *mongodbScraper) collectReplication(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if s.config.Metrics.MongodbReplsetMemberState.Enabled ||
+		s.config.Metrics.MongodbReplsetMemberHealth.Enabled ||
+		s.config.Metrics.MongodbReplsetMemberReplicationLag.Enabled {
+		replSetStatus, err := s.client.ReplSetGetStatus(ctx)
+		if err != nil {
+			errs.AddPartial(1, fmt.Errorf("failed to fetch replica set status metrics: %w", err))
+		} else {
+			s.recordReplicaSetMembers(now, replSetStatus, errs)
+		}
+	}
+
+	if s.config.Metrics.MongodbOplogSize.Enabled || s.config.Metrics.MongodbOplogMaxSize.Enabled {
+		oplogStats, err := s.client.OplogStats(ctx)
+		if err != nil {
+			errs.AddPartial(1, fmt.Errorf("failed to fetch oplog stats metrics: %w", err))
+		} else {
+			s.recordOplogStats(now, oplogStats, errs)
+		}
+	}
+
+	if s.config.Metrics.MongodbOplogWindow.Enabled {
+		window, err := s.client.OplogWindow(ctx)
+		if err != nil {
+			errs.AddPartial(1, fmt.Errorf("failed to fetch oplog window metric: %w", err))
+		} else {
+			s.mb.RecordMongodbOplogWindowDataPoint(now, int64(window/time.Second))
+		}
+	}
+	s.mb.EmitForResource()
+}
+
+func (s *mongodbScraper) collectSharding(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if s.config.Metrics.MongodbShardingBalancerEnabled.Enabled || s.config.Metrics.MongodbShardingBalancerRoundCount.Enabled {
+		balancerStatus, err := s.client.BalancerStatus(ctx)
+		if err != nil {
+			errs.AddPartial(1, fmt.Errorf("failed to fetch balancer status metrics: %w", err))
+		} else {
+			s.recordBalancerStatus(now, balancerStatus, errs)
+		}
+	}
+
+	if s.config.Metrics.MongodbShardingChunkCount.Enabled {
+		chunkCounts, err := s.client.ChunkCounts(ctx)
+		if err != nil {
+			errs.AddPartial(1, fmt.Errorf("failed to fetch chunk count metrics: %w", err))
+		} else {
+			s.recordChunkCounts(now, chunkCounts, errs)
+		}
+	}
+	s.mb.EmitForResource()
 }
 
 func (s *mongodbScraper) collectTopStats(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
@@ -158,6 +268,10 @@ func (s *mongodbScraper) collectTopStats(ctx context.Context, now pcommon.Timest
 
 func (s *mongodbScraper) collectIndexStats(ctx context.Context, now pcommon.Timestamp, databaseName string, collectionName string, errs *scrapererror.ScrapeErrors) {
 	indexStats, err := s.client.IndexStats(ctx, databaseName, collectionName)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	if err != nil {
 		errs.AddPartial(1, fmt.Errorf("failed to fetch index stats metrics: %w", err))
 		return
@@ -199,3 +313,24 @@ func (s *mongodbScraper) recordAdminStats(now pcommon.Timestamp, document bson.M
 func (s *mongodbScraper) recordIndexStats(now pcommon.Timestamp, indexStats []bson.M, databaseName string, collectionName string, errs *scrapererror.ScrapeErrors) {
 	s.recordIndexAccess(now, indexStats, databaseName, collectionName, errs)
 }
+
+// runConcurrently runs the tasks in at most size workers, and returns once
+// they have all returned.
+func runConcurrently(size int, tasks []func()) {
+	queue := make(chan func())
+	var wg sync.WaitGroup
+	for range min(max(size, 1), len(tasks)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for task := range queue {
+				task()
+			}
+		}()
+	}
+	for _, task := range tasks {
+		queue <- task
+	}
+	close(queue)
+	wg.Wait()
+}
@@ -0,0 +1,358 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mongodbreceiver // import "github.com/GoogleCloudPlatform/opentelemetry-operations-collector/components/otelopscol/receiver/mongodbreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-version"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/GoogleCloudPlatform/opentelemetry-operations-collector/components/otelopscol/receiver/mongodbreceiver/internal/metadata"
+)
+
+func TestScrapeReplicaSetMember(t *testing.T) {
+	optime := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	fc := newBaseFakeClient(bson.M{"process": "mongod", "repl": bson.M{"setName": "rs0"}})
+	fc.On("ReplSetGetStatus", mock.Anything).Return(bson.M{
+		"set": "rs0",
+		"members": bson.A{
+			bson.M{"name": "mongo-0:27017", "state": int32(1), "health": float64(1), "optimeDate": primitive.NewDateTimeFromTime(optime)},
+			bson.M{"name": "mongo-1:27017", "state": int32(2), "health": float64(1), "optimeDate": primitive.NewDateTimeFromTime(optime.Add(-1500 * time.Millisecond))},
+			bson.M{"name": "mongo-2:27017", "state": int32(7), "health": float64(0)},
+		},
+	}, nil)
+	fc.On("OplogStats", mock.Anything).Return(bson.M{"size": int32(1024), "maxSize": int64(1 << 30)}, nil)
+	fc.On("OplogWindow", mock.Anything).Return(36*time.Hour, nil)
+
+	metrics := scrapeWithFakeClient(t, fc)
+
+	require.Equal(t, map[string]int64{
+		"mongo-0:27017": 1,
+		"mongo-1:27017": 2,
+		"mongo-2:27017": 7,
+	}, intValuesByAttribute(t, metrics, "mongodb.replset.member.state", "member"))
+	require.Equal(t, map[string]int64{
+		"mongo-0:27017": 1,
+		"mongo-1:27017": 1,
+		"mongo-2:27017": 0,
+	}, intValuesByAttribute(t, metrics, "mongodb.replset.member.health", "member"))
+	require.Equal(t, map[string]int64{
+		"mongo-0:27017": 0,
+		"mongo-1:27017": 1500,
+	}, intValuesByAttribute(t, metrics, "mongodb.replset.member.replication_lag", "member"))
+	require.Equal(t, map[string]int64{"rs0": 2}, intValuesByAttribute(t, metrics, "mongodb.replset.member.health", "replica_set"))
+	require.Equal(t, map[string]int64{"": 1024}, intValuesByAttribute(t, metrics, "mongodb.oplog.size", ""))
+	require.Equal(t, map[string]int64{"": 1 << 30}, intValuesByAttribute(t, metrics, "mongodb.oplog.max_size", ""))
+	require.Equal(t, map[string]int64{"": 36 * 60 * 60}, intValuesByAttribute(t, metrics, "mongodb.oplog.window", ""))
+	require.Empty(t, intValuesByAttribute(t, metrics, "mongodb.sharding.balancer.enabled", ""))
+	fc.AssertNotCalled(t, "BalancerStatus", mock.Anything)
+}
+
+func TestScrapeReplicaSetStatusError(t *testing.T) {
+	fc := newBaseFakeClient(bson.M{"process": "mongod", "repl": bson.M{"setName": "rs0"}})
+	fc.On("ReplSetGetStatus", mock.Anything).Return(bson.M{}, errors.New("not authorized"))
+	fc.On("OplogStats", mock.Anything).Return(bson.M{"size": int32(1024), "maxSize": int64(1 << 30)}, nil)
+	fc.On("OplogWindow", mock.Anything).Return(time.Duration(0), errors.New("oplog is empty"))
+
+	scraper := newMongodbScraper(receivertest.NewNopSettings(metadata.Type), createDefaultConfig().(*Config))
+	scraper.client = fc
+
+	metrics, err := scraper.scrape(context.Background())
+	require.ErrorContains(t, err, "failed to fetch replica set status metrics: not authorized")
+	require.ErrorContains(t, err, "failed to fetch oplog window metric: oplog is empty")
+	require.Equal(t, map[string]int64{"": 1024}, intValuesByAttribute(t, metrics, "mongodb.oplog.size", ""))
+}
+
+func TestScrapeReplicaSetMetricsDisabled(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.MongodbReplsetMemberState.Enabled = false
+	cfg.Metrics.MongodbReplsetMemberHealth.Enabled = false
+	cfg.Metrics.MongodbReplsetMemberReplicationLag.Enabled = false
+
+	fc := newBaseFakeClient(bson.M{"process": "mongod", "repl": bson.M{"setName": "rs0"}})
+	fc.On("OplogStats", mock.Anything).Return(bson.M{"size": int32(1024), "maxSize": int64(1 << 30)}, nil)
+	fc.On("OplogWindow", mock.Anything).Return(36*time.Hour, nil)
+
+	scraper := newMongodbScraper(receivertest.NewNopSettings(metadata.Type), cfg)
+	scraper.client = fc
+	metrics, _ := scraper.scrape(context.Background())
+
+	require.Equal(t, map[string]int64{"": 1024}, intValuesByAttribute(t, metrics, "mongodb.oplog.size", ""))
+	fc.AssertNotCalled(t, "ReplSetGetStatus", mock.Anything)
+}
+
+func TestScrapeStandalone(t *testing.T) {
+	fc := newBaseFakeClient(bson.M{"process": "mongod"})
+
+	metrics := scrapeWithFakeClient(t, fc)
+
+	require.Empty(t, intValuesByAttribute(t, metrics, "mongodb.replset.member.state", "member"))
+	fc.AssertNotCalled(t, "ReplSetGetStatus", mock.Anything)
+	fc.AssertNotCalled(t, "BalancerStatus", mock.Anything)
+}
+
+func TestScrapeMongos(t *testing.T) {
+	fc := newBaseFakeClient(bson.M{"process": "mongos"})
+	fc.On("BalancerStatus", mock.Anything).Return(bson.M{"mode": "full", "inBalancerRound": false, "numBalancerRounds": int64(42)}, nil)
+	fc.On("ChunkCounts", mock.Anything).Return([]bson.M{
+		{"_id": "shard01", "count": int32(12)},
+		{"_id": "shard02", "count": int32(9)},
+	}, nil)
+
+	metrics := scrapeWithFakeClient(t, fc)
+
+	require.Equal(t, map[string]int64{"": 1}, intValuesByAttribute(t, metrics, "mongodb.sharding.balancer.enabled", ""))
+	require.Equal(t, map[string]int64{"": 42}, intValuesByAttribute(t, metrics, "mongodb.sharding.balancer.round.count", ""))
+	require.Equal(t, map[string]int64{
+		"shard01": 12,
+		"shard02": 9,
+	}, intValuesByAttribute(t, metrics, "mongodb.sharding.chunk.count", "shard"))
+	fc.AssertNotCalled(t, "ReplSetGetStatus", mock.Anything)
+}
+
+func TestScrapeMongosBalancerOff(t *testing.T) {
+	fc := newBaseFakeClient(bson.M{"process": "mongos"})
+	fc.On("BalancerStatus", mock.Anything).Return(bson.M{"mode": "off", "numBalancerRounds": int64(0)}, nil)
+	fc.On("ChunkCounts", mock.Anything).Return([]bson.M{}, nil)
+
+	metrics := scrapeWithFakeClient(t, fc)
+
+	require.Equal(t, map[string]int64{"": 0}, intValuesByAttribute(t, metrics, "mongodb.sharding.balancer.enabled", ""))
+}
+
+func TestScrapeMongosBalancerMetricsDisabled(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.MongodbShardingBalancerEnabled.Enabled = false
+	cfg.Metrics.MongodbShardingBalancerRoundCount.Enabled = false
+
+	fc := newBaseFakeClient(bson.M{"process": "mongos"})
+	fc.On("ChunkCounts", mock.Anything).Return([]bson.M{{"_id": "shard01", "count": int32(12)}}, nil)
+
+	scraper := newMongodbScraper(receivertest.NewNopSettings(metadata.Type), cfg)
+	scraper.client = fc
+	metrics, _ := scraper.scrape(context.Background())
+
+	require.Equal(t, map[string]int64{"shard01": 12}, intValuesByAttribute(t, metrics, "mongodb.sharding.chunk.count", "shard"))
+	fc.AssertNotCalled(t, "BalancerStatus", mock.Anything)
+}
+
+// newBaseFakeClient returns a fake client of a server with no databases,
+// whose admin serverStatus is adminStatus.
+func newBaseFakeClient(adminStatus bson.M) *fakeClient {
+	mongo44, _ := version.NewVersion("4.4")
+	fc := &fakeClient{}
+	fc.On("GetVersion", mock.Anything).Return(mongo44, nil)
+	fc.On("ListDatabaseNames", mock.Anything, mock.Anything, mock.Anything).Return([]string{}, nil)
+	fc.On("ServerStatus", mock.Anything, "admin").Return(adminStatus, nil)
+	fc.On("TopStats", mock.Anything).Return(bson.M{}, nil)
+	return fc
+}
+
+// scrapeWithFakeClient scrapes fc, ignoring the partial errors of the
+// serverStatus and top metrics that the fake doesn't report.
+func scrapeWithFakeClient(t *testing.T, fc *fakeClient) pmetric.Metrics {
+	scraper := newMongodbScraper(receivertest.NewNopSettings(metadata.Type), createDefaultConfig().(*Config))
+	scraper.client = fc
+
+	metrics, _ := scraper.scrape(context.Background())
+	return metrics
+}
+
+// intValuesByAttribute returns the values of the data points of the metric by
+// the value of the attribute, summing those with the same value.
+func intValuesByAttribute(t *testing.T, metrics pmetric.Metrics, metricName, attribute string) map[string]int64 {
+	values := map[string]int64{}
+	rms := metrics.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			ms := sms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				m := ms.At(k)
+				if m.Name() != metricName {
+					continue
+				}
+				var dps pmetric.NumberDataPointSlice
+				switch m.Type() {
+				case pmetric.MetricTypeGauge:
+					dps = m.Gauge().DataPoints()
+				case pmetric.MetricTypeSum:
+					dps = m.Sum().DataPoints()
+				default:
+					t.Fatalf("unexpected type %v of metric %s", m.Type(), metricName)
+				}
+				for l := 0; l < dps.Len(); l++ {
+					key := ""
+					if attribute != "" {
+						v, ok := dps.At(l).Attributes().Get(attribute)
+						require.True(t, ok, "metric %s has no attribute %s", metricName, attribute)
+						key = v.Str()
+					}
+					values[key] += dps.At(l).IntValue()
+				}
+			}
+		}
+	}
+	return values
+}
+
+func TestScrapeFiltersDatabasesAndCollections(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Databases = FilterConfig{Include: []string{"app*", "logs"}, Exclude: []string{"app_test"}}
+	cfg.Collections = FilterConfig{Exclude: []string{"system.*", "logs.archive"}}
+
+	fc := newDatabasesFakeClient(map[string][]string{
+		"admin":    {"system.users"},
+		"app":      {"orders", "system.profile"},
+		"app_test": {"orders"},
+		"logs":     {"archive", "events"},
+	})
+
+	scraper := newMongodbScraper(receivertest.NewNopSettings(metadata.Type), cfg)
+	scraper.client = fc
+	_, _ = scraper.scrape(context.Background())
+
+	fc.AssertCalled(t, "DBStats", mock.Anything, "app")
+	fc.AssertCalled(t, "DBStats", mock.Anything, "logs")
+	fc.AssertNotCalled(t, "DBStats", mock.Anything, "admin")
+	fc.AssertNotCalled(t, "DBStats", mock.Anything, "app_test")
+	fc.AssertNotCalled(t, "ListCollectionNames", mock.Anything, "app_test")
+	fc.AssertCalled(t, "IndexStats", mock.Anything, "app", "orders")
+	fc.AssertCalled(t, "IndexStats", mock.Anything, "logs", "events")
+	fc.AssertNotCalled(t, "IndexStats", mock.Anything, "app", "system.profile")
+	fc.AssertNotCalled(t, "IndexStats", mock.Anything, "logs", "archive")
+	fc.AssertNumberOfCalls(t, "IndexStats", 2)
+}
+
+func TestScrapeIsolatesDatabaseErrors(t *testing.T) {
+	mongo44, _ := version.NewVersion("4.4")
+	fc := &fakeClient{}
+	fc.On("GetVersion", mock.Anything).Return(mongo44, nil)
+	fc.On("ListDatabaseNames", mock.Anything, mock.Anything, mock.Anything).Return([]string{"broken", "orders"}, nil)
+	fc.On("ServerStatus", mock.Anything, mock.Anything).Return(bson.M{}, nil)
+	fc.On("TopStats", mock.Anything).Return(bson.M{}, nil)
+	fc.On("DBStats", mock.Anything, mock.Anything).Return(bson.M{}, nil)
+	fc.On("ListCollectionNames", mock.Anything, "broken").Return([]string(nil), errors.New("not authorized"))
+	fc.On("ListCollectionNames", mock.Anything, "orders").Return([]string{"products"}, nil)
+	fc.On("IndexStats", mock.Anything, "orders", "products").Return([]bson.M{
+		{"accesses": bson.M{"ops": int64(5)}},
+	}, nil)
+
+	scraper := newMongodbScraper(receivertest.NewNopSettings(metadata.Type), createDefaultConfig().(*Config))
+	scraper.client = fc
+
+	metrics, err := scraper.scrape(context.Background())
+	require.ErrorContains(t, err, "failed to fetch collection names of broken: not authorized")
+	require.Equal(t, map[string]int64{"products": 5}, intValuesByAttribute(t, metrics, "mongodb.index.access.count", "collection"))
+}
+
+func TestScrapeBoundsConcurrency(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.MaxConcurrency = 2
+
+	collections := make([]string, 20)
+	for i := range collections {
+		collections[i] = fmt.Sprintf("collection%d", i)
+	}
+	fc := newDatabasesFakeClient(map[string][]string{"db1": collections, "db2": collections})
+
+	var running, maxRunning atomic.Int32
+	fc.ExpectedCalls = slices.DeleteFunc(fc.ExpectedCalls, func(c *mock.Call) bool { return c.Method == "IndexStats" })
+	fc.On("IndexStats", mock.Anything, mock.Anything, mock.Anything).Run(func(mock.Arguments) {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			m := maxRunning.Load()
+			if n <= m || maxRunning.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+	}).Return([]bson.M{}, nil)
+
+	scraper := newMongodbScraper(receivertest.NewNopSettings(metadata.Type), cfg)
+	scraper.client = fc
+	_, _ = scraper.scrape(context.Background())
+
+	fc.AssertNumberOfCalls(t, "IndexStats", 40)
+	require.LessOrEqual(t, maxRunning.Load(), int32(2))
+}
+
+func TestScrapeTimeout(t *testing.T) {
+	tests := []struct {
+		name          string
+		scrapeTimeout time.Duration
+		expected      time.Duration
+	}{
+		{name: "scrape timeout", scrapeTimeout: 10 * time.Second, expected: 10 * time.Second},
+		{name: "collection interval", expected: time.Minute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			// The timeout of the commands doesn't bound the scrape.
+			cfg.Timeout = time.Hour
+			cfg.ScrapeTimeout = tt.scrapeTimeout
+
+			fc := newDatabasesFakeClient(map[string][]string{"app": {"orders"}})
+			fc.ExpectedCalls = slices.DeleteFunc(fc.ExpectedCalls, func(c *mock.Call) bool { return c.Method == "DBStats" })
+			fc.On("DBStats", mock.Anything, "app").Run(func(args mock.Arguments) {
+				// This runs in a worker goroutine, where require can't stop the test.
+				deadline, ok := args.Get(0).(context.Context).Deadline()
+				assert.True(t, ok)
+				assert.WithinDuration(t, time.Now().Add(tt.expected), deadline, time.Second)
+			}).Return(bson.M{}, nil)
+
+			scraper := newMongodbScraper(receivertest.NewNopSettings(metadata.Type), cfg)
+			scraper.client = fc
+			_, _ = scraper.scrape(context.Background())
+
+			fc.AssertCalled(t, "DBStats", mock.Anything, "app")
+		})
+	}
+}
+
+// newDatabasesFakeClient returns a fake client of a standalone server with
+// the databases and their collections, that have no indexes.
+func newDatabasesFakeClient(databases map[string][]string) *fakeClient {
+	mongo44, _ := version.NewVersion("4.4")
+	fc := &fakeClient{}
+	fc.On("GetVersion", mock.Anything).Return(mongo44, nil)
+	dbNames := make([]string, 0, len(databases))
+	for dbName, collectionNames := range databases {
+		dbNames = append(dbNames, dbName)
+		fc.On("ListCollectionNames", mock.Anything, dbName).Return(collectionNames, nil)
+	}
+	fc.On("ListDatabaseNames", mock.Anything, mock.Anything, mock.Anything).Return(dbNames, nil)
+	fc.On("ServerStatus", mock.Anything, mock.Anything).Return(bson.M{"process": "mongod"}, nil)
+	fc.On("TopStats", mock.Anything).Return(bson.M{}, nil)
+	fc.On("DBStats", mock.Anything, mock.Anything).Return(bson.M{}, nil)
+	fc.On("IndexStats", mock.Anything, mock.Anything, mock.Anything).Return([]bson.M{}, nil)
+	return fc
+}
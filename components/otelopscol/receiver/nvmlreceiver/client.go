@@ -19,6 +19,7 @@ package nvmlreceiver
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -32,21 +33,51 @@ const maxWarningsForFailedDeviceMetricQuery = 5
 
 type nvmlClient struct {
 	logger                         *zap.SugaredLogger
+	config                         *Config
 	disable                        bool
 	handleCleanup                  func() error
-	devices                        []nvml.Device
-	devicesModelName               []string
-	devicesUUID                    []string
+	devices                        []nvmlDevice
 	deviceToLastSeenTimestamp      map[nvml.Device]uint64
 	deviceMetricToFailedQueryCount map[string]uint64
 	deviceToAccountingIsEnabled    map[nvml.Device]bool
+	lostDevices                    map[nvml.Device]bool
+	knownDevices                   map[string]knownDevice
+	lastDiscovery                  time.Time
+}
+
+// nvmlDevice is a discovered device. gpuIndex is its NVML index, which it
+// keeps when other devices are lost.
+type nvmlDevice struct {
+	handle   nvml.Device
+	gpuIndex uint
+	UUID     string
+	model    string
+}
+
+// knownDevice is a device that was discovered at some point, by UUID, and
+// whether it is still available.
+type knownDevice struct {
+	gpuIndex  uint
+	model     string
+	available bool
+}
+
+type deviceAvailability struct {
+	time      time.Time
+	gpuIndex  uint
+	model     string
+	UUID      string
+	available bool
 }
 
 type deviceMetric struct {
 	time     time.Time
 	gpuIndex uint
 	name     string
-	value    [8]byte
+	// attribute is the value of the metric attribute, for metrics that have
+	// one, e.g. the clock type of nvml.gpu.clock.frequency.
+	attribute string
+	value     [8]byte
 }
 
 type processMetric struct {
@@ -61,49 +92,173 @@ type processMetric struct {
 	owner                  string
 }
 
-// calling nvml.Init() twice causes an unnecessary error (also wrap here for mocking)
-var once sync.Once
-var nvmlInitReturn nvml.Return
+// calling nvml.Init() twice causes an unnecessary error, so it is only called
+// again if it failed, e.g. before the driver was installed (also wrap here for
+// mocking)
+var nvmlInitMutex sync.Mutex
+var nvmlInitialized bool
 var nvmlInit = func() nvml.Return {
-	once.Do(func() {
-		nvmlInitReturn = nvml.Init()
-	})
-	return nvmlInitReturn
+	nvmlInitMutex.Lock()
+	defer nvmlInitMutex.Unlock()
+	if nvmlInitialized {
+		return nvml.SUCCESS
+	}
+	ret := nvml.Init()
+	nvmlInitialized = ret == nvml.SUCCESS
+	return ret
 }
 
+var nvmlDeviceGetCount = nvml.DeviceGetCount
+var nvmlDeviceGetHandleByIndex = nvml.DeviceGetHandleByIndex
+
 var nvmlDeviceGetSamples = nvml.DeviceGetSamples
 var nvmlDeviceGetMemoryInfo = nvml.DeviceGetMemoryInfo
 var nvmlDeviceSetAccountingMode = nvml.DeviceSetAccountingMode
 var nvmlDeviceGetAccountingPids = nvml.DeviceGetAccountingPids
+var nvmlDeviceGetPowerUsage = nvml.DeviceGetPowerUsage
+var nvmlDeviceGetTotalEnergyConsumption = nvml.DeviceGetTotalEnergyConsumption
+var nvmlDeviceGetTemperature = nvml.DeviceGetTemperature
+var nvmlDeviceGetClockInfo = nvml.DeviceGetClockInfo
+var nvmlDeviceGetTotalEccErrors = nvml.DeviceGetTotalEccErrors
+var nvmlDeviceGetPcieThroughput = nvml.DeviceGetPcieThroughput
+var nvmlDeviceGetFanSpeed = nvml.DeviceGetFanSpeed
+var nvmlDeviceGetCurrentClocksThrottleReasons = nvml.DeviceGetCurrentClocksThrottleReasons
+
+// clockThrottleReasons are the throttle_reason attribute values of the NVML
+// clock throttle reason bits.
+var clockThrottleReasons = []struct {
+	mask   uint64
+	reason string
+}{
+	{nvml.ClocksThrottleReasonGpuIdle, "gpu_idle"},
+	{nvml.ClocksThrottleReasonApplicationsClocksSetting, "applications_clocks_setting"},
+	{nvml.ClocksThrottleReasonSwPowerCap, "sw_power_cap"},
+	{nvml.ClocksThrottleReasonHwSlowdown, "hw_slowdown"},
+	{nvml.ClocksThrottleReasonSyncBoost, "sync_boost"},
+	{nvml.ClocksThrottleReasonSwThermalSlowdown, "sw_thermal_slowdown"},
+	{nvml.ClocksThrottleReasonHwThermalSlowdown, "hw_thermal_slowdown"},
+	{nvml.ClocksThrottleReasonHwPowerBrakeSlowdown, "hw_power_brake_slowdown"},
+	{nvml.ClocksThrottleReasonDisplayClockSetting, "display_clock_setting"},
+}
 
 func newClient(config *Config, logger *zap.Logger) (*nvmlClient, error) {
-	nvmlCleanup, err := initializeNvml(logger)
-	if err != nil {
-		logger.Sugar().Warnf("Unable to find and/or initialize Nvidia Management Library on '%w'. No Nvidia device metrics will be collected.", err)
-		return &nvmlClient{logger: logger.Sugar(), disable: true}, nil
+	client := &nvmlClient{
+		logger:                         logger.Sugar(),
+		config:                         config,
+		disable:                        true,
+		deviceToLastSeenTimestamp:      make(map[nvml.Device]uint64),
+		deviceMetricToFailedQueryCount: make(map[string]uint64),
+		deviceToAccountingIsEnabled:    make(map[nvml.Device]bool),
+		lostDevices:                    make(map[nvml.Device]bool),
+		knownDevices:                   make(map[string]knownDevice),
+	}
+	client.discover(time.Now())
+	return client, nil
+}
+
+// discover initializes NVML if it isn't yet, and enumerates the devices.
+// Failures are logged and retried at the next discovery.
+func (client *nvmlClient) discover(now time.Time) {
+	firstDiscovery := client.lastDiscovery.IsZero()
+	client.lastDiscovery = now
+
+	if client.disable {
+		nvmlCleanup, err := initializeNvml(client.logger.Desugar())
+		if err != nil {
+			if firstDiscovery {
+				client.logger.Warnf("Unable to find and/or initialize Nvidia Management Library on '%v'. No Nvidia device metrics will be collected until it is.", err)
+			} else {
+				client.logger.Debugf("Unable to find and/or initialize Nvidia Management Library on '%v'.", err)
+			}
+			return
+		}
+		client.disable = false
+		client.handleCleanup = nvmlCleanup
 	}
 
-	devices, names, UUIDs, err := discoverDevices(logger)
+	devices, err := discoverDevices(client.logger.Desugar())
 	if err != nil {
-		return nil, err
+		client.logger.Warnf("%v; will retry at the next discovery.", err)
 	}
+	client.setDevices(devices)
+}
 
-	var deviceToAccountingIsEnabled map[nvml.Device]bool
-	if config.Metrics.NvmlGpuProcessesUtilization.Enabled || config.Metrics.NvmlGpuProcessesMaxBytesUsed.Enabled {
-		deviceToAccountingIsEnabled = enableProcessAccountingModeOnSupportingDevices(logger, devices)
+// discoverIfDue calls discover if the discovery interval has elapsed since the
+// last discovery.
+func (client *nvmlClient) discoverIfDue(now time.Time) {
+	if client.config.DiscoveryInterval <= 0 || now.Sub(client.lastDiscovery) < client.config.DiscoveryInterval {
+		return
 	}
+	client.discover(now)
+}
 
-	return &nvmlClient{
-		logger:                         logger.Sugar(),
-		disable:                        false,
-		handleCleanup:                  nvmlCleanup,
-		devices:                        devices,
-		devicesModelName:               names,
-		devicesUUID:                    UUIDs,
-		deviceToLastSeenTimestamp:      make(map[nvml.Device]uint64),
-		deviceMetricToFailedQueryCount: make(map[string]uint64),
-		deviceToAccountingIsEnabled:    deviceToAccountingIsEnabled,
-	}, nil
+// setDevices replaces the devices of the client. The per-device state of the
+// devices that were already known is kept, by UUID, and process accounting is
+// enabled on the new ones.
+func (client *nvmlClient) setDevices(devices []nvmlDevice) {
+	previousDevices := make(map[string]nvml.Device, len(client.devices))
+	for _, device := range client.devices {
+		previousDevices[device.UUID] = device.handle
+	}
+
+	deviceToLastSeenTimestamp := make(map[nvml.Device]uint64, len(devices))
+	deviceToAccountingIsEnabled := make(map[nvml.Device]bool, len(devices))
+	newDevices := make([]nvmlDevice, 0, len(devices))
+	for _, device := range devices {
+		previous, ok := previousDevices[device.UUID]
+		if !ok {
+			newDevices = append(newDevices, device)
+			continue
+		}
+		if timestamp, ok := client.deviceToLastSeenTimestamp[previous]; ok {
+			deviceToLastSeenTimestamp[device.handle] = timestamp
+		}
+		if enabled, ok := client.deviceToAccountingIsEnabled[previous]; ok {
+			deviceToAccountingIsEnabled[device.handle] = enabled
+		}
+	}
+
+	if len(newDevices) > 0 && (client.config.Metrics.NvmlGpuProcessesUtilization.Enabled || client.config.Metrics.NvmlGpuProcessesMaxBytesUsed.Enabled) {
+		for device, enabled := range enableProcessAccountingModeOnSupportingDevices(client.logger.Desugar(), newDevices) {
+			deviceToAccountingIsEnabled[device] = enabled
+		}
+	}
+
+	client.devices = devices
+	client.deviceToLastSeenTimestamp = deviceToLastSeenTimestamp
+	client.deviceToAccountingIsEnabled = deviceToAccountingIsEnabled
+	client.lostDevices = make(map[nvml.Device]bool)
+
+	available := make(map[string]bool, len(devices))
+	for _, device := range devices {
+		available[device.UUID] = true
+		client.knownDevices[device.UUID] = knownDevice{gpuIndex: device.gpuIndex, model: device.model, available: true}
+	}
+	for UUID, known := range client.knownDevices {
+		if !available[UUID] && known.available {
+			client.logger.Warnf("Nvidia device %d with UUID %s is no longer available.", known.gpuIndex, UUID)
+			known.available = false
+			client.knownDevices[UUID] = known
+		}
+	}
+}
+
+// dropLostDevices removes the devices that returned ERROR_GPU_IS_LOST from
+// the client.
+func (client *nvmlClient) dropLostDevices() {
+	if len(client.lostDevices) == 0 {
+		return
+	}
+
+	devices := make([]nvmlDevice, 0, len(client.devices))
+	for _, device := range client.devices {
+		if client.lostDevices[device.handle] {
+			client.logger.Errorf("Nvidia device %d with UUID %s is lost; no more metrics will be collected for it.", device.gpuIndex, device.UUID)
+			continue
+		}
+		devices = append(devices, device)
+	}
+	client.setDevices(devices)
 }
 
 func initializeNvml(logger *zap.Logger) (nvmlCleanup func() error, err error) {
@@ -157,17 +312,15 @@ func printNvmlAndDriverVersion(logger *zap.Logger) {
 	logger.Sugar().Infof("NVIDIA driver version is %s", driverVersion)
 }
 
-func discoverDevices(logger *zap.Logger) ([]nvml.Device, []string, []string, error) {
-	count, ret := nvml.DeviceGetCount()
+func discoverDevices(logger *zap.Logger) ([]nvmlDevice, error) {
+	count, ret := nvmlDeviceGetCount()
 	if ret != nvml.SUCCESS {
-		return nil, nil, nil, fmt.Errorf("Unable to get Nvidia device count on '%v'", nvml.ErrorString(ret))
+		return nil, fmt.Errorf("Unable to get Nvidia device count on '%v'", nvml.ErrorString(ret))
 	}
 
-	devices := make([]nvml.Device, 0, count)
-	names := make([]string, 0, count)
-	UUIDs := make([]string, 0, count)
+	devices := make([]nvmlDevice, 0, count)
 	for i := 0; i < count; i++ {
-		device, ret := nvml.DeviceGetHandleByIndex(i)
+		device, ret := nvmlDeviceGetHandleByIndex(i)
 		if ret != nvml.SUCCESS {
 			logger.Sugar().Warnf("Unable to get Nvidia device at index %d on '%v'; ignoring device.", i, nvml.ErrorString(ret))
 			continue
@@ -186,9 +339,7 @@ func discoverDevices(logger *zap.Logger) ([]nvml.Device, []string, []string, err
 			continue
 		}
 
-		devices = append(devices, device)
-		UUIDs = append(UUIDs, UUID)
-		names = append(names, name)
+		devices = append(devices, nvmlDevice{handle: device, gpuIndex: uint(i), UUID: UUID, model: name})
 		logger.Sugar().Infof("Discovered Nvidia device %d of model %s with UUID %s.", i, name, UUID)
 
 		currMode, _, ret := device.GetMigMode()
@@ -202,26 +353,26 @@ func discoverDevices(logger *zap.Logger) ([]nvml.Device, []string, []string, err
 	}
 
 	if len(devices) == 0 {
-		return nil, nil, nil, fmt.Errorf("No supported NVIDIA devices found")
+		return nil, fmt.Errorf("No supported NVIDIA devices found")
 	}
 
-	return devices, names, UUIDs, nil
+	return devices, nil
 }
 
-func enableProcessAccountingModeOnSupportingDevices(logger *zap.Logger, devices []nvml.Device) map[nvml.Device]bool {
+func enableProcessAccountingModeOnSupportingDevices(logger *zap.Logger, devices []nvmlDevice) map[nvml.Device]bool {
 	deviceToAccountingIsEnabled := make(map[nvml.Device]bool, len(devices))
 
 	enableCount := 0
-	for gpuIndex, device := range devices {
-		ret := nvmlDeviceSetAccountingMode(device, nvml.FEATURE_ENABLED)
+	for _, device := range devices {
+		ret := nvmlDeviceSetAccountingMode(device.handle, nvml.FEATURE_ENABLED)
 		if ret != nvml.SUCCESS {
-			logger.Sugar().Warnf("Unable to set process accounting mode for Nvidia device %d on '%s'.", gpuIndex, nvml.ErrorString(ret))
-			deviceToAccountingIsEnabled[device] = false
+			logger.Sugar().Warnf("Unable to set process accounting mode for Nvidia device %d on '%s'.", device.gpuIndex, nvml.ErrorString(ret))
+			deviceToAccountingIsEnabled[device.handle] = false
 			continue
 		}
 
-		logger.Sugar().Infof("Successfully enabled process accounting mode for Nvidia device %d.", gpuIndex)
-		deviceToAccountingIsEnabled[device] = true
+		logger.Sugar().Infof("Successfully enabled process accounting mode for Nvidia device %d.", device.gpuIndex)
+		deviceToAccountingIsEnabled[device.handle] = true
 		enableCount++
 	}
 
@@ -246,42 +397,110 @@ func (client *nvmlClient) cleanup() error {
 	return nil
 }
 
+// getDevice returns the device of the NVML index gpuIndex.
+func (client *nvmlClient) getDevice(gpuIndex uint) nvmlDevice {
+	for _, device := range client.devices {
+		if device.gpuIndex == gpuIndex {
+			return device
+		}
+	}
+	return nvmlDevice{gpuIndex: gpuIndex}
+}
+
 func (client *nvmlClient) getDeviceModelName(gpuIndex uint) string {
-	return client.devicesModelName[gpuIndex]
+	return client.getDevice(gpuIndex).model
 }
 
 func (client *nvmlClient) getDeviceUUID(gpuIndex uint) string {
-	return client.devicesUUID[gpuIndex]
+	return client.getDevice(gpuIndex).UUID
 }
 
 func (client *nvmlClient) collectDeviceMetrics() ([]deviceMetric, error) {
+	// Devices lost during the last collection are dropped now, rather than
+	// right after it, so that its metrics can still be attributed to them.
+	client.dropLostDevices()
+	client.discoverIfDue(time.Now())
+
 	// not strictly needed since len(client.devices) = 0; but, safer
 	if client.disable {
 		return nil, nil
 	}
 
-	deviceMetrics := client.collectDeviceUtilization()
-	deviceMetrics = append(deviceMetrics, client.collectDeviceMemoryInfo()...)
+	// Only the enabled metrics are queried, since some queries are slow,
+	// e.g. the PCIe throughput is sampled over 20ms per device and direction.
+	metrics := client.config.Metrics
+	var deviceMetrics []deviceMetric
+	if metrics.NvmlGpuUtilization.Enabled {
+		deviceMetrics = append(deviceMetrics, client.collectDeviceUtilization()...)
+	}
+	if metrics.NvmlGpuMemoryBytesUsed.Enabled {
+		deviceMetrics = append(deviceMetrics, client.collectDeviceMemoryInfo()...)
+	}
+	if metrics.NvmlGpuPowerUsage.Enabled || metrics.NvmlGpuEnergyConsumption.Enabled {
+		deviceMetrics = append(deviceMetrics, client.collectDevicePower()...)
+	}
+	if metrics.NvmlGpuTemperature.Enabled {
+		deviceMetrics = append(deviceMetrics, client.collectDeviceTemperature()...)
+	}
+	if metrics.NvmlGpuClockFrequency.Enabled {
+		deviceMetrics = append(deviceMetrics, client.collectDeviceClocks()...)
+	}
+	if metrics.NvmlGpuEccErrors.Enabled {
+		deviceMetrics = append(deviceMetrics, client.collectDeviceEccErrors()...)
+	}
+	if metrics.NvmlGpuPcieThroughput.Enabled {
+		deviceMetrics = append(deviceMetrics, client.collectDevicePcieThroughput()...)
+	}
+	if metrics.NvmlGpuFanSpeed.Enabled {
+		deviceMetrics = append(deviceMetrics, client.collectDeviceFanSpeed()...)
+	}
+	if metrics.NvmlGpuClockThrottleReason.Enabled {
+		deviceMetrics = append(deviceMetrics, client.collectDeviceClockThrottleReasons()...)
+	}
 	return deviceMetrics, nil
 }
 
+// collectDeviceAvailability returns the availability of all the devices that
+// were discovered so far, ordered by UUID.
+func (client *nvmlClient) collectDeviceAvailability() []deviceAvailability {
+	UUIDs := make([]string, 0, len(client.knownDevices))
+	for UUID := range client.knownDevices {
+		UUIDs = append(UUIDs, UUID)
+	}
+	sort.Strings(UUIDs)
+
+	now := time.Now()
+	availability := make([]deviceAvailability, 0, len(UUIDs))
+	for _, UUID := range UUIDs {
+		known := client.knownDevices[UUID]
+		availability = append(availability, deviceAvailability{
+			time:      now,
+			gpuIndex:  known.gpuIndex,
+			model:     known.model,
+			UUID:      UUID,
+			available: known.available,
+		})
+	}
+	return availability
+}
+
 func (client *nvmlClient) collectDeviceUtilization() []deviceMetric {
 	deviceMetrics := make([]deviceMetric, 0, len(client.devices))
 
 	gpuUtil := deviceMetric{name: "nvml.gpu.utilization"}
 
-	for gpuIndex, device := range client.devices {
-		mean, err := client.getAverageGpuUtilizationSinceLastQuery(device)
+	for _, device := range client.devices {
+		mean, err := client.getAverageGpuUtilizationSinceLastQuery(device.handle)
 		if err != nil {
-			client.issueWarningForFailedQueryUptoThreshold(gpuIndex, gpuUtil.name, err.Error())
+			client.issueWarningForFailedQueryUptoThreshold(int(device.gpuIndex), gpuUtil.name, err.Error())
 			continue
 		}
 
-		gpuUtil.gpuIndex = uint(gpuIndex)
+		gpuUtil.gpuIndex = device.gpuIndex
 		gpuUtil.time = time.Now()
 		gpuUtil.setFloat64(mean)
 		deviceMetrics = append(deviceMetrics, gpuUtil)
-		client.logger.Debugf("Nvidia device %d has GPU utilization of %.1f%%", gpuIndex, 100.0*gpuUtil.asFloat64())
+		client.logger.Debugf("Nvidia device %d has GPU utilization of %.1f%%", device.gpuIndex, 100.0*gpuUtil.asFloat64())
 	}
 
 	return deviceMetrics
@@ -290,6 +509,9 @@ func (client *nvmlClient) collectDeviceUtilization() []deviceMetric {
 func (client *nvmlClient) getAverageGpuUtilizationSinceLastQuery(device nvml.Device) (float64, error) {
 	nvmlType, samples, ret := nvmlDeviceGetSamples(device, nvml.GPU_UTILIZATION_SAMPLES, client.deviceToLastSeenTimestamp[device])
 	if ret != nvml.SUCCESS {
+		if ret == nvml.ERROR_GPU_IS_LOST {
+			client.lostDevices[device] = true
+		}
 		return 0.0, fmt.Errorf("%v", nvml.ErrorString(ret))
 	}
 
@@ -327,25 +549,222 @@ func (client *nvmlClient) collectDeviceMemoryInfo() []deviceMetric {
 	gpuMemUsed := deviceMetric{name: "nvml.gpu.memory.bytes_used"}
 	gpuMemFree := deviceMetric{name: "nvml.gpu.memory.bytes_free"}
 
-	for gpuIndex, device := range client.devices {
-		memInfo, ret := nvmlDeviceGetMemoryInfo(device)
+	for _, device := range client.devices {
+		memInfo, ret := nvmlDeviceGetMemoryInfo(device.handle)
 		timestamp := time.Now()
 		if ret != nvml.SUCCESS {
-			client.issueWarningForFailedQueryUptoThreshold(gpuIndex, gpuMemUsed.name, nvml.ErrorString(ret))
+			client.issueWarningForFailedDeviceQuery(device, gpuMemUsed.name, ret)
 			continue
 		}
 
-		gpuMemUsed.gpuIndex = uint(gpuIndex)
+		gpuMemUsed.gpuIndex = device.gpuIndex
 		gpuMemUsed.time = timestamp
 		gpuMemUsed.setInt64(int64(memInfo.Used))
 		deviceMetrics = append(deviceMetrics, gpuMemUsed)
 
-		gpuMemFree.gpuIndex = uint(gpuIndex)
+		gpuMemFree.gpuIndex = device.gpuIndex
 		gpuMemFree.time = timestamp
 		gpuMemFree.setInt64(int64(memInfo.Free))
 		deviceMetrics = append(deviceMetrics, gpuMemFree)
 
-		client.logger.Debugf("Nvidia device %d has %d bytes used and %d bytes free", gpuIndex, gpuMemUsed.asInt64(), gpuMemFree.asInt64())
+		client.logger.Debugf("Nvidia device %d has %d bytes used and %d bytes free", device.gpuIndex, gpuMemUsed.asInt64(), gpuMemFree.asInt64())
+	}
+
+	return deviceMetrics
+}
+
+func (client *nvmlClient) collectDevicePower() []deviceMetric {
+	deviceMetrics := make([]deviceMetric, 0, 2*len(client.devices))
+
+	gpuPower := deviceMetric{name: "nvml.gpu.power.usage"}
+	gpuEnergy := deviceMetric{name: "nvml.gpu.energy_consumption"}
+
+	for _, device := range client.devices {
+		if client.config.Metrics.NvmlGpuPowerUsage.Enabled {
+			milliwatts, ret := nvmlDeviceGetPowerUsage(device.handle)
+			if ret != nvml.SUCCESS {
+				client.issueWarningForFailedDeviceQuery(device, gpuPower.name, ret)
+			} else {
+				gpuPower.gpuIndex = device.gpuIndex
+				gpuPower.time = time.Now()
+				gpuPower.setFloat64(float64(milliwatts) / 1e3) /* mW to W */
+				deviceMetrics = append(deviceMetrics, gpuPower)
+				client.logger.Debugf("Nvidia device %d draws %.1fW", device.gpuIndex, gpuPower.asFloat64())
+			}
+		}
+
+		if !client.config.Metrics.NvmlGpuEnergyConsumption.Enabled {
+			continue
+		}
+		millijoules, ret := nvmlDeviceGetTotalEnergyConsumption(device.handle)
+		if ret != nvml.SUCCESS {
+			client.issueWarningForFailedDeviceQuery(device, gpuEnergy.name, ret)
+			continue
+		}
+		gpuEnergy.gpuIndex = device.gpuIndex
+		gpuEnergy.time = time.Now()
+		gpuEnergy.setFloat64(float64(millijoules) / 1e3) /* mJ to J */
+		deviceMetrics = append(deviceMetrics, gpuEnergy)
+	}
+
+	return deviceMetrics
+}
+
+func (client *nvmlClient) collectDeviceTemperature() []deviceMetric {
+	deviceMetrics := make([]deviceMetric, 0, len(client.devices))
+
+	gpuTemp := deviceMetric{name: "nvml.gpu.temperature"}
+
+	for _, device := range client.devices {
+		celsius, ret := nvmlDeviceGetTemperature(device.handle, nvml.TEMPERATURE_GPU)
+		if ret != nvml.SUCCESS {
+			client.issueWarningForFailedDeviceQuery(device, gpuTemp.name, ret)
+			continue
+		}
+
+		gpuTemp.gpuIndex = device.gpuIndex
+		gpuTemp.time = time.Now()
+		gpuTemp.setFloat64(float64(celsius))
+		deviceMetrics = append(deviceMetrics, gpuTemp)
+	}
+
+	return deviceMetrics
+}
+
+func (client *nvmlClient) collectDeviceClocks() []deviceMetric {
+	deviceMetrics := make([]deviceMetric, 0, 2*len(client.devices))
+
+	clockTypes := []struct {
+		clockType nvml.ClockType
+		attribute string
+	}{
+		{nvml.CLOCK_SM, "sm"},
+		{nvml.CLOCK_MEM, "memory"},
+	}
+
+	for _, device := range client.devices {
+		for _, c := range clockTypes {
+			gpuClock := deviceMetric{name: "nvml.gpu.clock.frequency", attribute: c.attribute}
+			megahertz, ret := nvmlDeviceGetClockInfo(device.handle, c.clockType)
+			if ret != nvml.SUCCESS {
+				client.issueWarningForFailedDeviceQuery(device, fmt.Sprintf("%s{clock_type=%s}", gpuClock.name, c.attribute), ret)
+				continue
+			}
+
+			gpuClock.gpuIndex = device.gpuIndex
+			gpuClock.time = time.Now()
+			gpuClock.setFloat64(1e6 * float64(megahertz)) /* MHz to Hz */
+			deviceMetrics = append(deviceMetrics, gpuClock)
+		}
+	}
+
+	return deviceMetrics
+}
+
+func (client *nvmlClient) collectDeviceEccErrors() []deviceMetric {
+	deviceMetrics := make([]deviceMetric, 0, 2*len(client.devices))
+
+	errorTypes := []struct {
+		errorType nvml.MemoryErrorType
+		attribute string
+	}{
+		{nvml.MEMORY_ERROR_TYPE_CORRECTED, "corrected"},
+		{nvml.MEMORY_ERROR_TYPE_UNCORRECTED, "uncorrected"},
+	}
+
+	for _, device := range client.devices {
+		for _, e := range errorTypes {
+			gpuEccErrors := deviceMetric{name: "nvml.gpu.ecc_errors", attribute: e.attribute}
+			count, ret := nvmlDeviceGetTotalEccErrors(device.handle, e.errorType, nvml.VOLATILE_ECC)
+			if ret != nvml.SUCCESS {
+				client.issueWarningForFailedDeviceQuery(device, fmt.Sprintf("%s{error_type=%s}", gpuEccErrors.name, e.attribute), ret)
+				continue
+			}
+
+			gpuEccErrors.gpuIndex = device.gpuIndex
+			gpuEccErrors.time = time.Now()
+			gpuEccErrors.setInt64(int64(count))
+			deviceMetrics = append(deviceMetrics, gpuEccErrors)
+		}
+	}
+
+	return deviceMetrics
+}
+
+func (client *nvmlClient) collectDevicePcieThroughput() []deviceMetric {
+	deviceMetrics := make([]deviceMetric, 0, 2*len(client.devices))
+
+	directions := []struct {
+		counter   nvml.PcieUtilCounter
+		attribute string
+	}{
+		{nvml.PCIE_UTIL_TX_BYTES, "transmit"},
+		{nvml.PCIE_UTIL_RX_BYTES, "receive"},
+	}
+
+	for _, device := range client.devices {
+		for _, d := range directions {
+			gpuPcie := deviceMetric{name: "nvml.gpu.pcie.throughput", attribute: d.attribute}
+			kilobytesPerSecond, ret := nvmlDeviceGetPcieThroughput(device.handle, d.counter)
+			if ret != nvml.SUCCESS {
+				client.issueWarningForFailedDeviceQuery(device, fmt.Sprintf("%s{direction=%s}", gpuPcie.name, d.attribute), ret)
+				continue
+			}
+
+			gpuPcie.gpuIndex = device.gpuIndex
+			gpuPcie.time = time.Now()
+			gpuPcie.setInt64(1000 * int64(kilobytesPerSecond)) /* KB/s to By/s */
+			deviceMetrics = append(deviceMetrics, gpuPcie)
+		}
+	}
+
+	return deviceMetrics
+}
+
+func (client *nvmlClient) collectDeviceFanSpeed() []deviceMetric {
+	deviceMetrics := make([]deviceMetric, 0, len(client.devices))
+
+	gpuFan := deviceMetric{name: "nvml.gpu.fan.speed"}
+
+	for _, device := range client.devices {
+		percent, ret := nvmlDeviceGetFanSpeed(device.handle)
+		if ret != nvml.SUCCESS {
+			client.issueWarningForFailedDeviceQuery(device, gpuFan.name, ret)
+			continue
+		}
+
+		gpuFan.gpuIndex = device.gpuIndex
+		gpuFan.time = time.Now()
+		gpuFan.setFloat64(float64(percent) / 100.0)
+		deviceMetrics = append(deviceMetrics, gpuFan)
+	}
+
+	return deviceMetrics
+}
+
+func (client *nvmlClient) collectDeviceClockThrottleReasons() []deviceMetric {
+	deviceMetrics := make([]deviceMetric, 0, len(clockThrottleReasons)*len(client.devices))
+
+	for _, device := range client.devices {
+		reasons, ret := nvmlDeviceGetCurrentClocksThrottleReasons(device.handle)
+		timestamp := time.Now()
+		if ret != nvml.SUCCESS {
+			client.issueWarningForFailedDeviceQuery(device, "nvml.gpu.clock.throttle_reason", ret)
+			continue
+		}
+
+		for _, r := range clockThrottleReasons {
+			gpuThrottle := deviceMetric{name: "nvml.gpu.clock.throttle_reason", attribute: r.reason}
+			gpuThrottle.gpuIndex = device.gpuIndex
+			gpuThrottle.time = timestamp
+			if reasons&r.mask != 0 {
+				gpuThrottle.setInt64(1)
+			} else {
+				gpuThrottle.setInt64(0)
+			}
+			deviceMetrics = append(deviceMetrics, gpuThrottle)
+		}
+		client.logger.Debugf("Nvidia device %d has clock throttle reasons %#x", device.gpuIndex, reasons)
 	}
 
 	return deviceMetrics
@@ -358,25 +777,25 @@ func (client *nvmlClient) collectProcessMetrics() []processMetric {
 
 	processMetrics := make([]processMetric, 0)
 
-	for gpuIndex, device := range client.devices {
-		if !client.deviceToAccountingIsEnabled[device] {
+	for _, device := range client.devices {
+		if !client.deviceToAccountingIsEnabled[device.handle] {
 			continue
 		}
 
-		pids, ret := nvmlDeviceGetAccountingPids(device)
+		pids, ret := nvmlDeviceGetAccountingPids(device.handle)
 		if ret != nvml.SUCCESS {
 			msg := fmt.Sprintf("Unable to query cached PIDs on '%v", nvml.ErrorString(ret))
-			client.issueWarningForFailedQueryUptoThreshold(gpuIndex, "nvml.processes", msg)
+			client.issueWarningForFailedQueryUptoThreshold(int(device.gpuIndex), "nvml.processes", msg)
 			continue
 		}
 
 		for _, pid := range pids {
 			metricName := fmt.Sprintf("nvml.processes{pid=%d}", pid)
 
-			stats, ret := nvml.DeviceGetAccountingStats(device, uint32(pid))
+			stats, ret := nvml.DeviceGetAccountingStats(device.handle, uint32(pid))
 			if ret != nvml.SUCCESS {
 				msg := fmt.Sprintf("Unable to query pid %d account statistics on '%v", pid, nvml.ErrorString(ret))
-				client.issueWarningForFailedQueryUptoThreshold(gpuIndex, metricName, msg)
+				client.issueWarningForFailedQueryUptoThreshold(int(device.gpuIndex), metricName, msg)
 				continue
 			}
 
@@ -387,7 +806,7 @@ func (client *nvmlClient) collectProcessMetrics() []processMetric {
 			metric := processMetric{
 				time:                   time.Now(),
 				processPid:             pid,
-				gpuIndex:               uint(gpuIndex),
+				gpuIndex:               device.gpuIndex,
 				lifetimeGpuUtilization: uint64(stats.GpuUtilization),
 				lifetimeGpuMaxMemory:   stats.MaxMemoryUsage,
 			}
@@ -440,6 +859,16 @@ func (metric *processMetric) setMetadataLabels() error {
 	return nil
 }
 
+// issueWarningForFailedDeviceQuery is issueWarningForFailedQueryUptoThreshold
+// for an NVML device query; it also marks the device lost on
+// ERROR_GPU_IS_LOST, so that it is dropped before the next collection.
+func (client *nvmlClient) issueWarningForFailedDeviceQuery(device nvmlDevice, metricName string, ret nvml.Return) {
+	if ret == nvml.ERROR_GPU_IS_LOST {
+		client.lostDevices[device.handle] = true
+	}
+	client.issueWarningForFailedQueryUptoThreshold(int(device.gpuIndex), metricName, nvml.ErrorString(ret))
+}
+
 func (client *nvmlClient) issueWarningForFailedQueryUptoThreshold(deviceIdx int, metricName string, reason string) {
 	deviceMetric := fmt.Sprintf("device%d.%s", deviceIdx, metricName)
 	client.deviceMetricToFailedQueryCount[deviceMetric]++
@@ -61,8 +61,8 @@ func TestGpuModelNameExists(t *testing.T) {
 	require.NotNil(t, client)
 	require.Greater(t, len(client.devices), 0)
 
-	for gpuIndex := 0; gpuIndex < len(client.devices); gpuIndex++ {
-		model := client.getDeviceModelName(uint(gpuIndex))
+	for _, device := range client.devices {
+		model := client.getDeviceModelName(device.gpuIndex)
 		assert.GreaterOrEqual(t, len(model), 2)
 	}
 }
@@ -18,9 +18,13 @@
 package nvmlreceiver
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 )
@@ -44,3 +48,321 @@ func TestNewNvmlClientDisabledOnLibraryNotFoundError(t *testing.T) {
 	require.NotNil(t, client)
 	require.Equal(t, client.disable, true)
 }
+
+// newTestNvmlClient returns a client for the given mock devices, without
+// initializing NVML. Process accounting is unsupported by the devices.
+func newTestNvmlClient(t *testing.T, devices ...nvml.Device) *nvmlClient {
+	realNvmlDeviceSetAccountingMode := nvmlDeviceSetAccountingMode
+	t.Cleanup(func() { nvmlDeviceSetAccountingMode = realNvmlDeviceSetAccountingMode })
+	nvmlDeviceSetAccountingMode = func(Device nvml.Device, Mode nvml.EnableState) nvml.Return {
+		return nvml.ERROR_NOT_SUPPORTED
+	}
+
+	if len(devices) == 0 {
+		devices = []nvml.Device{&mock.Device{}}
+	}
+	nvmlDevices := make([]nvmlDevice, 0, len(devices))
+	for i, device := range devices {
+		nvmlDevices = append(nvmlDevices, nvmlDevice{
+			handle:   device,
+			gpuIndex: uint(i),
+			UUID:     fmt.Sprintf("GPU-%08d-0000-0000-0000-000000000000", i),
+			model:    "NVIDIA Test GPU",
+		})
+	}
+
+	client := &nvmlClient{
+		logger:                         zaptest.NewLogger(t).Sugar(),
+		config:                         createDefaultConfig().(*Config),
+		deviceToLastSeenTimestamp:      make(map[nvml.Device]uint64),
+		deviceMetricToFailedQueryCount: make(map[string]uint64),
+		deviceToAccountingIsEnabled:    make(map[nvml.Device]bool),
+		lostDevices:                    make(map[nvml.Device]bool),
+		knownDevices:                   make(map[string]knownDevice),
+		lastDiscovery:                  time.Now(),
+	}
+	client.setDevices(nvmlDevices)
+	return client
+}
+
+// enableAllDeviceMetrics enables the device metrics which are disabled by
+// default.
+func enableAllDeviceMetrics(client *nvmlClient) {
+	client.config.Metrics.NvmlGpuClockFrequency.Enabled = true
+	client.config.Metrics.NvmlGpuClockThrottleReason.Enabled = true
+	client.config.Metrics.NvmlGpuPcieThroughput.Enabled = true
+}
+
+// mockDeviceQueries replaces the NVML device queries with ones returning
+// fixed values, until the end of the test.
+func mockDeviceQueries(t *testing.T) {
+	realNvmlDeviceGetSamples := nvmlDeviceGetSamples
+	realNvmlDeviceGetMemoryInfo := nvmlDeviceGetMemoryInfo
+	realNvmlDeviceGetPowerUsage := nvmlDeviceGetPowerUsage
+	realNvmlDeviceGetTotalEnergyConsumption := nvmlDeviceGetTotalEnergyConsumption
+	realNvmlDeviceGetTemperature := nvmlDeviceGetTemperature
+	realNvmlDeviceGetClockInfo := nvmlDeviceGetClockInfo
+	realNvmlDeviceGetTotalEccErrors := nvmlDeviceGetTotalEccErrors
+	realNvmlDeviceGetPcieThroughput := nvmlDeviceGetPcieThroughput
+	realNvmlDeviceGetFanSpeed := nvmlDeviceGetFanSpeed
+	realNvmlDeviceGetCurrentClocksThrottleReasons := nvmlDeviceGetCurrentClocksThrottleReasons
+	t.Cleanup(func() {
+		nvmlDeviceGetSamples = realNvmlDeviceGetSamples
+		nvmlDeviceGetMemoryInfo = realNvmlDeviceGetMemoryInfo
+		nvmlDeviceGetPowerUsage = realNvmlDeviceGetPowerUsage
+		nvmlDeviceGetTotalEnergyConsumption = realNvmlDeviceGetTotalEnergyConsumption
+		nvmlDeviceGetTemperature = realNvmlDeviceGetTemperature
+		nvmlDeviceGetClockInfo = realNvmlDeviceGetClockInfo
+		nvmlDeviceGetTotalEccErrors = realNvmlDeviceGetTotalEccErrors
+		nvmlDeviceGetPcieThroughput = realNvmlDeviceGetPcieThroughput
+		nvmlDeviceGetFanSpeed = realNvmlDeviceGetFanSpeed
+		nvmlDeviceGetCurrentClocksThrottleReasons = realNvmlDeviceGetCurrentClocksThrottleReasons
+	})
+
+	nvmlDeviceGetSamples = func(device nvml.Device, _type nvml.SamplingType, LastSeenTimeStamp uint64) (nvml.ValueType, []nvml.Sample, nvml.Return) {
+		var value [8]byte
+		value[0] = 50
+		return nvml.VALUE_TYPE_UNSIGNED_INT, []nvml.Sample{{TimeStamp: LastSeenTimeStamp + 1, SampleValue: value}}, nvml.SUCCESS
+	}
+	nvmlDeviceGetMemoryInfo = func(device nvml.Device) (nvml.Memory, nvml.Return) {
+		return nvml.Memory{Total: 3000, Free: 1000, Used: 2000}, nvml.SUCCESS
+	}
+	nvmlDeviceGetPowerUsage = func(device nvml.Device) (uint32, nvml.Return) {
+		return 75500, nvml.SUCCESS
+	}
+	nvmlDeviceGetTotalEnergyConsumption = func(device nvml.Device) (uint64, nvml.Return) {
+		return 123456, nvml.SUCCESS
+	}
+	nvmlDeviceGetTemperature = func(device nvml.Device, sensor nvml.TemperatureSensors) (uint32, nvml.Return) {
+		return 45, nvml.SUCCESS
+	}
+	nvmlDeviceGetClockInfo = func(device nvml.Device, clockType nvml.ClockType) (uint32, nvml.Return) {
+		if clockType == nvml.CLOCK_SM {
+			return 1410, nvml.SUCCESS
+		}
+		return 1215, nvml.SUCCESS
+	}
+	nvmlDeviceGetTotalEccErrors = func(device nvml.Device, errorType nvml.MemoryErrorType, counterType nvml.EccCounterType) (uint64, nvml.Return) {
+		if errorType == nvml.MEMORY_ERROR_TYPE_CORRECTED {
+			return 3, nvml.SUCCESS
+		}
+		return 1, nvml.SUCCESS
+	}
+	nvmlDeviceGetPcieThroughput = func(device nvml.Device, counter nvml.PcieUtilCounter) (uint32, nvml.Return) {
+		if counter == nvml.PCIE_UTIL_TX_BYTES {
+			return 200, nvml.SUCCESS
+		}
+		return 100, nvml.SUCCESS
+	}
+	nvmlDeviceGetFanSpeed = func(device nvml.Device) (uint32, nvml.Return) {
+		return 30, nvml.SUCCESS
+	}
+	nvmlDeviceGetCurrentClocksThrottleReasons = func(device nvml.Device) (uint64, nvml.Return) {
+		return nvml.ClocksThrottleReasonGpuIdle | nvml.ClocksThrottleReasonSwThermalSlowdown, nvml.SUCCESS
+	}
+}
+
+func TestCollectDeviceMetricsWithMockedQueries(t *testing.T) {
+	mockDeviceQueries(t)
+	client := newTestNvmlClient(t)
+	enableAllDeviceMetrics(client)
+
+	deviceMetrics, err := client.collectDeviceMetrics()
+	require.NoError(t, err)
+
+	floatValues := make(map[string]float64)
+	intValues := make(map[string]int64)
+	for _, metric := range deviceMetrics {
+		key := metric.name
+		if metric.attribute != "" {
+			key += "/" + metric.attribute
+		}
+		assert.Equal(t, uint(0), metric.gpuIndex)
+		floatValues[key] = metric.asFloat64()
+		intValues[key] = metric.asInt64()
+	}
+
+	assert.Equal(t, 75.5, floatValues["nvml.gpu.power.usage"])
+	assert.Equal(t, 123.456, floatValues["nvml.gpu.energy_consumption"])
+	assert.Equal(t, 45.0, floatValues["nvml.gpu.temperature"])
+	assert.Equal(t, 1410e6, floatValues["nvml.gpu.clock.frequency/sm"])
+	assert.Equal(t, 1215e6, floatValues["nvml.gpu.clock.frequency/memory"])
+	assert.Equal(t, int64(3), intValues["nvml.gpu.ecc_errors/corrected"])
+	assert.Equal(t, int64(1), intValues["nvml.gpu.ecc_errors/uncorrected"])
+	assert.Equal(t, int64(200000), intValues["nvml.gpu.pcie.throughput/transmit"])
+	assert.Equal(t, int64(100000), intValues["nvml.gpu.pcie.throughput/receive"])
+	assert.Equal(t, 0.3, floatValues["nvml.gpu.fan.speed"])
+
+	for _, r := range clockThrottleReasons {
+		value, ok := intValues["nvml.gpu.clock.throttle_reason/"+r.reason]
+		require.True(t, ok, r.reason)
+		switch r.reason {
+		case "gpu_idle", "sw_thermal_slowdown":
+			assert.Equal(t, int64(1), value, r.reason)
+		default:
+			assert.Equal(t, int64(0), value, r.reason)
+		}
+	}
+}
+
+func TestCollectDeviceMetricsOnQueriesUnsupported(t *testing.T) {
+	mockDeviceQueries(t)
+	nvmlDeviceGetPowerUsage = func(device nvml.Device) (uint32, nvml.Return) {
+		return 0, nvml.ERROR_NOT_SUPPORTED
+	}
+	nvmlDeviceGetFanSpeed = func(device nvml.Device) (uint32, nvml.Return) {
+		return 0, nvml.ERROR_NOT_SUPPORTED
+	}
+	nvmlDeviceGetClockInfo = func(device nvml.Device, clockType nvml.ClockType) (uint32, nvml.Return) {
+		if clockType == nvml.CLOCK_MEM {
+			return 0, nvml.ERROR_NOT_SUPPORTED
+		}
+		return 1410, nvml.SUCCESS
+	}
+	nvmlDeviceGetCurrentClocksThrottleReasons = func(device nvml.Device) (uint64, nvml.Return) {
+		return 0, nvml.ERROR_NOT_SUPPORTED
+	}
+	client := newTestNvmlClient(t)
+	enableAllDeviceMetrics(client)
+
+	deviceMetrics, err := client.collectDeviceMetrics()
+	require.NoError(t, err)
+
+	seen := make(map[string]bool)
+	for _, metric := range deviceMetrics {
+		seen[metric.name+"/"+metric.attribute] = true
+	}
+	assert.False(t, seen["nvml.gpu.power.usage/"])
+	assert.False(t, seen["nvml.gpu.fan.speed/"])
+	assert.False(t, seen["nvml.gpu.clock.frequency/memory"])
+	assert.True(t, seen["nvml.gpu.clock.frequency/sm"])
+	assert.True(t, seen["nvml.gpu.energy_consumption/"])
+	for _, r := range clockThrottleReasons {
+		assert.False(t, seen["nvml.gpu.clock.throttle_reason/"+r.reason])
+	}
+
+	assert.Equal(t, uint64(1), client.deviceMetricToFailedQueryCount["device0.nvml.gpu.power.usage"])
+}
+
+func TestCollectDeviceMetricsSkipsDisabledMetrics(t *testing.T) {
+	mockDeviceQueries(t)
+	queries := 0
+	nvmlDeviceGetClockInfo = func(device nvml.Device, clockType nvml.ClockType) (uint32, nvml.Return) {
+		queries++
+		return 0, nvml.SUCCESS
+	}
+	nvmlDeviceGetPcieThroughput = func(device nvml.Device, counter nvml.PcieUtilCounter) (uint32, nvml.Return) {
+		queries++
+		return 0, nvml.SUCCESS
+	}
+	nvmlDeviceGetCurrentClocksThrottleReasons = func(device nvml.Device) (uint64, nvml.Return) {
+		queries++
+		return 0, nvml.SUCCESS
+	}
+	nvmlDeviceGetTotalEnergyConsumption = func(device nvml.Device) (uint64, nvml.Return) {
+		queries++
+		return 0, nvml.SUCCESS
+	}
+	client := newTestNvmlClient(t)
+	client.config.Metrics.NvmlGpuEnergyConsumption.Enabled = false
+
+	deviceMetrics, err := client.collectDeviceMetrics()
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, queries)
+	seen := make(map[string]bool)
+	for _, metric := range deviceMetrics {
+		seen[metric.name] = true
+	}
+	assert.Equal(t, map[string]bool{
+		"nvml.gpu.utilization":       true,
+		"nvml.gpu.memory.bytes_used": true,
+		"nvml.gpu.memory.bytes_free": true,
+		"nvml.gpu.power.usage":       true,
+		"nvml.gpu.temperature":       true,
+		"nvml.gpu.ecc_errors":        true,
+		"nvml.gpu.fan.speed":         true,
+	}, seen)
+}
+
+func TestNewNvmlClientRetriesInitialization(t *testing.T) {
+	realNvmlInit := nvmlInit
+	defer func() { nvmlInit = realNvmlInit }()
+	nvmlInit = func() nvml.Return { return nvml.ERROR_LIBRARY_NOT_FOUND }
+
+	client, _ := newClient(createDefaultConfig().(*Config), zaptest.NewLogger(t))
+	require.NotNil(t, client)
+	require.Equal(t, client.disable, true)
+
+	// Not retried before the discovery interval elapses.
+	initCalls := 0
+	nvmlInit = func() nvml.Return {
+		initCalls++
+		return nvml.ERROR_DRIVER_NOT_LOADED
+	}
+	client.discoverIfDue(client.lastDiscovery.Add(time.Second))
+	assert.Equal(t, 0, initCalls)
+
+	client.discoverIfDue(client.lastDiscovery.Add(defaultDiscoveryInterval))
+	assert.Equal(t, 1, initCalls)
+	assert.Equal(t, client.disable, true)
+}
+
+func TestDiscoverKeepsDeviceStateByUUID(t *testing.T) {
+	first, second := &mock.Device{}, &mock.Device{}
+	client := newTestNvmlClient(t, first, second)
+	client.deviceToLastSeenTimestamp[first] = 100
+	client.deviceToLastSeenTimestamp[second] = 200
+
+	// The first device is gone, the second device is re-discovered with a
+	// new handle, and a third device appears.
+	rediscovered, third := &mock.Device{}, &mock.Device{}
+	client.setDevices([]nvmlDevice{
+		{handle: rediscovered, gpuIndex: 1, UUID: client.devices[1].UUID, model: "NVIDIA Test GPU"},
+		{handle: third, gpuIndex: 2, UUID: "GPU-00000002-0000-0000-0000-000000000000", model: "NVIDIA Other GPU"},
+	})
+
+	assert.Equal(t, map[nvml.Device]uint64{rediscovered: 200}, client.deviceToLastSeenTimestamp)
+	assert.Equal(t, map[nvml.Device]bool{rediscovered: false, third: false}, client.deviceToAccountingIsEnabled)
+
+	availability := client.collectDeviceAvailability()
+	require.Len(t, availability, 3)
+	assert.Equal(t, "GPU-00000000-0000-0000-0000-000000000000", availability[0].UUID)
+	assert.False(t, availability[0].available)
+	assert.Equal(t, uint(0), availability[0].gpuIndex)
+	assert.Equal(t, "GPU-00000001-0000-0000-0000-000000000000", availability[1].UUID)
+	assert.True(t, availability[1].available)
+	assert.Equal(t, uint(1), availability[1].gpuIndex)
+	assert.Equal(t, "GPU-00000002-0000-0000-0000-000000000000", availability[2].UUID)
+	assert.True(t, availability[2].available)
+	assert.Equal(t, uint(2), availability[2].gpuIndex)
+	assert.Equal(t, "NVIDIA Other GPU", availability[2].model)
+}
+
+func TestDiscoverDevicesSkipsLostDevices(t *testing.T) {
+	realNvmlDeviceGetCount := nvmlDeviceGetCount
+	realNvmlDeviceGetHandleByIndex := nvmlDeviceGetHandleByIndex
+	defer func() {
+		nvmlDeviceGetCount = realNvmlDeviceGetCount
+		nvmlDeviceGetHandleByIndex = realNvmlDeviceGetHandleByIndex
+	}()
+
+	device := &mock.Device{
+		GetUUIDFunc:    func() (string, nvml.Return) { return "GPU-00000001-0000-0000-0000-000000000000", nvml.SUCCESS },
+		GetNameFunc:    func() (string, nvml.Return) { return "NVIDIA Test GPU", nvml.SUCCESS },
+		GetMigModeFunc: func() (int, int, nvml.Return) { return nvml.DEVICE_MIG_DISABLE, nvml.DEVICE_MIG_DISABLE, nvml.SUCCESS },
+	}
+	nvmlDeviceGetCount = func() (int, nvml.Return) { return 2, nvml.SUCCESS }
+	nvmlDeviceGetHandleByIndex = func(index int) (nvml.Device, nvml.Return) {
+		if index == 0 {
+			return nil, nvml.ERROR_GPU_IS_LOST
+		}
+		return device, nvml.SUCCESS
+	}
+
+	devices, err := discoverDevices(zaptest.NewLogger(t))
+	require.NoError(t, err)
+	assert.Equal(t, []nvmlDevice{
+		{handle: device, gpuIndex: 1, UUID: "GPU-00000001-0000-0000-0000-000000000000", model: "NVIDIA Test GPU"},
+	}, devices)
+}
@@ -15,6 +15,7 @@
 package nvmlreceiver
 
 import (
+	"errors"
 	"time"
 
 	"go.opentelemetry.io/collector/scraper/scraperhelper"
@@ -23,8 +24,19 @@ import (
 )
 
 const defaultCollectionInterval = 10 * time.Second
+const defaultDiscoveryInterval = 1 * time.Minute
 
 type Config struct {
 	scraperhelper.ControllerConfig `mapstructure:",squash"`
 	Metrics                        metadata.MetricsConfig `mapstructure:"metrics"`
+	// DiscoveryInterval is how often the receiver retries initializing NVML
+	// and re-enumerates the Nvidia devices. Zero disables re-discovery.
+	DiscoveryInterval time.Duration `mapstructure:"discovery_interval"`
+}
+
+func (cfg *Config) Validate() error {
+	if cfg.DiscoveryInterval < 0 {
+		return errors.New("discovery_interval must not be negative")
+	}
+	return nil
 }
@@ -35,6 +35,7 @@ func createDefaultConfig() component.Config {
 		ControllerConfig: scraperhelper.ControllerConfig{
 			CollectionInterval: defaultCollectionInterval,
 		},
-		Metrics: metadata.DefaultMetricsConfig(),
+		Metrics:           metadata.DefaultMetricsConfig(),
+		DiscoveryInterval: defaultDiscoveryInterval,
 	}
 }
@@ -8,6 +8,309 @@ import (
 	"go.opentelemetry.io/collector/confmap"
 )
 
+// NvmlGpuAvailableMetricAttributeKey specifies the key of an attribute for the nvml.gpu.available metric.
+type NvmlGpuAvailableMetricAttributeKey string
+
+const (
+	NvmlGpuAvailableMetricAttributeKeyModel     NvmlGpuAvailableMetricAttributeKey = "model"
+	NvmlGpuAvailableMetricAttributeKeyGpuNumber NvmlGpuAvailableMetricAttributeKey = "gpu_number"
+	NvmlGpuAvailableMetricAttributeKeyUUID      NvmlGpuAvailableMetricAttributeKey = "uuid"
+)
+
+// NvmlGpuAvailableMetricConfig provides config for the nvml.gpu.available metric.
+type NvmlGpuAvailableMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                               `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []NvmlGpuAvailableMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *NvmlGpuAvailableMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *NvmlGpuAvailableMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case NvmlGpuAvailableMetricAttributeKeyModel, NvmlGpuAvailableMetricAttributeKeyGpuNumber, NvmlGpuAvailableMetricAttributeKeyUUID:
+		default:
+			return fmt.Errorf("metric nvml.gpu.available doesn't have an attribute %v, valid attributes: [model, gpu_number, uuid]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// NvmlGpuClockFrequencyMetricAttributeKey specifies the key of an attribute for the nvml.gpu.clock.frequency metric.
+type NvmlGpuClockFrequencyMetricAttributeKey string
+
+const (
+	NvmlGpuClockFrequencyMetricAttributeKeyModel     NvmlGpuClockFrequencyMetricAttributeKey = "model"
+	NvmlGpuClockFrequencyMetricAttributeKeyGpuNumber NvmlGpuClockFrequencyMetricAttributeKey = "gpu_number"
+	NvmlGpuClockFrequencyMetricAttributeKeyUUID      NvmlGpuClockFrequencyMetricAttributeKey = "uuid"
+	NvmlGpuClockFrequencyMetricAttributeKeyClockType NvmlGpuClockFrequencyMetricAttributeKey = "clock_type"
+)
+
+// NvmlGpuClockFrequencyMetricConfig provides config for the nvml.gpu.clock.frequency metric.
+type NvmlGpuClockFrequencyMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                    `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []NvmlGpuClockFrequencyMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *NvmlGpuClockFrequencyMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *NvmlGpuClockFrequencyMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case NvmlGpuClockFrequencyMetricAttributeKeyModel, NvmlGpuClockFrequencyMetricAttributeKeyGpuNumber, NvmlGpuClockFrequencyMetricAttributeKeyUUID, NvmlGpuClockFrequencyMetricAttributeKeyClockType:
+		default:
+			return fmt.Errorf("metric nvml.gpu.clock.frequency doesn't have an attribute %v, valid attributes: [model, gpu_number, uuid, clock_type]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// NvmlGpuClockThrottleReasonMetricAttributeKey specifies the key of an attribute for the nvml.gpu.clock.throttle_reason metric.
+type NvmlGpuClockThrottleReasonMetricAttributeKey string
+
+const (
+	NvmlGpuClockThrottleReasonMetricAttributeKeyModel          NvmlGpuClockThrottleReasonMetricAttributeKey = "model"
+	NvmlGpuClockThrottleReasonMetricAttributeKeyGpuNumber      NvmlGpuClockThrottleReasonMetricAttributeKey = "gpu_number"
+	NvmlGpuClockThrottleReasonMetricAttributeKeyUUID           NvmlGpuClockThrottleReasonMetricAttributeKey = "uuid"
+	NvmlGpuClockThrottleReasonMetricAttributeKeyThrottleReason NvmlGpuClockThrottleReasonMetricAttributeKey = "throttle_reason"
+)
+
+// NvmlGpuClockThrottleReasonMetricConfig provides config for the nvml.gpu.clock.throttle_reason metric.
+type NvmlGpuClockThrottleReasonMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                         `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []NvmlGpuClockThrottleReasonMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *NvmlGpuClockThrottleReasonMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *NvmlGpuClockThrottleReasonMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case NvmlGpuClockThrottleReasonMetricAttributeKeyModel, NvmlGpuClockThrottleReasonMetricAttributeKeyGpuNumber, NvmlGpuClockThrottleReasonMetricAttributeKeyUUID, NvmlGpuClockThrottleReasonMetricAttributeKeyThrottleReason:
+		default:
+			return fmt.Errorf("metric nvml.gpu.clock.throttle_reason doesn't have an attribute %v, valid attributes: [model, gpu_number, uuid, throttle_reason]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// NvmlGpuEccErrorsMetricAttributeKey specifies the key of an attribute for the nvml.gpu.ecc_errors metric.
+type NvmlGpuEccErrorsMetricAttributeKey string
+
+const (
+	NvmlGpuEccErrorsMetricAttributeKeyModel     NvmlGpuEccErrorsMetricAttributeKey = "model"
+	NvmlGpuEccErrorsMetricAttributeKeyGpuNumber NvmlGpuEccErrorsMetricAttributeKey = "gpu_number"
+	NvmlGpuEccErrorsMetricAttributeKeyUUID      NvmlGpuEccErrorsMetricAttributeKey = "uuid"
+	NvmlGpuEccErrorsMetricAttributeKeyErrorType NvmlGpuEccErrorsMetricAttributeKey = "error_type"
+)
+
+// NvmlGpuEccErrorsMetricConfig provides config for the nvml.gpu.ecc_errors metric.
+type NvmlGpuEccErrorsMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                               `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []NvmlGpuEccErrorsMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *NvmlGpuEccErrorsMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *NvmlGpuEccErrorsMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case NvmlGpuEccErrorsMetricAttributeKeyModel, NvmlGpuEccErrorsMetricAttributeKeyGpuNumber, NvmlGpuEccErrorsMetricAttributeKeyUUID, NvmlGpuEccErrorsMetricAttributeKeyErrorType:
+		default:
+			return fmt.Errorf("metric nvml.gpu.ecc_errors doesn't have an attribute %v, valid attributes: [model, gpu_number, uuid, error_type]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// NvmlGpuEnergyConsumptionMetricAttributeKey specifies the key of an attribute for the nvml.gpu.energy_consumption metric.
+type NvmlGpuEnergyConsumptionMetricAttributeKey string
+
+const (
+	NvmlGpuEnergyConsumptionMetricAttributeKeyModel     NvmlGpuEnergyConsumptionMetricAttributeKey = "model"
+	NvmlGpuEnergyConsumptionMetricAttributeKeyGpuNumber NvmlGpuEnergyConsumptionMetricAttributeKey = "gpu_number"
+	NvmlGpuEnergyConsumptionMetricAttributeKeyUUID      NvmlGpuEnergyConsumptionMetricAttributeKey = "uuid"
+)
+
+// NvmlGpuEnergyConsumptionMetricConfig provides config for the nvml.gpu.energy_consumption metric.
+type NvmlGpuEnergyConsumptionMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                       `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []NvmlGpuEnergyConsumptionMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *NvmlGpuEnergyConsumptionMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *NvmlGpuEnergyConsumptionMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case NvmlGpuEnergyConsumptionMetricAttributeKeyModel, NvmlGpuEnergyConsumptionMetricAttributeKeyGpuNumber, NvmlGpuEnergyConsumptionMetricAttributeKeyUUID:
+		default:
+			return fmt.Errorf("metric nvml.gpu.energy_consumption doesn't have an attribute %v, valid attributes: [model, gpu_number, uuid]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// NvmlGpuFanSpeedMetricAttributeKey specifies the key of an attribute for the nvml.gpu.fan.speed metric.
+type NvmlGpuFanSpeedMetricAttributeKey string
+
+const (
+	NvmlGpuFanSpeedMetricAttributeKeyModel     NvmlGpuFanSpeedMetricAttributeKey = "model"
+	NvmlGpuFanSpeedMetricAttributeKeyGpuNumber NvmlGpuFanSpeedMetricAttributeKey = "gpu_number"
+	NvmlGpuFanSpeedMetricAttributeKeyUUID      NvmlGpuFanSpeedMetricAttributeKey = "uuid"
+)
+
+// NvmlGpuFanSpeedMetricConfig provides config for the nvml.gpu.fan.speed metric.
+type NvmlGpuFanSpeedMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                              `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []NvmlGpuFanSpeedMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *NvmlGpuFanSpeedMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *NvmlGpuFanSpeedMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case NvmlGpuFanSpeedMetricAttributeKeyModel, NvmlGpuFanSpeedMetricAttributeKeyGpuNumber, NvmlGpuFanSpeedMetricAttributeKeyUUID:
+		default:
+			return fmt.Errorf("metric nvml.gpu.fan.speed doesn't have an attribute %v, valid attributes: [model, gpu_number, uuid]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
 // NvmlGpuMemoryBytesUsedMetricAttributeKey specifies the key of an attribute for the nvml.gpu.memory.bytes_used metric.
 type NvmlGpuMemoryBytesUsedMetricAttributeKey string
 
@@ -59,6 +362,107 @@ func (ms *NvmlGpuMemoryBytesUsedMetricConfig) Validate() error {
 	return nil
 }
 
+// NvmlGpuPcieThroughputMetricAttributeKey specifies the key of an attribute for the nvml.gpu.pcie.throughput metric.
+type NvmlGpuPcieThroughputMetricAttributeKey string
+
+const (
+	NvmlGpuPcieThroughputMetricAttributeKeyModel     NvmlGpuPcieThroughputMetricAttributeKey = "model"
+	NvmlGpuPcieThroughputMetricAttributeKeyGpuNumber NvmlGpuPcieThroughputMetricAttributeKey = "gpu_number"
+	NvmlGpuPcieThroughputMetricAttributeKeyUUID      NvmlGpuPcieThroughputMetricAttributeKey = "uuid"
+	NvmlGpuPcieThroughputMetricAttributeKeyDirection NvmlGpuPcieThroughputMetricAttributeKey = "direction"
+)
+
+// NvmlGpuPcieThroughputMetricConfig provides config for the nvml.gpu.pcie.throughput metric.
+type NvmlGpuPcieThroughputMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                    `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []NvmlGpuPcieThroughputMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *NvmlGpuPcieThroughputMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *NvmlGpuPcieThroughputMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case NvmlGpuPcieThroughputMetricAttributeKeyModel, NvmlGpuPcieThroughputMetricAttributeKeyGpuNumber, NvmlGpuPcieThroughputMetricAttributeKeyUUID, NvmlGpuPcieThroughputMetricAttributeKeyDirection:
+		default:
+			return fmt.Errorf("metric nvml.gpu.pcie.throughput doesn't have an attribute %v, valid attributes: [model, gpu_number, uuid, direction]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// NvmlGpuPowerUsageMetricAttributeKey specifies the key of an attribute for the nvml.gpu.power.usage metric.
+type NvmlGpuPowerUsageMetricAttributeKey string
+
+const (
+	NvmlGpuPowerUsageMetricAttributeKeyModel     NvmlGpuPowerUsageMetricAttributeKey = "model"
+	NvmlGpuPowerUsageMetricAttributeKeyGpuNumber NvmlGpuPowerUsageMetricAttributeKey = "gpu_number"
+	NvmlGpuPowerUsageMetricAttributeKeyUUID      NvmlGpuPowerUsageMetricAttributeKey = "uuid"
+)
+
+// NvmlGpuPowerUsageMetricConfig provides config for the nvml.gpu.power.usage metric.
+type NvmlGpuPowerUsageMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []NvmlGpuPowerUsageMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *NvmlGpuPowerUsageMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *NvmlGpuPowerUsageMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case NvmlGpuPowerUsageMetricAttributeKeyModel, NvmlGpuPowerUsageMetricAttributeKeyGpuNumber, NvmlGpuPowerUsageMetricAttributeKeyUUID:
+		default:
+			return fmt.Errorf("metric nvml.gpu.power.usage doesn't have an attribute %v, valid attributes: [model, gpu_number, uuid]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
 // NvmlGpuProcessesMaxBytesUsedMetricAttributeKey specifies the key of an attribute for the nvml.gpu.processes.max_bytes_used metric.
 type NvmlGpuProcessesMaxBytesUsedMetricAttributeKey string
 
@@ -169,6 +573,56 @@ func (ms *NvmlGpuProcessesUtilizationMetricConfig) Validate() error {
 	return nil
 }
 
+// NvmlGpuTemperatureMetricAttributeKey specifies the key of an attribute for the nvml.gpu.temperature metric.
+type NvmlGpuTemperatureMetricAttributeKey string
+
+const (
+	NvmlGpuTemperatureMetricAttributeKeyModel     NvmlGpuTemperatureMetricAttributeKey = "model"
+	NvmlGpuTemperatureMetricAttributeKeyGpuNumber NvmlGpuTemperatureMetricAttributeKey = "gpu_number"
+	NvmlGpuTemperatureMetricAttributeKeyUUID      NvmlGpuTemperatureMetricAttributeKey = "uuid"
+)
+
+// NvmlGpuTemperatureMetricConfig provides config for the nvml.gpu.temperature metric.
+type NvmlGpuTemperatureMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                 `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []NvmlGpuTemperatureMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *NvmlGpuTemperatureMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *NvmlGpuTemperatureMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case NvmlGpuTemperatureMetricAttributeKeyModel, NvmlGpuTemperatureMetricAttributeKeyGpuNumber, NvmlGpuTemperatureMetricAttributeKeyUUID:
+		default:
+			return fmt.Errorf("metric nvml.gpu.temperature doesn't have an attribute %v, valid attributes: [model, gpu_number, uuid]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
 // NvmlGpuUtilizationMetricAttributeKey specifies the key of an attribute for the nvml.gpu.utilization metric.
 type NvmlGpuUtilizationMetricAttributeKey string
 
@@ -221,19 +675,68 @@ func (ms *NvmlGpuUtilizationMetricConfig) Validate() error {
 
 // MetricsConfig provides config for nvml metrics.
 type MetricsConfig struct {
+	NvmlGpuAvailable             NvmlGpuAvailableMetricConfig             `mapstructure:"nvml.gpu.available"`
+	NvmlGpuClockFrequency        NvmlGpuClockFrequencyMetricConfig        `mapstructure:"nvml.gpu.clock.frequency"`
+	NvmlGpuClockThrottleReason   NvmlGpuClockThrottleReasonMetricConfig   `mapstructure:"nvml.gpu.clock.throttle_reason"`
+	NvmlGpuEccErrors             NvmlGpuEccErrorsMetricConfig             `mapstructure:"nvml.gpu.ecc_errors"`
+	NvmlGpuEnergyConsumption     NvmlGpuEnergyConsumptionMetricConfig     `mapstructure:"nvml.gpu.energy_consumption"`
+	NvmlGpuFanSpeed              NvmlGpuFanSpeedMetricConfig              `mapstructure:"nvml.gpu.fan.speed"`
 	NvmlGpuMemoryBytesUsed       NvmlGpuMemoryBytesUsedMetricConfig       `mapstructure:"nvml.gpu.memory.bytes_used"`
+	NvmlGpuPcieThroughput        NvmlGpuPcieThroughputMetricConfig        `mapstructure:"nvml.gpu.pcie.throughput"`
+	NvmlGpuPowerUsage            NvmlGpuPowerUsageMetricConfig            `mapstructure:"nvml.gpu.power.usage"`
 	NvmlGpuProcessesMaxBytesUsed NvmlGpuProcessesMaxBytesUsedMetricConfig `mapstructure:"nvml.gpu.processes.max_bytes_used"`
 	NvmlGpuProcessesUtilization  NvmlGpuProcessesUtilizationMetricConfig  `mapstructure:"nvml.gpu.processes.utilization"`
+	NvmlGpuTemperature           NvmlGpuTemperatureMetricConfig           `mapstructure:"nvml.gpu.temperature"`
 	NvmlGpuUtilization           NvmlGpuUtilizationMetricConfig           `mapstructure:"nvml.gpu.utilization"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
 	return MetricsConfig{
+		NvmlGpuAvailable: NvmlGpuAvailableMetricConfig{
+			Enabled:             true,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []NvmlGpuAvailableMetricAttributeKey{NvmlGpuAvailableMetricAttributeKeyModel, NvmlGpuAvailableMetricAttributeKeyGpuNumber, NvmlGpuAvailableMetricAttributeKeyUUID},
+		},
+		NvmlGpuClockFrequency: NvmlGpuClockFrequencyMetricConfig{
+			Enabled:             false,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []NvmlGpuClockFrequencyMetricAttributeKey{NvmlGpuClockFrequencyMetricAttributeKeyModel, NvmlGpuClockFrequencyMetricAttributeKeyGpuNumber, NvmlGpuClockFrequencyMetricAttributeKeyUUID, NvmlGpuClockFrequencyMetricAttributeKeyClockType},
+		},
+		NvmlGpuClockThrottleReason: NvmlGpuClockThrottleReasonMetricConfig{
+			Enabled:             false,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []NvmlGpuClockThrottleReasonMetricAttributeKey{NvmlGpuClockThrottleReasonMetricAttributeKeyModel, NvmlGpuClockThrottleReasonMetricAttributeKeyGpuNumber, NvmlGpuClockThrottleReasonMetricAttributeKeyUUID, NvmlGpuClockThrottleReasonMetricAttributeKeyThrottleReason},
+		},
+		NvmlGpuEccErrors: NvmlGpuEccErrorsMetricConfig{
+			Enabled:             true,
+			AggregationStrategy: AggregationStrategySum,
+			EnabledAttributes:   []NvmlGpuEccErrorsMetricAttributeKey{NvmlGpuEccErrorsMetricAttributeKeyModel, NvmlGpuEccErrorsMetricAttributeKeyGpuNumber, NvmlGpuEccErrorsMetricAttributeKeyUUID, NvmlGpuEccErrorsMetricAttributeKeyErrorType},
+		},
+		NvmlGpuEnergyConsumption: NvmlGpuEnergyConsumptionMetricConfig{
+			Enabled:             true,
+			AggregationStrategy: AggregationStrategySum,
+			EnabledAttributes:   []NvmlGpuEnergyConsumptionMetricAttributeKey{NvmlGpuEnergyConsumptionMetricAttributeKeyModel, NvmlGpuEnergyConsumptionMetricAttributeKeyGpuNumber, NvmlGpuEnergyConsumptionMetricAttributeKeyUUID},
+		},
+		NvmlGpuFanSpeed: NvmlGpuFanSpeedMetricConfig{
+			Enabled:             true,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []NvmlGpuFanSpeedMetricAttributeKey{NvmlGpuFanSpeedMetricAttributeKeyModel, NvmlGpuFanSpeedMetricAttributeKeyGpuNumber, NvmlGpuFanSpeedMetricAttributeKeyUUID},
+		},
 		NvmlGpuMemoryBytesUsed: NvmlGpuMemoryBytesUsedMetricConfig{
 			Enabled:             true,
 			AggregationStrategy: AggregationStrategyAvg,
 			EnabledAttributes:   []NvmlGpuMemoryBytesUsedMetricAttributeKey{NvmlGpuMemoryBytesUsedMetricAttributeKeyModel, NvmlGpuMemoryBytesUsedMetricAttributeKeyGpuNumber, NvmlGpuMemoryBytesUsedMetricAttributeKeyUUID, NvmlGpuMemoryBytesUsedMetricAttributeKeyMemoryState},
 		},
+		NvmlGpuPcieThroughput: NvmlGpuPcieThroughputMetricConfig{
+			Enabled:             false,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []NvmlGpuPcieThroughputMetricAttributeKey{NvmlGpuPcieThroughputMetricAttributeKeyModel, NvmlGpuPcieThroughputMetricAttributeKeyGpuNumber, NvmlGpuPcieThroughputMetricAttributeKeyUUID, NvmlGpuPcieThroughputMetricAttributeKeyDirection},
+		},
+		NvmlGpuPowerUsage: NvmlGpuPowerUsageMetricConfig{
+			Enabled:             true,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []NvmlGpuPowerUsageMetricAttributeKey{NvmlGpuPowerUsageMetricAttributeKeyModel, NvmlGpuPowerUsageMetricAttributeKeyGpuNumber, NvmlGpuPowerUsageMetricAttributeKeyUUID},
+		},
 		NvmlGpuProcessesMaxBytesUsed: NvmlGpuProcessesMaxBytesUsedMetricConfig{
 			Enabled:             true,
 			AggregationStrategy: AggregationStrategyAvg,
@@ -244,6 +747,11 @@ func DefaultMetricsConfig() MetricsConfig {
 			AggregationStrategy: AggregationStrategyAvg,
 			EnabledAttributes:   []NvmlGpuProcessesUtilizationMetricAttributeKey{NvmlGpuProcessesUtilizationMetricAttributeKeyModel, NvmlGpuProcessesUtilizationMetricAttributeKeyGpuNumber, NvmlGpuProcessesUtilizationMetricAttributeKeyUUID, NvmlGpuProcessesUtilizationMetricAttributeKeyPid, NvmlGpuProcessesUtilizationMetricAttributeKeyProcess, NvmlGpuProcessesUtilizationMetricAttributeKeyCommand, NvmlGpuProcessesUtilizationMetricAttributeKeyCommandLine, NvmlGpuProcessesUtilizationMetricAttributeKeyOwner},
 		},
+		NvmlGpuTemperature: NvmlGpuTemperatureMetricConfig{
+			Enabled:             true,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []NvmlGpuTemperatureMetricAttributeKey{NvmlGpuTemperatureMetricAttributeKeyModel, NvmlGpuTemperatureMetricAttributeKeyGpuNumber, NvmlGpuTemperatureMetricAttributeKeyUUID},
+		},
 		NvmlGpuUtilization: NvmlGpuUtilizationMetricConfig{
 			Enabled:             true,
 			AggregationStrategy: AggregationStrategyAvg,
@@ -26,11 +26,51 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "all_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
+					NvmlGpuAvailable: NvmlGpuAvailableMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []NvmlGpuAvailableMetricAttributeKey{NvmlGpuAvailableMetricAttributeKeyModel, NvmlGpuAvailableMetricAttributeKeyGpuNumber, NvmlGpuAvailableMetricAttributeKeyUUID},
+					},
+					NvmlGpuClockFrequency: NvmlGpuClockFrequencyMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []NvmlGpuClockFrequencyMetricAttributeKey{NvmlGpuClockFrequencyMetricAttributeKeyModel, NvmlGpuClockFrequencyMetricAttributeKeyGpuNumber, NvmlGpuClockFrequencyMetricAttributeKeyUUID, NvmlGpuClockFrequencyMetricAttributeKeyClockType},
+					},
+					NvmlGpuClockThrottleReason: NvmlGpuClockThrottleReasonMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []NvmlGpuClockThrottleReasonMetricAttributeKey{NvmlGpuClockThrottleReasonMetricAttributeKeyModel, NvmlGpuClockThrottleReasonMetricAttributeKeyGpuNumber, NvmlGpuClockThrottleReasonMetricAttributeKeyUUID, NvmlGpuClockThrottleReasonMetricAttributeKeyThrottleReason},
+					},
+					NvmlGpuEccErrors: NvmlGpuEccErrorsMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategySum,
+						EnabledAttributes:   []NvmlGpuEccErrorsMetricAttributeKey{NvmlGpuEccErrorsMetricAttributeKeyModel, NvmlGpuEccErrorsMetricAttributeKeyGpuNumber, NvmlGpuEccErrorsMetricAttributeKeyUUID, NvmlGpuEccErrorsMetricAttributeKeyErrorType},
+					},
+					NvmlGpuEnergyConsumption: NvmlGpuEnergyConsumptionMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategySum,
+						EnabledAttributes:   []NvmlGpuEnergyConsumptionMetricAttributeKey{NvmlGpuEnergyConsumptionMetricAttributeKeyModel, NvmlGpuEnergyConsumptionMetricAttributeKeyGpuNumber, NvmlGpuEnergyConsumptionMetricAttributeKeyUUID},
+					},
+					NvmlGpuFanSpeed: NvmlGpuFanSpeedMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []NvmlGpuFanSpeedMetricAttributeKey{NvmlGpuFanSpeedMetricAttributeKeyModel, NvmlGpuFanSpeedMetricAttributeKeyGpuNumber, NvmlGpuFanSpeedMetricAttributeKeyUUID},
+					},
 					NvmlGpuMemoryBytesUsed: NvmlGpuMemoryBytesUsedMetricConfig{
 						Enabled:             true,
 						AggregationStrategy: AggregationStrategyAvg,
 						EnabledAttributes:   []NvmlGpuMemoryBytesUsedMetricAttributeKey{NvmlGpuMemoryBytesUsedMetricAttributeKeyModel, NvmlGpuMemoryBytesUsedMetricAttributeKeyGpuNumber, NvmlGpuMemoryBytesUsedMetricAttributeKeyUUID, NvmlGpuMemoryBytesUsedMetricAttributeKeyMemoryState},
 					},
+					NvmlGpuPcieThroughput: NvmlGpuPcieThroughputMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []NvmlGpuPcieThroughputMetricAttributeKey{NvmlGpuPcieThroughputMetricAttributeKeyModel, NvmlGpuPcieThroughputMetricAttributeKeyGpuNumber, NvmlGpuPcieThroughputMetricAttributeKeyUUID, NvmlGpuPcieThroughputMetricAttributeKeyDirection},
+					},
+					NvmlGpuPowerUsage: NvmlGpuPowerUsageMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []NvmlGpuPowerUsageMetricAttributeKey{NvmlGpuPowerUsageMetricAttributeKeyModel, NvmlGpuPowerUsageMetricAttributeKeyGpuNumber, NvmlGpuPowerUsageMetricAttributeKeyUUID},
+					},
 					NvmlGpuProcessesMaxBytesUsed: NvmlGpuProcessesMaxBytesUsedMetricConfig{
 						Enabled:             true,
 						AggregationStrategy: AggregationStrategyAvg,
@@ -41,6 +81,11 @@ func TestMetricsBuilderConfig(t *testing.T) {
 						AggregationStrategy: AggregationStrategyAvg,
 						EnabledAttributes:   []NvmlGpuProcessesUtilizationMetricAttributeKey{NvmlGpuProcessesUtilizationMetricAttributeKeyModel, NvmlGpuProcessesUtilizationMetricAttributeKeyGpuNumber, NvmlGpuProcessesUtilizationMetricAttributeKeyUUID, NvmlGpuProcessesUtilizationMetricAttributeKeyPid, NvmlGpuProcessesUtilizationMetricAttributeKeyProcess, NvmlGpuProcessesUtilizationMetricAttributeKeyCommand, NvmlGpuProcessesUtilizationMetricAttributeKeyCommandLine, NvmlGpuProcessesUtilizationMetricAttributeKeyOwner},
 					},
+					NvmlGpuTemperature: NvmlGpuTemperatureMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []NvmlGpuTemperatureMetricAttributeKey{NvmlGpuTemperatureMetricAttributeKeyModel, NvmlGpuTemperatureMetricAttributeKeyGpuNumber, NvmlGpuTemperatureMetricAttributeKeyUUID},
+					},
 					NvmlGpuUtilization: NvmlGpuUtilizationMetricConfig{
 						Enabled:             true,
 						AggregationStrategy: AggregationStrategyAvg,
@@ -53,11 +98,51 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "none_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
+					NvmlGpuAvailable: NvmlGpuAvailableMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []NvmlGpuAvailableMetricAttributeKey{NvmlGpuAvailableMetricAttributeKeyModel, NvmlGpuAvailableMetricAttributeKeyGpuNumber, NvmlGpuAvailableMetricAttributeKeyUUID},
+					},
+					NvmlGpuClockFrequency: NvmlGpuClockFrequencyMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []NvmlGpuClockFrequencyMetricAttributeKey{NvmlGpuClockFrequencyMetricAttributeKeyModel, NvmlGpuClockFrequencyMetricAttributeKeyGpuNumber, NvmlGpuClockFrequencyMetricAttributeKeyUUID, NvmlGpuClockFrequencyMetricAttributeKeyClockType},
+					},
+					NvmlGpuClockThrottleReason: NvmlGpuClockThrottleReasonMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []NvmlGpuClockThrottleReasonMetricAttributeKey{NvmlGpuClockThrottleReasonMetricAttributeKeyModel, NvmlGpuClockThrottleReasonMetricAttributeKeyGpuNumber, NvmlGpuClockThrottleReasonMetricAttributeKeyUUID, NvmlGpuClockThrottleReasonMetricAttributeKeyThrottleReason},
+					},
+					NvmlGpuEccErrors: NvmlGpuEccErrorsMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategySum,
+						EnabledAttributes:   []NvmlGpuEccErrorsMetricAttributeKey{NvmlGpuEccErrorsMetricAttributeKeyModel, NvmlGpuEccErrorsMetricAttributeKeyGpuNumber, NvmlGpuEccErrorsMetricAttributeKeyUUID, NvmlGpuEccErrorsMetricAttributeKeyErrorType},
+					},
+					NvmlGpuEnergyConsumption: NvmlGpuEnergyConsumptionMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategySum,
+						EnabledAttributes:   []NvmlGpuEnergyConsumptionMetricAttributeKey{NvmlGpuEnergyConsumptionMetricAttributeKeyModel, NvmlGpuEnergyConsumptionMetricAttributeKeyGpuNumber, NvmlGpuEnergyConsumptionMetricAttributeKeyUUID},
+					},
+					NvmlGpuFanSpeed: NvmlGpuFanSpeedMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []NvmlGpuFanSpeedMetricAttributeKey{NvmlGpuFanSpeedMetricAttributeKeyModel, NvmlGpuFanSpeedMetricAttributeKeyGpuNumber, NvmlGpuFanSpeedMetricAttributeKeyUUID},
+					},
 					NvmlGpuMemoryBytesUsed: NvmlGpuMemoryBytesUsedMetricConfig{
 						Enabled:             false,
 						AggregationStrategy: AggregationStrategyAvg,
 						EnabledAttributes:   []NvmlGpuMemoryBytesUsedMetricAttributeKey{NvmlGpuMemoryBytesUsedMetricAttributeKeyModel, NvmlGpuMemoryBytesUsedMetricAttributeKeyGpuNumber, NvmlGpuMemoryBytesUsedMetricAttributeKeyUUID, NvmlGpuMemoryBytesUsedMetricAttributeKeyMemoryState},
 					},
+					NvmlGpuPcieThroughput: NvmlGpuPcieThroughputMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []NvmlGpuPcieThroughputMetricAttributeKey{NvmlGpuPcieThroughputMetricAttributeKeyModel, NvmlGpuPcieThroughputMetricAttributeKeyGpuNumber, NvmlGpuPcieThroughputMetricAttributeKeyUUID, NvmlGpuPcieThroughputMetricAttributeKeyDirection},
+					},
+					NvmlGpuPowerUsage: NvmlGpuPowerUsageMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []NvmlGpuPowerUsageMetricAttributeKey{NvmlGpuPowerUsageMetricAttributeKeyModel, NvmlGpuPowerUsageMetricAttributeKeyGpuNumber, NvmlGpuPowerUsageMetricAttributeKeyUUID},
+					},
 					NvmlGpuProcessesMaxBytesUsed: NvmlGpuProcessesMaxBytesUsedMetricConfig{
 						Enabled:             false,
 						AggregationStrategy: AggregationStrategyAvg,
@@ -68,6 +153,11 @@ func TestMetricsBuilderConfig(t *testing.T) {
 						AggregationStrategy: AggregationStrategyAvg,
 						EnabledAttributes:   []NvmlGpuProcessesUtilizationMetricAttributeKey{NvmlGpuProcessesUtilizationMetricAttributeKeyModel, NvmlGpuProcessesUtilizationMetricAttributeKeyGpuNumber, NvmlGpuProcessesUtilizationMetricAttributeKeyUUID, NvmlGpuProcessesUtilizationMetricAttributeKeyPid, NvmlGpuProcessesUtilizationMetricAttributeKeyProcess, NvmlGpuProcessesUtilizationMetricAttributeKeyCommand, NvmlGpuProcessesUtilizationMetricAttributeKeyCommandLine, NvmlGpuProcessesUtilizationMetricAttributeKeyOwner},
 					},
+					NvmlGpuTemperature: NvmlGpuTemperatureMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []NvmlGpuTemperatureMetricAttributeKey{NvmlGpuTemperatureMetricAttributeKeyModel, NvmlGpuTemperatureMetricAttributeKeyGpuNumber, NvmlGpuTemperatureMetricAttributeKeyUUID},
+					},
 					NvmlGpuUtilization: NvmlGpuUtilizationMetricConfig{
 						Enabled:             false,
 						AggregationStrategy: AggregationStrategyAvg,
@@ -80,11 +170,83 @@ func TestMetricsBuilderConfig(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			cfg := loadMetricsBuilderConfig(t, tt.name)
-			diff := cmp.Diff(tt.want, cfg, cmpopts.IgnoreUnexported(NvmlGpuMemoryBytesUsedMetricConfig{}, NvmlGpuProcessesMaxBytesUsedMetricConfig{}, NvmlGpuProcessesUtilizationMetricConfig{}, NvmlGpuUtilizationMetricConfig{}))
+			diff := cmp.Diff(tt.want, cfg, cmpopts.IgnoreUnexported(NvmlGpuAvailableMetricConfig{}, NvmlGpuClockFrequencyMetricConfig{}, NvmlGpuClockThrottleReasonMetricConfig{}, NvmlGpuEccErrorsMetricConfig{}, NvmlGpuEnergyConsumptionMetricConfig{}, NvmlGpuFanSpeedMetricConfig{}, NvmlGpuMemoryBytesUsedMetricConfig{}, NvmlGpuPcieThroughputMetricConfig{}, NvmlGpuPowerUsageMetricConfig{}, NvmlGpuProcessesMaxBytesUsedMetricConfig{}, NvmlGpuProcessesUtilizationMetricConfig{}, NvmlGpuTemperatureMetricConfig{}, NvmlGpuUtilizationMetricConfig{}))
 			require.Emptyf(t, diff, "Config mismatch (-expected +actual):\n%s", diff)
 		})
 	}
 }
+func TestNvmlGpuAvailableMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().NvmlGpuAvailable
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []NvmlGpuAvailableMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric nvml.gpu.available doesn't have an attribute invalid, valid attributes: [model, gpu_number, uuid]")
+
+	cfg = DefaultMetricsConfig().NvmlGpuAvailable
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestNvmlGpuClockFrequencyMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().NvmlGpuClockFrequency
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []NvmlGpuClockFrequencyMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric nvml.gpu.clock.frequency doesn't have an attribute invalid, valid attributes: [model, gpu_number, uuid, clock_type]")
+
+	cfg = DefaultMetricsConfig().NvmlGpuClockFrequency
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestNvmlGpuClockThrottleReasonMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().NvmlGpuClockThrottleReason
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []NvmlGpuClockThrottleReasonMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric nvml.gpu.clock.throttle_reason doesn't have an attribute invalid, valid attributes: [model, gpu_number, uuid, throttle_reason]")
+
+	cfg = DefaultMetricsConfig().NvmlGpuClockThrottleReason
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestNvmlGpuEccErrorsMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().NvmlGpuEccErrors
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []NvmlGpuEccErrorsMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric nvml.gpu.ecc_errors doesn't have an attribute invalid, valid attributes: [model, gpu_number, uuid, error_type]")
+
+	cfg = DefaultMetricsConfig().NvmlGpuEccErrors
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestNvmlGpuEnergyConsumptionMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().NvmlGpuEnergyConsumption
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []NvmlGpuEnergyConsumptionMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric nvml.gpu.energy_consumption doesn't have an attribute invalid, valid attributes: [model, gpu_number, uuid]")
+
+	cfg = DefaultMetricsConfig().NvmlGpuEnergyConsumption
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestNvmlGpuFanSpeedMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().NvmlGpuFanSpeed
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []NvmlGpuFanSpeedMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric nvml.gpu.fan.speed doesn't have an attribute invalid, valid attributes: [model, gpu_number, uuid]")
+
+	cfg = DefaultMetricsConfig().NvmlGpuFanSpeed
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
 func TestNvmlGpuMemoryBytesUsedMetricsConfig_Validate(t *testing.T) {
 	cfg := DefaultMetricsConfig().NvmlGpuMemoryBytesUsed
 	require.NoError(t, cfg.Validate())
@@ -97,6 +259,30 @@ func TestNvmlGpuMemoryBytesUsedMetricsConfig_Validate(t *testing.T) {
 	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
 }
 
+func TestNvmlGpuPcieThroughputMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().NvmlGpuPcieThroughput
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []NvmlGpuPcieThroughputMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric nvml.gpu.pcie.throughput doesn't have an attribute invalid, valid attributes: [model, gpu_number, uuid, direction]")
+
+	cfg = DefaultMetricsConfig().NvmlGpuPcieThroughput
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestNvmlGpuPowerUsageMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().NvmlGpuPowerUsage
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []NvmlGpuPowerUsageMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric nvml.gpu.power.usage doesn't have an attribute invalid, valid attributes: [model, gpu_number, uuid]")
+
+	cfg = DefaultMetricsConfig().NvmlGpuPowerUsage
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
 func TestNvmlGpuProcessesMaxBytesUsedMetricsConfig_Validate(t *testing.T) {
 	cfg := DefaultMetricsConfig().NvmlGpuProcessesMaxBytesUsed
 	require.NoError(t, cfg.Validate())
@@ -121,6 +307,18 @@ func TestNvmlGpuProcessesUtilizationMetricsConfig_Validate(t *testing.T) {
 	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
 }
 
+func TestNvmlGpuTemperatureMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().NvmlGpuTemperature
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []NvmlGpuTemperatureMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric nvml.gpu.temperature doesn't have an attribute invalid, valid attributes: [model, gpu_number, uuid]")
+
+	cfg = DefaultMetricsConfig().NvmlGpuTemperature
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
 func TestNvmlGpuUtilizationMetricsConfig_Validate(t *testing.T) {
 	cfg := DefaultMetricsConfig().NvmlGpuUtilization
 	require.NoError(t, cfg.Validate())
@@ -18,6 +18,84 @@ const (
 	AggregationStrategyMax = "max"
 )
 
+// AttributeClockType specifies the value clock_type attribute.
+type AttributeClockType int
+
+const (
+	_ AttributeClockType = iota
+	AttributeClockTypeSm
+	AttributeClockTypeMemory
+)
+
+// String returns the string representation of the AttributeClockType.
+func (av AttributeClockType) String() string {
+	switch av {
+	case AttributeClockTypeSm:
+		return "sm"
+	case AttributeClockTypeMemory:
+		return "memory"
+	}
+	return ""
+}
+
+// MapAttributeClockType is a helper map of string to AttributeClockType attribute value.
+var MapAttributeClockType = map[string]AttributeClockType{
+	"sm":     AttributeClockTypeSm,
+	"memory": AttributeClockTypeMemory,
+}
+
+// AttributeDirection specifies the value direction attribute.
+type AttributeDirection int
+
+const (
+	_ AttributeDirection = iota
+	AttributeDirectionTransmit
+	AttributeDirectionReceive
+)
+
+// String returns the string representation of the AttributeDirection.
+func (av AttributeDirection) String() string {
+	switch av {
+	case AttributeDirectionTransmit:
+		return "transmit"
+	case AttributeDirectionReceive:
+		return "receive"
+	}
+	return ""
+}
+
+// MapAttributeDirection is a helper map of string to AttributeDirection attribute value.
+var MapAttributeDirection = map[string]AttributeDirection{
+	"transmit": AttributeDirectionTransmit,
+	"receive":  AttributeDirectionReceive,
+}
+
+// AttributeErrorType specifies the value error_type attribute.
+type AttributeErrorType int
+
+const (
+	_ AttributeErrorType = iota
+	AttributeErrorTypeCorrected
+	AttributeErrorTypeUncorrected
+)
+
+// String returns the string representation of the AttributeErrorType.
+func (av AttributeErrorType) String() string {
+	switch av {
+	case AttributeErrorTypeCorrected:
+		return "corrected"
+	case AttributeErrorTypeUncorrected:
+		return "uncorrected"
+	}
+	return ""
+}
+
+// MapAttributeErrorType is a helper map of string to AttributeErrorType attribute value.
+var MapAttributeErrorType = map[string]AttributeErrorType{
+	"corrected":   AttributeErrorTypeCorrected,
+	"uncorrected": AttributeErrorTypeUncorrected,
+}
+
 // AttributeMemoryState specifies the value memory_state attribute.
 type AttributeMemoryState int
 
@@ -44,11 +122,97 @@ var MapAttributeMemoryState = map[string]AttributeMemoryState{
 	"free": AttributeMemoryStateFree,
 }
 
+// AttributeThrottleReason specifies the value throttle_reason attribute.
+type AttributeThrottleReason int
+
+const (
+	_ AttributeThrottleReason = iota
+	AttributeThrottleReasonGpuIdle
+	AttributeThrottleReasonApplicationsClocksSetting
+	AttributeThrottleReasonSwPowerCap
+	AttributeThrottleReasonHwSlowdown
+	AttributeThrottleReasonSyncBoost
+	AttributeThrottleReasonSwThermalSlowdown
+	AttributeThrottleReasonHwThermalSlowdown
+	AttributeThrottleReasonHwPowerBrakeSlowdown
+	AttributeThrottleReasonDisplayClockSetting
+)
+
+// String returns the string representation of the AttributeThrottleReason.
+func (av AttributeThrottleReason) String() string {
+	switch av {
+	case AttributeThrottleReasonGpuIdle:
+		return "gpu_idle"
+	case AttributeThrottleReasonApplicationsClocksSetting:
+		return "applications_clocks_setting"
+	case AttributeThrottleReasonSwPowerCap:
+		return "sw_power_cap"
+	case AttributeThrottleReasonHwSlowdown:
+		return "hw_slowdown"
+	case AttributeThrottleReasonSyncBoost:
+		return "sync_boost"
+	case AttributeThrottleReasonSwThermalSlowdown:
+		return "sw_thermal_slowdown"
+	case AttributeThrottleReasonHwThermalSlowdown:
+		return "hw_thermal_slowdown"
+	case AttributeThrottleReasonHwPowerBrakeSlowdown:
+		return "hw_power_brake_slowdown"
+	case AttributeThrottleReasonDisplayClockSetting:
+		return "display_clock_setting"
+	}
+	return ""
+}
+
+// MapAttributeThrottleReason is a helper map of string to AttributeThrottleReason attribute value.
+var MapAttributeThrottleReason = map[string]AttributeThrottleReason{
+	"gpu_idle":                    AttributeThrottleReasonGpuIdle,
+	"applications_clocks_setting": AttributeThrottleReasonApplicationsClocksSetting,
+	"sw_power_cap":                AttributeThrottleReasonSwPowerCap,
+	"hw_slowdown":                 AttributeThrottleReasonHwSlowdown,
+	"sync_boost":                  AttributeThrottleReasonSyncBoost,
+	"sw_thermal_slowdown":         AttributeThrottleReasonSwThermalSlowdown,
+	"hw_thermal_slowdown":         AttributeThrottleReasonHwThermalSlowdown,
+	"hw_power_brake_slowdown":     AttributeThrottleReasonHwPowerBrakeSlowdown,
+	"display_clock_setting":       AttributeThrottleReasonDisplayClockSetting,
+}
+
 var MetricsInfo = metricsInfo{
+	NvmlGpuAvailable: metricInfo{
+		Name:       "nvml.gpu.available",
+		Attributes: []string{"model", "gpu_number", "uuid"},
+	},
+	NvmlGpuClockFrequency: metricInfo{
+		Name:       "nvml.gpu.clock.frequency",
+		Attributes: []string{"model", "gpu_number", "uuid", "clock_type"},
+	},
+	NvmlGpuClockThrottleReason: metricInfo{
+		Name:       "nvml.gpu.clock.throttle_reason",
+		Attributes: []string{"model", "gpu_number", "uuid", "throttle_reason"},
+	},
+	NvmlGpuEccErrors: metricInfo{
+		Name:       "nvml.gpu.ecc_errors",
+		Attributes: []string{"model", "gpu_number", "uuid", "error_type"},
+	},
+	NvmlGpuEnergyConsumption: metricInfo{
+		Name:       "nvml.gpu.energy_consumption",
+		Attributes: []string{"model", "gpu_number", "uuid"},
+	},
+	NvmlGpuFanSpeed: metricInfo{
+		Name:       "nvml.gpu.fan.speed",
+		Attributes: []string{"model", "gpu_number", "uuid"},
+	},
 	NvmlGpuMemoryBytesUsed: metricInfo{
 		Name:       "nvml.gpu.memory.bytes_used",
 		Attributes: []string{"model", "gpu_number", "uuid", "memory_state"},
 	},
+	NvmlGpuPcieThroughput: metricInfo{
+		Name:       "nvml.gpu.pcie.throughput",
+		Attributes: []string{"model", "gpu_number", "uuid", "direction"},
+	},
+	NvmlGpuPowerUsage: metricInfo{
+		Name:       "nvml.gpu.power.usage",
+		Attributes: []string{"model", "gpu_number", "uuid"},
+	},
 	NvmlGpuProcessesMaxBytesUsed: metricInfo{
 		Name:       "nvml.gpu.processes.max_bytes_used",
 		Attributes: []string{"model", "gpu_number", "uuid", "pid", "process", "command", "command_line", "owner"},
@@ -57,6 +221,10 @@ var MetricsInfo = metricsInfo{
 		Name:       "nvml.gpu.processes.utilization",
 		Attributes: []string{"model", "gpu_number", "uuid", "pid", "process", "command", "command_line", "owner"},
 	},
+	NvmlGpuTemperature: metricInfo{
+		Name:       "nvml.gpu.temperature",
+		Attributes: []string{"model", "gpu_number", "uuid"},
+	},
 	NvmlGpuUtilization: metricInfo{
 		Name:       "nvml.gpu.utilization",
 		Attributes: []string{"model", "gpu_number", "uuid"},
@@ -64,9 +232,18 @@ var MetricsInfo = metricsInfo{
 }
 
 type metricsInfo struct {
+	NvmlGpuAvailable             metricInfo
+	NvmlGpuClockFrequency        metricInfo
+	NvmlGpuClockThrottleReason   metricInfo
+	NvmlGpuEccErrors             metricInfo
+	NvmlGpuEnergyConsumption     metricInfo
+	NvmlGpuFanSpeed              metricInfo
 	NvmlGpuMemoryBytesUsed       metricInfo
+	NvmlGpuPcieThroughput        metricInfo
+	NvmlGpuPowerUsage            metricInfo
 	NvmlGpuProcessesMaxBytesUsed metricInfo
 	NvmlGpuProcessesUtilization  metricInfo
+	NvmlGpuTemperature           metricInfo
 	NvmlGpuUtilization           metricInfo
 }
 
@@ -75,6 +252,589 @@ type metricInfo struct {
 	Attributes []string
 }
 
+type metricNvmlGpuAvailable struct {
+	data          pmetric.Metric               // data buffer for generated metric.
+	config        NvmlGpuAvailableMetricConfig // metric config provided by user.
+	capacity      int                          // max observed number of data points added to the metric.
+	aggDataPoints []int64                      // slice containing number of aggregated datapoints at each index
+}
+
+// init fills nvml.gpu.available metric with initial data.
+func (m *metricNvmlGpuAvailable) init() {
+	m.data.SetName("nvml.gpu.available")
+	m.data.SetDescription("Whether the GPU is available, 1 if it is and 0 if it was lost or removed since it was discovered.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricNvmlGpuAvailable) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, modelAttributeValue string, gpuNumberAttributeValue string, uuidAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, NvmlGpuAvailableMetricAttributeKeyModel) {
+		dp.Attributes().PutStr("model", modelAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, NvmlGpuAvailableMetricAttributeKeyGpuNumber) {
+		dp.Attributes().PutStr("gpu_number", gpuNumberAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, NvmlGpuAvailableMetricAttributeKeyUUID) {
+		dp.Attributes().PutStr("uuid", uuidAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNvmlGpuAvailable) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNvmlGpuAvailable) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNvmlGpuAvailable(cfg NvmlGpuAvailableMetricConfig) metricNvmlGpuAvailable {
+	m := metricNvmlGpuAvailable{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNvmlGpuClockFrequency struct {
+	data          pmetric.Metric                    // data buffer for generated metric.
+	config        NvmlGpuClockFrequencyMetricConfig // metric config provided by user.
+	capacity      int                               // max observed number of data points added to the metric.
+	aggDataPoints []float64                         // slice containing number of aggregated datapoints at each index
+}
+
+// init fills nvml.gpu.clock.frequency metric with initial data.
+func (m *metricNvmlGpuClockFrequency) init() {
+	m.data.SetName("nvml.gpu.clock.frequency")
+	m.data.SetDescription("Current GPU clock frequency by clock domain.")
+	m.data.SetUnit("Hz")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricNvmlGpuClockFrequency) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, modelAttributeValue string, gpuNumberAttributeValue string, uuidAttributeValue string, clockTypeAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, NvmlGpuClockFrequencyMetricAttributeKeyModel) {
+		dp.Attributes().PutStr("model", modelAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, NvmlGpuClockFrequencyMetricAttributeKeyGpuNumber) {
+		dp.Attributes().PutStr("gpu_number", gpuNumberAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, NvmlGpuClockFrequencyMetricAttributeKeyUUID) {
+		dp.Attributes().PutStr("uuid", uuidAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, NvmlGpuClockFrequencyMetricAttributeKeyClockType) {
+		dp.Attributes().PutStr("clock_type", clockTypeAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetDoubleValue(dpi.DoubleValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.DoubleValue() > val {
+					dpi.SetDoubleValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.DoubleValue() < val {
+					dpi.SetDoubleValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetDoubleValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNvmlGpuClockFrequency) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNvmlGpuClockFrequency) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetDoubleValue(m.data.Gauge().DataPoints().At(i).DoubleValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNvmlGpuClockFrequency(cfg NvmlGpuClockFrequencyMetricConfig) metricNvmlGpuClockFrequency {
+	m := metricNvmlGpuClockFrequency{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNvmlGpuClockThrottleReason struct {
+	data          pmetric.Metric                         // data buffer for generated metric.
+	config        NvmlGpuClockThrottleReasonMetricConfig // metric config provided by user.
+	capacity      int                                    // max observed number of data points added to the metric.
+	aggDataPoints []int64                                // slice containing number of aggregated datapoints at each index
+}
+
+// init fills nvml.gpu.clock.throttle_reason metric with initial data.
+func (m *metricNvmlGpuClockThrottleReason) init() {
+	m.data.SetName("nvml.gpu.clock.throttle_reason")
+	m.data.SetDescription("Whether the GPU clocks are currently throttled for the reason, 1 if they are and 0 otherwise.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricNvmlGpuClockThrottleReason) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, modelAttributeValue string, gpuNumberAttributeValue string, uuidAttributeValue string, throttleReasonAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, NvmlGpuClockThrottleReasonMetricAttributeKeyModel) {
+		dp.Attributes().PutStr("model", modelAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, NvmlGpuClockThrottleReasonMetricAttributeKeyGpuNumber) {
+		dp.Attributes().PutStr("gpu_number", gpuNumberAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, NvmlGpuClockThrottleReasonMetricAttributeKeyUUID) {
+		dp.Attributes().PutStr("uuid", uuidAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, NvmlGpuClockThrottleReasonMetricAttributeKeyThrottleReason) {
+		dp.Attributes().PutStr("throttle_reason", throttleReasonAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNvmlGpuClockThrottleReason) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNvmlGpuClockThrottleReason) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNvmlGpuClockThrottleReason(cfg NvmlGpuClockThrottleReasonMetricConfig) metricNvmlGpuClockThrottleReason {
+	m := metricNvmlGpuClockThrottleReason{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNvmlGpuEccErrors struct {
+	data          pmetric.Metric               // data buffer for generated metric.
+	config        NvmlGpuEccErrorsMetricConfig // metric config provided by user.
+	capacity      int                          // max observed number of data points added to the metric.
+	aggDataPoints []int64                      // slice containing number of aggregated datapoints at each index
+}
+
+// init fills nvml.gpu.ecc_errors metric with initial data.
+func (m *metricNvmlGpuEccErrors) init() {
+	m.data.SetName("nvml.gpu.ecc_errors")
+	m.data.SetDescription("Number of ECC errors since the driver was last loaded.")
+	m.data.SetUnit("{errors}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricNvmlGpuEccErrors) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, modelAttributeValue string, gpuNumberAttributeValue string, uuidAttributeValue string, errorTypeAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, NvmlGpuEccErrorsMetricAttributeKeyModel) {
+		dp.Attributes().PutStr("model", modelAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, NvmlGpuEccErrorsMetricAttributeKeyGpuNumber) {
+		dp.Attributes().PutStr("gpu_number", gpuNumberAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, NvmlGpuEccErrorsMetricAttributeKeyUUID) {
+		dp.Attributes().PutStr("uuid", uuidAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, NvmlGpuEccErrorsMetricAttributeKeyErrorType) {
+		dp.Attributes().PutStr("error_type", errorTypeAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Sum().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNvmlGpuEccErrors) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNvmlGpuEccErrors) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Sum().DataPoints().At(i).SetIntValue(m.data.Sum().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNvmlGpuEccErrors(cfg NvmlGpuEccErrorsMetricConfig) metricNvmlGpuEccErrors {
+	m := metricNvmlGpuEccErrors{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNvmlGpuEnergyConsumption struct {
+	data          pmetric.Metric                       // data buffer for generated metric.
+	config        NvmlGpuEnergyConsumptionMetricConfig // metric config provided by user.
+	capacity      int                                  // max observed number of data points added to the metric.
+	aggDataPoints []float64                            // slice containing number of aggregated datapoints at each index
+}
+
+// init fills nvml.gpu.energy_consumption metric with initial data.
+func (m *metricNvmlGpuEnergyConsumption) init() {
+	m.data.SetName("nvml.gpu.energy_consumption")
+	m.data.SetDescription("Total energy consumed by the GPU since the driver was last loaded.")
+	m.data.SetUnit("J")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricNvmlGpuEnergyConsumption) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, modelAttributeValue string, gpuNumberAttributeValue string, uuidAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, NvmlGpuEnergyConsumptionMetricAttributeKeyModel) {
+		dp.Attributes().PutStr("model", modelAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, NvmlGpuEnergyConsumptionMetricAttributeKeyGpuNumber) {
+		dp.Attributes().PutStr("gpu_number", gpuNumberAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, NvmlGpuEnergyConsumptionMetricAttributeKeyUUID) {
+		dp.Attributes().PutStr("uuid", uuidAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Sum().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetDoubleValue(dpi.DoubleValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.DoubleValue() > val {
+					dpi.SetDoubleValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.DoubleValue() < val {
+					dpi.SetDoubleValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetDoubleValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNvmlGpuEnergyConsumption) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNvmlGpuEnergyConsumption) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Sum().DataPoints().At(i).SetDoubleValue(m.data.Sum().DataPoints().At(i).DoubleValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNvmlGpuEnergyConsumption(cfg NvmlGpuEnergyConsumptionMetricConfig) metricNvmlGpuEnergyConsumption {
+	m := metricNvmlGpuEnergyConsumption{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNvmlGpuFanSpeed struct {
+	data          pmetric.Metric              // data buffer for generated metric.
+	config        NvmlGpuFanSpeedMetricConfig // metric config provided by user.
+	capacity      int                         // max observed number of data points added to the metric.
+	aggDataPoints []float64                   // slice containing number of aggregated datapoints at each index
+}
+
+// init fills nvml.gpu.fan.speed metric with initial data.
+func (m *metricNvmlGpuFanSpeed) init() {
+	m.data.SetName("nvml.gpu.fan.speed")
+	m.data.SetDescription("Intended fan speed as a fraction of its maximum speed.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricNvmlGpuFanSpeed) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, modelAttributeValue string, gpuNumberAttributeValue string, uuidAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, NvmlGpuFanSpeedMetricAttributeKeyModel) {
+		dp.Attributes().PutStr("model", modelAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, NvmlGpuFanSpeedMetricAttributeKeyGpuNumber) {
+		dp.Attributes().PutStr("gpu_number", gpuNumberAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, NvmlGpuFanSpeedMetricAttributeKeyUUID) {
+		dp.Attributes().PutStr("uuid", uuidAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetDoubleValue(dpi.DoubleValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.DoubleValue() > val {
+					dpi.SetDoubleValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.DoubleValue() < val {
+					dpi.SetDoubleValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetDoubleValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNvmlGpuFanSpeed) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNvmlGpuFanSpeed) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetDoubleValue(m.data.Gauge().DataPoints().At(i).DoubleValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNvmlGpuFanSpeed(cfg NvmlGpuFanSpeedMetricConfig) metricNvmlGpuFanSpeed {
+	m := metricNvmlGpuFanSpeed{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricNvmlGpuMemoryBytesUsed struct {
 	data          pmetric.Metric                     // data buffer for generated metric.
 	config        NvmlGpuMemoryBytesUsedMetricConfig // metric config provided by user.
@@ -173,6 +933,199 @@ func newMetricNvmlGpuMemoryBytesUsed(cfg NvmlGpuMemoryBytesUsedMetricConfig) met
 	return m
 }
 
+type metricNvmlGpuPcieThroughput struct {
+	data          pmetric.Metric                    // data buffer for generated metric.
+	config        NvmlGpuPcieThroughputMetricConfig // metric config provided by user.
+	capacity      int                               // max observed number of data points added to the metric.
+	aggDataPoints []int64                           // slice containing number of aggregated datapoints at each index
+}
+
+// init fills nvml.gpu.pcie.throughput metric with initial data.
+func (m *metricNvmlGpuPcieThroughput) init() {
+	m.data.SetName("nvml.gpu.pcie.throughput")
+	m.data.SetDescription("PCIe throughput by direction, sampled over 20ms.")
+	m.data.SetUnit("By/s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricNvmlGpuPcieThroughput) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, modelAttributeValue string, gpuNumberAttributeValue string, uuidAttributeValue string, directionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, NvmlGpuPcieThroughputMetricAttributeKeyModel) {
+		dp.Attributes().PutStr("model", modelAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, NvmlGpuPcieThroughputMetricAttributeKeyGpuNumber) {
+		dp.Attributes().PutStr("gpu_number", gpuNumberAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, NvmlGpuPcieThroughputMetricAttributeKeyUUID) {
+		dp.Attributes().PutStr("uuid", uuidAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, NvmlGpuPcieThroughputMetricAttributeKeyDirection) {
+		dp.Attributes().PutStr("direction", directionAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNvmlGpuPcieThroughput) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNvmlGpuPcieThroughput) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNvmlGpuPcieThroughput(cfg NvmlGpuPcieThroughputMetricConfig) metricNvmlGpuPcieThroughput {
+	m := metricNvmlGpuPcieThroughput{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNvmlGpuPowerUsage struct {
+	data          pmetric.Metric                // data buffer for generated metric.
+	config        NvmlGpuPowerUsageMetricConfig // metric config provided by user.
+	capacity      int                           // max observed number of data points added to the metric.
+	aggDataPoints []float64                     // slice containing number of aggregated datapoints at each index
+}
+
+// init fills nvml.gpu.power.usage metric with initial data.
+func (m *metricNvmlGpuPowerUsage) init() {
+	m.data.SetName("nvml.gpu.power.usage")
+	m.data.SetDescription("Current power draw of the GPU and its associated circuitry.")
+	m.data.SetUnit("W")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricNvmlGpuPowerUsage) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, modelAttributeValue string, gpuNumberAttributeValue string, uuidAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, NvmlGpuPowerUsageMetricAttributeKeyModel) {
+		dp.Attributes().PutStr("model", modelAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, NvmlGpuPowerUsageMetricAttributeKeyGpuNumber) {
+		dp.Attributes().PutStr("gpu_number", gpuNumberAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, NvmlGpuPowerUsageMetricAttributeKeyUUID) {
+		dp.Attributes().PutStr("uuid", uuidAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetDoubleValue(dpi.DoubleValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.DoubleValue() > val {
+					dpi.SetDoubleValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.DoubleValue() < val {
+					dpi.SetDoubleValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetDoubleValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNvmlGpuPowerUsage) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNvmlGpuPowerUsage) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetDoubleValue(m.data.Gauge().DataPoints().At(i).DoubleValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNvmlGpuPowerUsage(cfg NvmlGpuPowerUsageMetricConfig) metricNvmlGpuPowerUsage {
+	m := metricNvmlGpuPowerUsage{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricNvmlGpuProcessesMaxBytesUsed struct {
 	data          pmetric.Metric                           // data buffer for generated metric.
 	config        NvmlGpuProcessesMaxBytesUsedMetricConfig // metric config provided by user.
@@ -393,6 +1346,101 @@ func newMetricNvmlGpuProcessesUtilization(cfg NvmlGpuProcessesUtilizationMetricC
 	return m
 }
 
+type metricNvmlGpuTemperature struct {
+	data          pmetric.Metric                 // data buffer for generated metric.
+	config        NvmlGpuTemperatureMetricConfig // metric config provided by user.
+	capacity      int                            // max observed number of data points added to the metric.
+	aggDataPoints []float64                      // slice containing number of aggregated datapoints at each index
+}
+
+// init fills nvml.gpu.temperature metric with initial data.
+func (m *metricNvmlGpuTemperature) init() {
+	m.data.SetName("nvml.gpu.temperature")
+	m.data.SetDescription("Current temperature of the GPU die.")
+	m.data.SetUnit("Cel")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricNvmlGpuTemperature) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, modelAttributeValue string, gpuNumberAttributeValue string, uuidAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, NvmlGpuTemperatureMetricAttributeKeyModel) {
+		dp.Attributes().PutStr("model", modelAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, NvmlGpuTemperatureMetricAttributeKeyGpuNumber) {
+		dp.Attributes().PutStr("gpu_number", gpuNumberAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, NvmlGpuTemperatureMetricAttributeKeyUUID) {
+		dp.Attributes().PutStr("uuid", uuidAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetDoubleValue(dpi.DoubleValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.DoubleValue() > val {
+					dpi.SetDoubleValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.DoubleValue() < val {
+					dpi.SetDoubleValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetDoubleValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNvmlGpuTemperature) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNvmlGpuTemperature) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetDoubleValue(m.data.Gauge().DataPoints().At(i).DoubleValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNvmlGpuTemperature(cfg NvmlGpuTemperatureMetricConfig) metricNvmlGpuTemperature {
+	m := metricNvmlGpuTemperature{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricNvmlGpuUtilization struct {
 	data          pmetric.Metric                 // data buffer for generated metric.
 	config        NvmlGpuUtilizationMetricConfig // metric config provided by user.
@@ -496,9 +1544,18 @@ type MetricsBuilder struct {
 	metricsCapacity                    int                  // maximum observed number of metrics per resource.
 	metricsBuffer                      pmetric.Metrics      // accumulates metrics data before emitting.
 	buildInfo                          component.BuildInfo  // contains version information.
+	metricNvmlGpuAvailable             metricNvmlGpuAvailable
+	metricNvmlGpuClockFrequency        metricNvmlGpuClockFrequency
+	metricNvmlGpuClockThrottleReason   metricNvmlGpuClockThrottleReason
+	metricNvmlGpuEccErrors             metricNvmlGpuEccErrors
+	metricNvmlGpuEnergyConsumption     metricNvmlGpuEnergyConsumption
+	metricNvmlGpuFanSpeed              metricNvmlGpuFanSpeed
 	metricNvmlGpuMemoryBytesUsed       metricNvmlGpuMemoryBytesUsed
+	metricNvmlGpuPcieThroughput        metricNvmlGpuPcieThroughput
+	metricNvmlGpuPowerUsage            metricNvmlGpuPowerUsage
 	metricNvmlGpuProcessesMaxBytesUsed metricNvmlGpuProcessesMaxBytesUsed
 	metricNvmlGpuProcessesUtilization  metricNvmlGpuProcessesUtilization
+	metricNvmlGpuTemperature           metricNvmlGpuTemperature
 	metricNvmlGpuUtilization           metricNvmlGpuUtilization
 }
 
@@ -525,9 +1582,18 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 		startTime:                          pcommon.NewTimestampFromTime(time.Now()),
 		metricsBuffer:                      pmetric.NewMetrics(),
 		buildInfo:                          settings.BuildInfo,
+		metricNvmlGpuAvailable:             newMetricNvmlGpuAvailable(mbc.Metrics.NvmlGpuAvailable),
+		metricNvmlGpuClockFrequency:        newMetricNvmlGpuClockFrequency(mbc.Metrics.NvmlGpuClockFrequency),
+		metricNvmlGpuClockThrottleReason:   newMetricNvmlGpuClockThrottleReason(mbc.Metrics.NvmlGpuClockThrottleReason),
+		metricNvmlGpuEccErrors:             newMetricNvmlGpuEccErrors(mbc.Metrics.NvmlGpuEccErrors),
+		metricNvmlGpuEnergyConsumption:     newMetricNvmlGpuEnergyConsumption(mbc.Metrics.NvmlGpuEnergyConsumption),
+		metricNvmlGpuFanSpeed:              newMetricNvmlGpuFanSpeed(mbc.Metrics.NvmlGpuFanSpeed),
 		metricNvmlGpuMemoryBytesUsed:       newMetricNvmlGpuMemoryBytesUsed(mbc.Metrics.NvmlGpuMemoryBytesUsed),
+		metricNvmlGpuPcieThroughput:        newMetricNvmlGpuPcieThroughput(mbc.Metrics.NvmlGpuPcieThroughput),
+		metricNvmlGpuPowerUsage:            newMetricNvmlGpuPowerUsage(mbc.Metrics.NvmlGpuPowerUsage),
 		metricNvmlGpuProcessesMaxBytesUsed: newMetricNvmlGpuProcessesMaxBytesUsed(mbc.Metrics.NvmlGpuProcessesMaxBytesUsed),
 		metricNvmlGpuProcessesUtilization:  newMetricNvmlGpuProcessesUtilization(mbc.Metrics.NvmlGpuProcessesUtilization),
+		metricNvmlGpuTemperature:           newMetricNvmlGpuTemperature(mbc.Metrics.NvmlGpuTemperature),
 		metricNvmlGpuUtilization:           newMetricNvmlGpuUtilization(mbc.Metrics.NvmlGpuUtilization),
 	}
 
@@ -594,9 +1660,18 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	ils.Scope().SetName(ScopeName)
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
+	mb.metricNvmlGpuAvailable.emit(ils.Metrics())
+	mb.metricNvmlGpuClockFrequency.emit(ils.Metrics())
+	mb.metricNvmlGpuClockThrottleReason.emit(ils.Metrics())
+	mb.metricNvmlGpuEccErrors.emit(ils.Metrics())
+	mb.metricNvmlGpuEnergyConsumption.emit(ils.Metrics())
+	mb.metricNvmlGpuFanSpeed.emit(ils.Metrics())
 	mb.metricNvmlGpuMemoryBytesUsed.emit(ils.Metrics())
+	mb.metricNvmlGpuPcieThroughput.emit(ils.Metrics())
+	mb.metricNvmlGpuPowerUsage.emit(ils.Metrics())
 	mb.metricNvmlGpuProcessesMaxBytesUsed.emit(ils.Metrics())
 	mb.metricNvmlGpuProcessesUtilization.emit(ils.Metrics())
+	mb.metricNvmlGpuTemperature.emit(ils.Metrics())
 	mb.metricNvmlGpuUtilization.emit(ils.Metrics())
 
 	for _, op := range options {
@@ -619,11 +1694,51 @@ func (mb *MetricsBuilder) Emit(options ...ResourceMetricsOption) pmetric.Metrics
 	return metrics
 }
 
+// RecordNvmlGpuAvailableDataPoint adds a data point to nvml.gpu.available metric.
+func (mb *MetricsBuilder) RecordNvmlGpuAvailableDataPoint(ts pcommon.Timestamp, val int64, modelAttributeValue string, gpuNumberAttributeValue string, uuidAttributeValue string) {
+	mb.metricNvmlGpuAvailable.recordDataPoint(mb.startTime, ts, val, modelAttributeValue, gpuNumberAttributeValue, uuidAttributeValue)
+}
+
+// RecordNvmlGpuClockFrequencyDataPoint adds a data point to nvml.gpu.clock.frequency metric.
+func (mb *MetricsBuilder) RecordNvmlGpuClockFrequencyDataPoint(ts pcommon.Timestamp, val float64, modelAttributeValue string, gpuNumberAttributeValue string, uuidAttributeValue string, clockTypeAttributeValue AttributeClockType) {
+	mb.metricNvmlGpuClockFrequency.recordDataPoint(mb.startTime, ts, val, modelAttributeValue, gpuNumberAttributeValue, uuidAttributeValue, clockTypeAttributeValue.String())
+}
+
+// RecordNvmlGpuClockThrottleReasonDataPoint adds a data point to nvml.gpu.clock.throttle_reason metric.
+func (mb *MetricsBuilder) RecordNvmlGpuClockThrottleReasonDataPoint(ts pcommon.Timestamp, val int64, modelAttributeValue string, gpuNumberAttributeValue string, uuidAttributeValue string, throttleReasonAttributeValue AttributeThrottleReason) {
+	mb.metricNvmlGpuClockThrottleReason.recordDataPoint(mb.startTime, ts, val, modelAttributeValue, gpuNumberAttributeValue, uuidAttributeValue, throttleReasonAttributeValue.String())
+}
+
+// RecordNvmlGpuEccErrorsDataPoint adds a data point to nvml.gpu.ecc_errors metric.
+func (mb *MetricsBuilder) RecordNvmlGpuEccErrorsDataPoint(ts pcommon.Timestamp, val int64, modelAttributeValue string, gpuNumberAttributeValue string, uuidAttributeValue string, errorTypeAttributeValue AttributeErrorType) {
+	mb.metricNvmlGpuEccErrors.recordDataPoint(mb.startTime, ts, val, modelAttributeValue, gpuNumberAttributeValue, uuidAttributeValue, errorTypeAttributeValue.String())
+}
+
+// RecordNvmlGpuEnergyConsumptionDataPoint adds a data point to nvml.gpu.energy_consumption metric.
+func (mb *MetricsBuilder) RecordNvmlGpuEnergyConsumptionDataPoint(ts pcommon.Timestamp, val float64, modelAttributeValue string, gpuNumberAttributeValue string, uuidAttributeValue string) {
+	mb.metricNvmlGpuEnergyConsumption.recordDataPoint(mb.startTime, ts, val, modelAttributeValue, gpuNumberAttributeValue, uuidAttributeValue)
+}
+
+// RecordNvmlGpuFanSpeedDataPoint adds a data point to nvml.gpu.fan.speed metric.
+func (mb *MetricsBuilder) RecordNvmlGpuFanSpeedDataPoint(ts pcommon.Timestamp, val float64, modelAttributeValue string, gpuNumberAttributeValue string, uuidAttributeValue string) {
+	mb.metricNvmlGpuFanSpeed.recordDataPoint(mb.startTime, ts, val, modelAttributeValue, gpuNumberAttributeValue, uuidAttributeValue)
+}
+
 // RecordNvmlGpuMemoryBytesUsedDataPoint adds a data point to nvml.gpu.memory.bytes_used metric.
 func (mb *MetricsBuilder) RecordNvmlGpuMemoryBytesUsedDataPoint(ts pcommon.Timestamp, val int64, modelAttributeValue string, gpuNumberAttributeValue string, uuidAttributeValue string, memoryStateAttributeValue AttributeMemoryState) {
 	mb.metricNvmlGpuMemoryBytesUsed.recordDataPoint(mb.startTime, ts, val, modelAttributeValue, gpuNumberAttributeValue, uuidAttributeValue, memoryStateAttributeValue.String())
 }
 
+// RecordNvmlGpuPcieThroughputDataPoint adds a data point to nvml.gpu.pcie.throughput metric.
+func (mb *MetricsBuilder) RecordNvmlGpuPcieThroughputDataPoint(ts pcommon.Timestamp, val int64, modelAttributeValue string, gpuNumberAttributeValue string, uuidAttributeValue string, directionAttributeValue AttributeDirection) {
+	mb.metricNvmlGpuPcieThroughput.recordDataPoint(mb.startTime, ts, val, modelAttributeValue, gpuNumberAttributeValue, uuidAttributeValue, directionAttributeValue.String())
+}
+
+// RecordNvmlGpuPowerUsageDataPoint adds a data point to nvml.gpu.power.usage metric.
+func (mb *MetricsBuilder) RecordNvmlGpuPowerUsageDataPoint(ts pcommon.Timestamp, val float64, modelAttributeValue string, gpuNumberAttributeValue string, uuidAttributeValue string) {
+	mb.metricNvmlGpuPowerUsage.recordDataPoint(mb.startTime, ts, val, modelAttributeValue, gpuNumberAttributeValue, uuidAttributeValue)
+}
+
 // RecordNvmlGpuProcessesMaxBytesUsedDataPoint adds a data point to nvml.gpu.processes.max_bytes_used metric.
 func (mb *MetricsBuilder) RecordNvmlGpuProcessesMaxBytesUsedDataPoint(ts pcommon.Timestamp, val int64, modelAttributeValue string, gpuNumberAttributeValue string, uuidAttributeValue string, pidAttributeValue int64, processAttributeValue string, commandAttributeValue string, commandLineAttributeValue string, ownerAttributeValue string) {
 	mb.metricNvmlGpuProcessesMaxBytesUsed.recordDataPoint(mb.startTime, ts, val, modelAttributeValue, gpuNumberAttributeValue, uuidAttributeValue, pidAttributeValue, processAttributeValue, commandAttributeValue, commandLineAttributeValue, ownerAttributeValue)
@@ -634,6 +1749,11 @@ func (mb *MetricsBuilder) RecordNvmlGpuProcessesUtilizationDataPoint(ts pcommon.
 	mb.metricNvmlGpuProcessesUtilization.recordDataPoint(mb.startTime, ts, val, modelAttributeValue, gpuNumberAttributeValue, uuidAttributeValue, pidAttributeValue, processAttributeValue, commandAttributeValue, commandLineAttributeValue, ownerAttributeValue)
 }
 
+// RecordNvmlGpuTemperatureDataPoint adds a data point to nvml.gpu.temperature metric.
+func (mb *MetricsBuilder) RecordNvmlGpuTemperatureDataPoint(ts pcommon.Timestamp, val float64, modelAttributeValue string, gpuNumberAttributeValue string, uuidAttributeValue string) {
+	mb.metricNvmlGpuTemperature.recordDataPoint(mb.startTime, ts, val, modelAttributeValue, gpuNumberAttributeValue, uuidAttributeValue)
+}
+
 // RecordNvmlGpuUtilizationDataPoint adds a data point to nvml.gpu.utilization metric.
 func (mb *MetricsBuilder) RecordNvmlGpuUtilizationDataPoint(ts pcommon.Timestamp, val float64, modelAttributeValue string, gpuNumberAttributeValue string, uuidAttributeValue string) {
 	mb.metricNvmlGpuUtilization.recordDataPoint(mb.startTime, ts, val, modelAttributeValue, gpuNumberAttributeValue, uuidAttributeValue)
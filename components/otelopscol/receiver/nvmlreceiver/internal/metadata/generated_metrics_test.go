@@ -58,9 +58,18 @@ func TestMetricsBuilder(t *testing.T) {
 			settings.Logger = zap.New(observedZapCore)
 			mb := NewMetricsBuilder(loadMetricsBuilderConfig(t, tt.name), settings, WithStartTime(start))
 			aggMap := make(map[string]string) // contains the aggregation strategies for each metric name
+			aggMap["nvml.gpu.available"] = mb.metricNvmlGpuAvailable.config.AggregationStrategy
+			aggMap["nvml.gpu.clock.frequency"] = mb.metricNvmlGpuClockFrequency.config.AggregationStrategy
+			aggMap["nvml.gpu.clock.throttle_reason"] = mb.metricNvmlGpuClockThrottleReason.config.AggregationStrategy
+			aggMap["nvml.gpu.ecc_errors"] = mb.metricNvmlGpuEccErrors.config.AggregationStrategy
+			aggMap["nvml.gpu.energy_consumption"] = mb.metricNvmlGpuEnergyConsumption.config.AggregationStrategy
+			aggMap["nvml.gpu.fan.speed"] = mb.metricNvmlGpuFanSpeed.config.AggregationStrategy
 			aggMap["nvml.gpu.memory.bytes_used"] = mb.metricNvmlGpuMemoryBytesUsed.config.AggregationStrategy
+			aggMap["nvml.gpu.pcie.throughput"] = mb.metricNvmlGpuPcieThroughput.config.AggregationStrategy
+			aggMap["nvml.gpu.power.usage"] = mb.metricNvmlGpuPowerUsage.config.AggregationStrategy
 			aggMap["nvml.gpu.processes.max_bytes_used"] = mb.metricNvmlGpuProcessesMaxBytesUsed.config.AggregationStrategy
 			aggMap["nvml.gpu.processes.utilization"] = mb.metricNvmlGpuProcessesUtilization.config.AggregationStrategy
+			aggMap["nvml.gpu.temperature"] = mb.metricNvmlGpuTemperature.config.AggregationStrategy
 			aggMap["nvml.gpu.utilization"] = mb.metricNvmlGpuUtilization.config.AggregationStrategy
 
 			expectedWarnings := 0
@@ -72,10 +81,58 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount := 0
 			defaultMetricsCount++
 			allMetricsCount++
+			mb.RecordNvmlGpuAvailableDataPoint(ts, 1, "model-val", "gpu_number-val", "uuid-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordNvmlGpuAvailableDataPoint(ts, 3, "model-val-2", "gpu_number-val-2", "uuid-val-2")
+			}
+
+			allMetricsCount++
+			mb.RecordNvmlGpuClockFrequencyDataPoint(ts, 1, "model-val", "gpu_number-val", "uuid-val", AttributeClockTypeSm)
+			if tt.name == "reaggregate_set" {
+				mb.RecordNvmlGpuClockFrequencyDataPoint(ts, 3, "model-val-2", "gpu_number-val-2", "uuid-val-2", AttributeClockTypeMemory)
+			}
+
+			allMetricsCount++
+			mb.RecordNvmlGpuClockThrottleReasonDataPoint(ts, 1, "model-val", "gpu_number-val", "uuid-val", AttributeThrottleReasonGpuIdle)
+			if tt.name == "reaggregate_set" {
+				mb.RecordNvmlGpuClockThrottleReasonDataPoint(ts, 3, "model-val-2", "gpu_number-val-2", "uuid-val-2", AttributeThrottleReasonApplicationsClocksSetting)
+			}
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordNvmlGpuEccErrorsDataPoint(ts, 1, "model-val", "gpu_number-val", "uuid-val", AttributeErrorTypeCorrected)
+			if tt.name == "reaggregate_set" {
+				mb.RecordNvmlGpuEccErrorsDataPoint(ts, 3, "model-val-2", "gpu_number-val-2", "uuid-val-2", AttributeErrorTypeUncorrected)
+			}
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordNvmlGpuEnergyConsumptionDataPoint(ts, 1, "model-val", "gpu_number-val", "uuid-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordNvmlGpuEnergyConsumptionDataPoint(ts, 3, "model-val-2", "gpu_number-val-2", "uuid-val-2")
+			}
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordNvmlGpuFanSpeedDataPoint(ts, 1, "model-val", "gpu_number-val", "uuid-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordNvmlGpuFanSpeedDataPoint(ts, 3, "model-val-2", "gpu_number-val-2", "uuid-val-2")
+			}
+			defaultMetricsCount++
+			allMetricsCount++
 			mb.RecordNvmlGpuMemoryBytesUsedDataPoint(ts, 1, "model-val", "gpu_number-val", "uuid-val", AttributeMemoryStateUsed)
 			if tt.name == "reaggregate_set" {
 				mb.RecordNvmlGpuMemoryBytesUsedDataPoint(ts, 3, "model-val-2", "gpu_number-val-2", "uuid-val-2", AttributeMemoryStateFree)
 			}
+
+			allMetricsCount++
+			mb.RecordNvmlGpuPcieThroughputDataPoint(ts, 1, "model-val", "gpu_number-val", "uuid-val", AttributeDirectionTransmit)
+			if tt.name == "reaggregate_set" {
+				mb.RecordNvmlGpuPcieThroughputDataPoint(ts, 3, "model-val-2", "gpu_number-val-2", "uuid-val-2", AttributeDirectionReceive)
+			}
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordNvmlGpuPowerUsageDataPoint(ts, 1, "model-val", "gpu_number-val", "uuid-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordNvmlGpuPowerUsageDataPoint(ts, 3, "model-val-2", "gpu_number-val-2", "uuid-val-2")
+			}
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordNvmlGpuProcessesMaxBytesUsedDataPoint(ts, 1, "model-val", "gpu_number-val", "uuid-val", 3, "process-val", "command-val", "command_line-val", "owner-val")
@@ -90,6 +147,12 @@ func TestMetricsBuilder(t *testing.T) {
 			}
 			defaultMetricsCount++
 			allMetricsCount++
+			mb.RecordNvmlGpuTemperatureDataPoint(ts, 1, "model-val", "gpu_number-val", "uuid-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordNvmlGpuTemperatureDataPoint(ts, 3, "model-val-2", "gpu_number-val-2", "uuid-val-2")
+			}
+			defaultMetricsCount++
+			allMetricsCount++
 			mb.RecordNvmlGpuUtilizationDataPoint(ts, 1, "model-val", "gpu_number-val", "uuid-val")
 			if tt.name == "reaggregate_set" {
 				mb.RecordNvmlGpuUtilizationDataPoint(ts, 3, "model-val-2", "gpu_number-val-2", "uuid-val-2")
@@ -98,9 +161,18 @@ func TestMetricsBuilder(t *testing.T) {
 			res := pcommon.NewResource()
 			metrics := mb.Emit(WithResource(res))
 			if tt.name == "reaggregate_set" {
+				assert.Empty(t, mb.metricNvmlGpuAvailable.aggDataPoints)
+				assert.Empty(t, mb.metricNvmlGpuClockFrequency.aggDataPoints)
+				assert.Empty(t, mb.metricNvmlGpuClockThrottleReason.aggDataPoints)
+				assert.Empty(t, mb.metricNvmlGpuEccErrors.aggDataPoints)
+				assert.Empty(t, mb.metricNvmlGpuEnergyConsumption.aggDataPoints)
+				assert.Empty(t, mb.metricNvmlGpuFanSpeed.aggDataPoints)
 				assert.Empty(t, mb.metricNvmlGpuMemoryBytesUsed.aggDataPoints)
+				assert.Empty(t, mb.metricNvmlGpuPcieThroughput.aggDataPoints)
+				assert.Empty(t, mb.metricNvmlGpuPowerUsage.aggDataPoints)
 				assert.Empty(t, mb.metricNvmlGpuProcessesMaxBytesUsed.aggDataPoints)
 				assert.Empty(t, mb.metricNvmlGpuProcessesUtilization.aggDataPoints)
+				assert.Empty(t, mb.metricNvmlGpuTemperature.aggDataPoints)
 				assert.Empty(t, mb.metricNvmlGpuUtilization.aggDataPoints)
 			}
 
@@ -129,6 +201,329 @@ func TestMetricsBuilder(t *testing.T) {
 			validatedMetrics := make(map[string]bool)
 			for _, mi := range allMetricsList {
 				switch mi.Name() {
+				case "nvml.gpu.available":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["nvml.gpu.available"], "Found a duplicate in the metrics slice: nvml.gpu.available")
+						validatedMetrics["nvml.gpu.available"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "Whether the GPU is available, 1 if it is and 0 if it was lost or removed since it was discovered.", mi.Description())
+						assert.Equal(t, "1", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						modelAttrVal, ok := dp.Attributes().Get("model")
+						assert.True(t, ok)
+						assert.Equal(t, "model-val", modelAttrVal.Str())
+						gpuNumberAttrVal, ok := dp.Attributes().Get("gpu_number")
+						assert.True(t, ok)
+						assert.Equal(t, "gpu_number-val", gpuNumberAttrVal.Str())
+						uuidAttrVal, ok := dp.Attributes().Get("uuid")
+						assert.True(t, ok)
+						assert.Equal(t, "uuid-val", uuidAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["nvml.gpu.available"], "Found a duplicate in the metrics slice: nvml.gpu.available")
+						validatedMetrics["nvml.gpu.available"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "Whether the GPU is available, 1 if it is and 0 if it was lost or removed since it was discovered.", mi.Description())
+						assert.Equal(t, "1", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["nvml.gpu.available"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("model")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("gpu_number")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("uuid")
+						assert.False(t, ok)
+					}
+				case "nvml.gpu.clock.frequency":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["nvml.gpu.clock.frequency"], "Found a duplicate in the metrics slice: nvml.gpu.clock.frequency")
+						validatedMetrics["nvml.gpu.clock.frequency"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "Current GPU clock frequency by clock domain.", mi.Description())
+						assert.Equal(t, "Hz", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+						assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+						modelAttrVal, ok := dp.Attributes().Get("model")
+						assert.True(t, ok)
+						assert.Equal(t, "model-val", modelAttrVal.Str())
+						gpuNumberAttrVal, ok := dp.Attributes().Get("gpu_number")
+						assert.True(t, ok)
+						assert.Equal(t, "gpu_number-val", gpuNumberAttrVal.Str())
+						uuidAttrVal, ok := dp.Attributes().Get("uuid")
+						assert.True(t, ok)
+						assert.Equal(t, "uuid-val", uuidAttrVal.Str())
+						clockTypeAttrVal, ok := dp.Attributes().Get("clock_type")
+						assert.True(t, ok)
+						assert.Equal(t, "sm", clockTypeAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["nvml.gpu.clock.frequency"], "Found a duplicate in the metrics slice: nvml.gpu.clock.frequency")
+						validatedMetrics["nvml.gpu.clock.frequency"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "Current GPU clock frequency by clock domain.", mi.Description())
+						assert.Equal(t, "Hz", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+						switch aggMap["nvml.gpu.clock.frequency"] {
+						case "sum":
+							assert.InDelta(t, float64(4), dp.DoubleValue(), 0.01)
+						case "avg":
+							assert.InDelta(t, float64(2), dp.DoubleValue(), 0.01)
+						case "min":
+							assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+						case "max":
+							assert.InDelta(t, float64(3), dp.DoubleValue(), 0.01)
+						}
+						_, ok := dp.Attributes().Get("model")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("gpu_number")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("uuid")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("clock_type")
+						assert.False(t, ok)
+					}
+				case "nvml.gpu.clock.throttle_reason":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["nvml.gpu.clock.throttle_reason"], "Found a duplicate in the metrics slice: nvml.gpu.clock.throttle_reason")
+						validatedMetrics["nvml.gpu.clock.throttle_reason"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "Whether the GPU clocks are currently throttled for the reason, 1 if they are and 0 otherwise.", mi.Description())
+						assert.Equal(t, "1", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						modelAttrVal, ok := dp.Attributes().Get("model")
+						assert.True(t, ok)
+						assert.Equal(t, "model-val", modelAttrVal.Str())
+						gpuNumberAttrVal, ok := dp.Attributes().Get("gpu_number")
+						assert.True(t, ok)
+						assert.Equal(t, "gpu_number-val", gpuNumberAttrVal.Str())
+						uuidAttrVal, ok := dp.Attributes().Get("uuid")
+						assert.True(t, ok)
+						assert.Equal(t, "uuid-val", uuidAttrVal.Str())
+						throttleReasonAttrVal, ok := dp.Attributes().Get("throttle_reason")
+						assert.True(t, ok)
+						assert.Equal(t, "gpu_idle", throttleReasonAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["nvml.gpu.clock.throttle_reason"], "Found a duplicate in the metrics slice: nvml.gpu.clock.throttle_reason")
+						validatedMetrics["nvml.gpu.clock.throttle_reason"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "Whether the GPU clocks are currently throttled for the reason, 1 if they are and 0 otherwise.", mi.Description())
+						assert.Equal(t, "1", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["nvml.gpu.clock.throttle_reason"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("model")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("gpu_number")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("uuid")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("throttle_reason")
+						assert.False(t, ok)
+					}
+				case "nvml.gpu.ecc_errors":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["nvml.gpu.ecc_errors"], "Found a duplicate in the metrics slice: nvml.gpu.ecc_errors")
+						validatedMetrics["nvml.gpu.ecc_errors"] = true
+						assert.Equal(t, pmetric.MetricTypeSum, mi.Type())
+						assert.Equal(t, 1, mi.Sum().DataPoints().Len())
+						assert.Equal(t, "Number of ECC errors since the driver was last loaded.", mi.Description())
+						assert.Equal(t, "{errors}", mi.Unit())
+						assert.True(t, mi.Sum().IsMonotonic())
+						assert.Equal(t, pmetric.AggregationTemporalityCumulative, mi.Sum().AggregationTemporality())
+						dp := mi.Sum().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						modelAttrVal, ok := dp.Attributes().Get("model")
+						assert.True(t, ok)
+						assert.Equal(t, "model-val", modelAttrVal.Str())
+						gpuNumberAttrVal, ok := dp.Attributes().Get("gpu_number")
+						assert.True(t, ok)
+						assert.Equal(t, "gpu_number-val", gpuNumberAttrVal.Str())
+						uuidAttrVal, ok := dp.Attributes().Get("uuid")
+						assert.True(t, ok)
+						assert.Equal(t, "uuid-val", uuidAttrVal.Str())
+						errorTypeAttrVal, ok := dp.Attributes().Get("error_type")
+						assert.True(t, ok)
+						assert.Equal(t, "corrected", errorTypeAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["nvml.gpu.ecc_errors"], "Found a duplicate in the metrics slice: nvml.gpu.ecc_errors")
+						validatedMetrics["nvml.gpu.ecc_errors"] = true
+						assert.Equal(t, pmetric.MetricTypeSum, mi.Type())
+						assert.Equal(t, 1, mi.Sum().DataPoints().Len())
+						assert.Equal(t, "Number of ECC errors since the driver was last loaded.", mi.Description())
+						assert.Equal(t, "{errors}", mi.Unit())
+						assert.True(t, mi.Sum().IsMonotonic())
+						assert.Equal(t, pmetric.AggregationTemporalityCumulative, mi.Sum().AggregationTemporality())
+						dp := mi.Sum().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["nvml.gpu.ecc_errors"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("model")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("gpu_number")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("uuid")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("error_type")
+						assert.False(t, ok)
+					}
+				case "nvml.gpu.energy_consumption":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["nvml.gpu.energy_consumption"], "Found a duplicate in the metrics slice: nvml.gpu.energy_consumption")
+						validatedMetrics["nvml.gpu.energy_consumption"] = true
+						assert.Equal(t, pmetric.MetricTypeSum, mi.Type())
+						assert.Equal(t, 1, mi.Sum().DataPoints().Len())
+						assert.Equal(t, "Total energy consumed by the GPU since the driver was last loaded.", mi.Description())
+						assert.Equal(t, "J", mi.Unit())
+						assert.True(t, mi.Sum().IsMonotonic())
+						assert.Equal(t, pmetric.AggregationTemporalityCumulative, mi.Sum().AggregationTemporality())
+						dp := mi.Sum().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+						assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+						modelAttrVal, ok := dp.Attributes().Get("model")
+						assert.True(t, ok)
+						assert.Equal(t, "model-val", modelAttrVal.Str())
+						gpuNumberAttrVal, ok := dp.Attributes().Get("gpu_number")
+						assert.True(t, ok)
+						assert.Equal(t, "gpu_number-val", gpuNumberAttrVal.Str())
+						uuidAttrVal, ok := dp.Attributes().Get("uuid")
+						assert.True(t, ok)
+						assert.Equal(t, "uuid-val", uuidAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["nvml.gpu.energy_consumption"], "Found a duplicate in the metrics slice: nvml.gpu.energy_consumption")
+						validatedMetrics["nvml.gpu.energy_consumption"] = true
+						assert.Equal(t, pmetric.MetricTypeSum, mi.Type())
+						assert.Equal(t, 1, mi.Sum().DataPoints().Len())
+						assert.Equal(t, "Total energy consumed by the GPU since the driver was last loaded.", mi.Description())
+						assert.Equal(t, "J", mi.Unit())
+						assert.True(t, mi.Sum().IsMonotonic())
+						assert.Equal(t, pmetric.AggregationTemporalityCumulative, mi.Sum().AggregationTemporality())
+						dp := mi.Sum().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+						switch aggMap["nvml.gpu.energy_consumption"] {
+						case "sum":
+							assert.InDelta(t, float64(4), dp.DoubleValue(), 0.01)
+						case "avg":
+							assert.InDelta(t, float64(2), dp.DoubleValue(), 0.01)
+						case "min":
+							assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+						case "max":
+							assert.InDelta(t, float64(3), dp.DoubleValue(), 0.01)
+						}
+						_, ok := dp.Attributes().Get("model")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("gpu_number")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("uuid")
+						assert.False(t, ok)
+					}
+				case "nvml.gpu.fan.speed":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["nvml.gpu.fan.speed"], "Found a duplicate in the metrics slice: nvml.gpu.fan.speed")
+						validatedMetrics["nvml.gpu.fan.speed"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "Intended fan speed as a fraction of its maximum speed.", mi.Description())
+						assert.Equal(t, "1", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+						assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+						modelAttrVal, ok := dp.Attributes().Get("model")
+						assert.True(t, ok)
+						assert.Equal(t, "model-val", modelAttrVal.Str())
+						gpuNumberAttrVal, ok := dp.Attributes().Get("gpu_number")
+						assert.True(t, ok)
+						assert.Equal(t, "gpu_number-val", gpuNumberAttrVal.Str())
+						uuidAttrVal, ok := dp.Attributes().Get("uuid")
+						assert.True(t, ok)
+						assert.Equal(t, "uuid-val", uuidAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["nvml.gpu.fan.speed"], "Found a duplicate in the metrics slice: nvml.gpu.fan.speed")
+						validatedMetrics["nvml.gpu.fan.speed"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "Intended fan speed as a fraction of its maximum speed.", mi.Description())
+						assert.Equal(t, "1", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+						switch aggMap["nvml.gpu.fan.speed"] {
+						case "sum":
+							assert.InDelta(t, float64(4), dp.DoubleValue(), 0.01)
+						case "avg":
+							assert.InDelta(t, float64(2), dp.DoubleValue(), 0.01)
+						case "min":
+							assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+						case "max":
+							assert.InDelta(t, float64(3), dp.DoubleValue(), 0.01)
+						}
+						_, ok := dp.Attributes().Get("model")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("gpu_number")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("uuid")
+						assert.False(t, ok)
+					}
 				case "nvml.gpu.memory.bytes_used":
 					if tt.name != "reaggregate_set" {
 						assert.False(t, validatedMetrics["nvml.gpu.memory.bytes_used"], "Found a duplicate in the metrics slice: nvml.gpu.memory.bytes_used")
@@ -184,6 +579,111 @@ func TestMetricsBuilder(t *testing.T) {
 						_, ok = dp.Attributes().Get("memory_state")
 						assert.False(t, ok)
 					}
+				case "nvml.gpu.pcie.throughput":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["nvml.gpu.pcie.throughput"], "Found a duplicate in the metrics slice: nvml.gpu.pcie.throughput")
+						validatedMetrics["nvml.gpu.pcie.throughput"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "PCIe throughput by direction, sampled over 20ms.", mi.Description())
+						assert.Equal(t, "By/s", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						modelAttrVal, ok := dp.Attributes().Get("model")
+						assert.True(t, ok)
+						assert.Equal(t, "model-val", modelAttrVal.Str())
+						gpuNumberAttrVal, ok := dp.Attributes().Get("gpu_number")
+						assert.True(t, ok)
+						assert.Equal(t, "gpu_number-val", gpuNumberAttrVal.Str())
+						uuidAttrVal, ok := dp.Attributes().Get("uuid")
+						assert.True(t, ok)
+						assert.Equal(t, "uuid-val", uuidAttrVal.Str())
+						directionAttrVal, ok := dp.Attributes().Get("direction")
+						assert.True(t, ok)
+						assert.Equal(t, "transmit", directionAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["nvml.gpu.pcie.throughput"], "Found a duplicate in the metrics slice: nvml.gpu.pcie.throughput")
+						validatedMetrics["nvml.gpu.pcie.throughput"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "PCIe throughput by direction, sampled over 20ms.", mi.Description())
+						assert.Equal(t, "By/s", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["nvml.gpu.pcie.throughput"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("model")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("gpu_number")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("uuid")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("direction")
+						assert.False(t, ok)
+					}
+				case "nvml.gpu.power.usage":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["nvml.gpu.power.usage"], "Found a duplicate in the metrics slice: nvml.gpu.power.usage")
+						validatedMetrics["nvml.gpu.power.usage"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "Current power draw of the GPU and its associated circuitry.", mi.Description())
+						assert.Equal(t, "W", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+						assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+						modelAttrVal, ok := dp.Attributes().Get("model")
+						assert.True(t, ok)
+						assert.Equal(t, "model-val", modelAttrVal.Str())
+						gpuNumberAttrVal, ok := dp.Attributes().Get("gpu_number")
+						assert.True(t, ok)
+						assert.Equal(t, "gpu_number-val", gpuNumberAttrVal.Str())
+						uuidAttrVal, ok := dp.Attributes().Get("uuid")
+						assert.True(t, ok)
+						assert.Equal(t, "uuid-val", uuidAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["nvml.gpu.power.usage"], "Found a duplicate in the metrics slice: nvml.gpu.power.usage")
+						validatedMetrics["nvml.gpu.power.usage"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "Current power draw of the GPU and its associated circuitry.", mi.Description())
+						assert.Equal(t, "W", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+						switch aggMap["nvml.gpu.power.usage"] {
+						case "sum":
+							assert.InDelta(t, float64(4), dp.DoubleValue(), 0.01)
+						case "avg":
+							assert.InDelta(t, float64(2), dp.DoubleValue(), 0.01)
+						case "min":
+							assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+						case "max":
+							assert.InDelta(t, float64(3), dp.DoubleValue(), 0.01)
+						}
+						_, ok := dp.Attributes().Get("model")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("gpu_number")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("uuid")
+						assert.False(t, ok)
+					}
 				case "nvml.gpu.processes.max_bytes_used":
 					if tt.name != "reaggregate_set" {
 						assert.False(t, validatedMetrics["nvml.gpu.processes.max_bytes_used"], "Found a duplicate in the metrics slice: nvml.gpu.processes.max_bytes_used")
@@ -334,6 +834,56 @@ func TestMetricsBuilder(t *testing.T) {
 						_, ok = dp.Attributes().Get("owner")
 						assert.False(t, ok)
 					}
+				case "nvml.gpu.temperature":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["nvml.gpu.temperature"], "Found a duplicate in the metrics slice: nvml.gpu.temperature")
+						validatedMetrics["nvml.gpu.temperature"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "Current temperature of the GPU die.", mi.Description())
+						assert.Equal(t, "Cel", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+						assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+						modelAttrVal, ok := dp.Attributes().Get("model")
+						assert.True(t, ok)
+						assert.Equal(t, "model-val", modelAttrVal.Str())
+						gpuNumberAttrVal, ok := dp.Attributes().Get("gpu_number")
+						assert.True(t, ok)
+						assert.Equal(t, "gpu_number-val", gpuNumberAttrVal.Str())
+						uuidAttrVal, ok := dp.Attributes().Get("uuid")
+						assert.True(t, ok)
+						assert.Equal(t, "uuid-val", uuidAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["nvml.gpu.temperature"], "Found a duplicate in the metrics slice: nvml.gpu.temperature")
+						validatedMetrics["nvml.gpu.temperature"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "Current temperature of the GPU die.", mi.Description())
+						assert.Equal(t, "Cel", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+						switch aggMap["nvml.gpu.temperature"] {
+						case "sum":
+							assert.InDelta(t, float64(4), dp.DoubleValue(), 0.01)
+						case "avg":
+							assert.InDelta(t, float64(2), dp.DoubleValue(), 0.01)
+						case "min":
+							assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+						case "max":
+							assert.InDelta(t, float64(3), dp.DoubleValue(), 0.01)
+						}
+						_, ok := dp.Attributes().Get("model")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("gpu_number")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("uuid")
+						assert.False(t, ok)
+					}
 				case "nvml.gpu.utilization":
 					if tt.name != "reaggregate_set" {
 						assert.False(t, validatedMetrics["nvml.gpu.utilization"], "Found a duplicate in the metrics slice: nvml.gpu.utilization")
@@ -82,7 +82,40 @@ func (s *nvmlScraper) scrape(_ context.Context) (pmetric.Metrics, error) {
 		case "nvml.gpu.memory.bytes_free":
 			s.mb.RecordNvmlGpuMemoryBytesUsedDataPoint(
 				timestamp, metric.asInt64(), model, gpuIndex, UUID, metadata.AttributeMemoryStateFree)
+		case "nvml.gpu.power.usage":
+			s.mb.RecordNvmlGpuPowerUsageDataPoint(
+				timestamp, metric.asFloat64(), model, gpuIndex, UUID)
+		case "nvml.gpu.energy_consumption":
+			s.mb.RecordNvmlGpuEnergyConsumptionDataPoint(
+				timestamp, metric.asFloat64(), model, gpuIndex, UUID)
+		case "nvml.gpu.temperature":
+			s.mb.RecordNvmlGpuTemperatureDataPoint(
+				timestamp, metric.asFloat64(), model, gpuIndex, UUID)
+		case "nvml.gpu.clock.frequency":
+			s.mb.RecordNvmlGpuClockFrequencyDataPoint(
+				timestamp, metric.asFloat64(), model, gpuIndex, UUID, metadata.MapAttributeClockType[metric.attribute])
+		case "nvml.gpu.ecc_errors":
+			s.mb.RecordNvmlGpuEccErrorsDataPoint(
+				timestamp, metric.asInt64(), model, gpuIndex, UUID, metadata.MapAttributeErrorType[metric.attribute])
+		case "nvml.gpu.pcie.throughput":
+			s.mb.RecordNvmlGpuPcieThroughputDataPoint(
+				timestamp, metric.asInt64(), model, gpuIndex, UUID, metadata.MapAttributeDirection[metric.attribute])
+		case "nvml.gpu.fan.speed":
+			s.mb.RecordNvmlGpuFanSpeedDataPoint(
+				timestamp, metric.asFloat64(), model, gpuIndex, UUID)
+		case "nvml.gpu.clock.throttle_reason":
+			s.mb.RecordNvmlGpuClockThrottleReasonDataPoint(
+				timestamp, metric.asInt64(), model, gpuIndex, UUID, metadata.MapAttributeThrottleReason[metric.attribute])
+		}
+	}
+
+	for _, availability := range s.client.collectDeviceAvailability() {
+		var value int64
+		if availability.available {
+			value = 1
 		}
+		s.mb.RecordNvmlGpuAvailableDataPoint(
+			pcommon.NewTimestampFromTime(availability.time), value, availability.model, fmt.Sprintf("%d", availability.gpuIndex), availability.UUID)
 	}
 
 	processMetrics := s.client.collectProcessMetrics()
@@ -178,7 +178,12 @@ func validateScraperResult(t *testing.T, metrics pmetric.Metrics, expectedMetric
 	ms := ilms.At(0).Metrics()
 	for i := 0; i < ms.Len(); i++ {
 		m := ms.At(i)
-		dps := m.Gauge().DataPoints()
+		var dps pmetric.NumberDataPointSlice
+		if m.Type() == pmetric.MetricTypeSum {
+			dps = m.Sum().DataPoints()
+		} else {
+			dps = m.Gauge().DataPoints()
+		}
 		for j := 0; j < dps.Len(); j++ {
 			assert.Regexp(t, ".*gpu_number:.*", dps.At(j).Attributes().AsRaw())
 			assert.Regexp(t, ".*model:.*", dps.At(j).Attributes().AsRaw())
@@ -193,6 +198,16 @@ func validateScraperResult(t *testing.T, metrics pmetric.Metrics, expectedMetric
 			for j := 0; j < dps.Len(); j++ {
 				assert.Regexp(t, ".*memory_state:.*", dps.At(j).Attributes().AsRaw())
 			}
+		case "nvml.gpu.available",
+			"nvml.gpu.power.usage",
+			"nvml.gpu.energy_consumption",
+			"nvml.gpu.temperature",
+			"nvml.gpu.clock.frequency",
+			"nvml.gpu.ecc_errors",
+			"nvml.gpu.pcie.throughput",
+			"nvml.gpu.fan.speed",
+			"nvml.gpu.clock.throttle_reason":
+			// Support for these varies between devices, so they are optional.
 		case "nvml.gpu.processes.utilization":
 			fallthrough
 		case "nvml.gpu.processes.max_bytes_used":
@@ -23,8 +23,11 @@ import (
 
 	"github.com/GoogleCloudPlatform/opentelemetry-operations-collector/components/otelopscol/receiver/nvmlreceiver/internal/metadata"
 	"github.com/NVIDIA/go-nvml/pkg/nvml"
+	"github.com/NVIDIA/go-nvml/pkg/nvml/mock"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 )
 
@@ -43,3 +46,103 @@ func TestScrapeOnLibraryNotFound(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 0, metrics.MetricCount())
 }
+
+func TestScrapeWithMockedDeviceQueries(t *testing.T) {
+	mockDeviceQueries(t)
+
+	scraper := newNvmlScraper(createDefaultConfig().(*Config), receivertest.NewNopSettings(metadata.Type))
+	require.NotNil(t, scraper)
+	scraper.client = newTestNvmlClient(t)
+	scraper.mb = metadata.NewMetricsBuilder(metadata.DefaultMetricsBuilderConfig(), receivertest.NewNopSettings(metadata.Type))
+
+	metrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	// The clock, throttle reason and PCIe metrics are disabled by default.
+	expectedDataPointCount := map[string]int{
+		"nvml.gpu.available":          1,
+		"nvml.gpu.utilization":        1,
+		"nvml.gpu.memory.bytes_used":  2,
+		"nvml.gpu.power.usage":        1,
+		"nvml.gpu.energy_consumption": 1,
+		"nvml.gpu.temperature":        1,
+		"nvml.gpu.ecc_errors":         2,
+		"nvml.gpu.fan.speed":          1,
+	}
+
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, len(expectedDataPointCount), ms.Len())
+	for i := 0; i < ms.Len(); i++ {
+		m := ms.At(i)
+		var dps pmetric.NumberDataPointSlice
+		if m.Type() == pmetric.MetricTypeSum {
+			dps = m.Sum().DataPoints()
+		} else {
+			dps = m.Gauge().DataPoints()
+		}
+		assert.Equal(t, expectedDataPointCount[m.Name()], dps.Len(), m.Name())
+		for j := 0; j < dps.Len(); j++ {
+			attrs := dps.At(j).Attributes()
+			gpuNumber, _ := attrs.Get("gpu_number")
+			assert.Equal(t, "0", gpuNumber.Str())
+			model, _ := attrs.Get("model")
+			assert.Equal(t, "NVIDIA Test GPU", model.Str())
+		}
+	}
+}
+
+func TestScrapeOnGpuLost(t *testing.T) {
+	mockDeviceQueries(t)
+
+	scraper := newNvmlScraper(createDefaultConfig().(*Config), receivertest.NewNopSettings(metadata.Type))
+	require.NotNil(t, scraper)
+	lost := &mock.Device{}
+	scraper.client = newTestNvmlClient(t, lost, &mock.Device{})
+	scraper.mb = metadata.NewMetricsBuilder(metadata.DefaultMetricsBuilderConfig(), receivertest.NewNopSettings(metadata.Type))
+
+	nvmlDeviceGetTemperature = func(device nvml.Device, sensor nvml.TemperatureSensors) (uint32, nvml.Return) {
+		if device == lost {
+			return 0, nvml.ERROR_GPU_IS_LOST
+		}
+		return 45, nvml.SUCCESS
+	}
+
+	_, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	require.Len(t, scraper.client.devices, 2)
+	require.True(t, scraper.client.lostDevices[lost])
+
+	metrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	available := make(map[string]int64)
+	for i := 0; i < ms.Len(); i++ {
+		m := ms.At(i)
+		if m.Name() == "nvml.gpu.available" {
+			dps := m.Gauge().DataPoints()
+			for j := 0; j < dps.Len(); j++ {
+				uuid, _ := dps.At(j).Attributes().Get("uuid")
+				available[uuid.Str()] = dps.At(j).IntValue()
+			}
+			continue
+		}
+		var dps pmetric.NumberDataPointSlice
+		if m.Type() == pmetric.MetricTypeSum {
+			dps = m.Sum().DataPoints()
+		} else {
+			dps = m.Gauge().DataPoints()
+		}
+		// The remaining device keeps its NVML index.
+		for j := 0; j < dps.Len(); j++ {
+			uuid, _ := dps.At(j).Attributes().Get("uuid")
+			assert.Equal(t, "GPU-00000001-0000-0000-0000-000000000000", uuid.Str(), m.Name())
+			gpuNumber, _ := dps.At(j).Attributes().Get("gpu_number")
+			assert.Equal(t, "1", gpuNumber.Str(), m.Name())
+		}
+	}
+	assert.Equal(t, map[string]int64{
+		"GPU-00000000-0000-0000-0000-000000000000": 0,
+		"GPU-00000001-0000-0000-0000-000000000000": 1,
+	}, available)
+}
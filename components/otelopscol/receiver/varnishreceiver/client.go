@@ -16,8 +16,10 @@ package varnishreceiver
 
 import (
 	"encoding/json"
+	"fmt"
 	"os/exec"
 	"path/filepath"
+	"strings"
 
 	"go.opentelemetry.io/collector/component"
 	"go.uber.org/zap"
@@ -49,29 +51,36 @@ type client interface {
 var _ client = (*varnishClient)(nil)
 
 type varnishClient struct {
-	exec   executer
-	cfg    *Config
-	logger *zap.Logger
+	exec     executer
+	cfg      *Config
+	cacheDir string
+	logger   *zap.Logger
 }
 
-// newVarnishClient creates a client.
-func newVarnishClient(cfg *Config, _ component.Host, settings component.TelemetrySettings) client {
+// newVarnishClient creates a client for the varnish instance of cacheDir.
+func newVarnishClient(cfg *Config, cacheDir string, _ component.Host, settings component.TelemetrySettings) client {
 	return &varnishClient{
-		cfg:    cfg,
-		logger: settings.Logger,
-		exec:   newExecuter(),
+		cfg:      cfg,
+		cacheDir: cacheDir,
+		logger:   settings.Logger,
+		exec:     newExecuter(),
 	}
 }
 
 const (
 	varnishStat = "varnishstat"
 	counters    = "counters"
+
+	backendPrefix     = "VBE."
+	mallocPrefix      = "SMA."
+	filePrefix        = "SMF."
+	backendHappyField = "happy"
 )
 
 // BuildCommand builds the exec command statement.
 func (v *varnishClient) BuildCommand() (string, []string) {
 	argList := []string{"-j"}
-	argList = append(argList, "-n", v.cfg.CacheDir)
+	argList = append(argList, "-n", v.cacheDir)
 
 	command := varnishStat
 	if v.cfg.ExecDir != "" {
@@ -94,26 +103,130 @@ func (v *varnishClient) GetStats() (*Stats, error) {
 
 // parseStats parses varnishStats json response into a Stats struct.
 func parseStats(rawStats []byte) (*Stats, error) {
-	raw := make(map[string]interface{})
+	raw := make(map[string]json.RawMessage)
 	if err := json.Unmarshal(rawStats, &raw); err != nil {
 		return nil, err
 	}
 
 	// Varnish 6.5+ nests metrics inside a "counters" field.
 	// https://varnish-cache.org/docs/6.5/whats-new/upgrading-6.5.html#varnishstat
-	if _, ok := raw[counters]; ok {
-		var jsonParsed FullStats
-		if err := json.Unmarshal(rawStats, &jsonParsed); err != nil {
+	if rawCounters, ok := raw[counters]; ok {
+		rawStats = rawCounters
+		raw = make(map[string]json.RawMessage)
+		if err := json.Unmarshal(rawStats, &raw); err != nil {
 			return nil, err
 		}
-
-		return &jsonParsed.Counters, nil
 	}
 
-	var jsonParsed Stats
-	if err := json.Unmarshal(rawStats, &jsonParsed); err != nil {
+	var stats Stats
+	if err := json.Unmarshal(rawStats, &stats); err != nil {
 		return nil, err
 	}
 
-	return &jsonParsed, nil
+	stats.Backends = make(map[BackendKey]*BackendStats)
+	stats.Storages = make(map[string]*StorageStats)
+	for name, rawCounter := range raw {
+		var err error
+		switch {
+		case strings.HasPrefix(name, backendPrefix):
+			err = stats.addBackendCounter(strings.TrimPrefix(name, backendPrefix), rawCounter)
+		case strings.HasPrefix(name, mallocPrefix):
+			err = stats.addStorageCounter(strings.TrimPrefix(name, mallocPrefix), rawCounter)
+		case strings.HasPrefix(name, filePrefix):
+			err = stats.addStorageCounter(strings.TrimPrefix(name, filePrefix), rawCounter)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse counter %s: %w", name, err)
+		}
+	}
+
+	return &stats, nil
+}
+
+// counter is a single varnishstat counter. Values are unsigned, and bitmaps
+// such as the backend "happy" field use all 64 bits.
+type counter struct {
+	Value uint64 `json:"value"`
+}
+
+// splitCounterName splits a counter name without its type prefix into the
+// name of the object it belongs to and the field, e.g. "boot.default.req"
+// into "boot.default" and "req".
+func splitCounterName(name string) (string, string, bool) {
+	i := strings.LastIndex(name, ".")
+	if i <= 0 {
+		return "", "", false
+	}
+	return name[:i], name[i+1:], true
+}
+
+// addBackendCounter adds a VBE.<vcl>.<backend>.<field> counter to the stats
+// of <backend> in <vcl>.
+func (s *Stats) addBackendCounter(name string, rawCounter json.RawMessage) error {
+	object, field, ok := splitCounterName(name)
+	if !ok {
+		return nil
+	}
+	// VCL names can't contain dots, but the names of dynamic backends can.
+	var key BackendKey
+	if vcl, backend, ok := strings.Cut(object, "."); ok {
+		key = BackendKey{VCL: vcl, Name: backend}
+	} else {
+		key = BackendKey{Name: object}
+	}
+
+	var c counter
+	if err := json.Unmarshal(rawCounter, &c); err != nil {
+		return err
+	}
+
+	stats, ok := s.Backends[key]
+	if !ok {
+		stats = &BackendStats{}
+		s.Backends[key] = stats
+	}
+	switch field {
+	case "req":
+		stats.Requests = int64(c.Value)
+	case "bereq_hdrbytes", "bereq_bodybytes":
+		stats.BytesSent += int64(c.Value)
+	case "beresp_hdrbytes", "beresp_bodybytes":
+		stats.BytesReceived += int64(c.Value)
+	case "conn":
+		stats.Connections = int64(c.Value)
+	case backendHappyField:
+		stats.Happy = c.Value
+	}
+	return nil
+}
+
+// addStorageCounter adds a SMA.<storage>.<field> or SMF.<storage>.<field>
+// counter to the stats of <storage>.
+func (s *Stats) addStorageCounter(name string, rawCounter json.RawMessage) error {
+	storage, field, ok := splitCounterName(name)
+	if !ok {
+		return nil
+	}
+
+	var c counter
+	if err := json.Unmarshal(rawCounter, &c); err != nil {
+		return err
+	}
+
+	stats, ok := s.Storages[storage]
+	if !ok {
+		stats = &StorageStats{}
+		s.Storages[storage] = stats
+	}
+	switch field {
+	case "c_req":
+		stats.Allocations = int64(c.Value)
+	case "c_fail":
+		stats.AllocationFailures = int64(c.Value)
+	case "g_bytes":
+		stats.BytesUsed = int64(c.Value)
+	case "g_space":
+		stats.BytesFree = int64(c.Value)
+	}
+	return nil
 }
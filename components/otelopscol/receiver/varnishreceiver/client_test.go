@@ -30,6 +30,7 @@ import (
 func TestNewVarnishClient(t *testing.T) {
 	client := newVarnishClient(
 		createDefaultConfig().(*Config),
+		"/path/varnishinstance",
 		componenttest.NewNopHost(),
 		componenttest.NewNopTelemetrySettings())
 	require.NotNil(t, client)
@@ -80,7 +81,8 @@ func TestBuildCommand(t *testing.T) {
 	for _, tC := range testCases {
 		t.Run(tC.desc, func(t *testing.T) {
 			client := varnishClient{
-				cfg: &tC.config,
+				cfg:      &tC.config,
+				cacheDir: tC.config.CacheDir,
 			}
 			command, argList := client.BuildCommand()
 			require.EqualValues(t, tC.command, command)
@@ -107,11 +109,11 @@ func TestGetStats(t *testing.T) {
 	mockExec := new(mockExecuter)
 	mockExec.On("Execute", "varnishstat", []string{"-j", "-n", "/path/varnishinstance"}).Return(getBytes(t, "mock_response6_0.json"))
 	myclient := varnishClient{
-		exec:   mockExec,
-		cfg:    createDefaultConfig().(*Config),
-		logger: zap.NewNop(),
+		exec:     mockExec,
+		cfg:      createDefaultConfig().(*Config),
+		cacheDir: "/path/varnishinstance",
+		logger:   zap.NewNop(),
 	}
-	myclient.cfg.CacheDir = "/path/varnishinstance"
 	stats, err := myclient.GetStats()
 	require.NoError(t, err)
 	require.NotNil(t, stats)
@@ -119,17 +121,81 @@ func TestGetStats(t *testing.T) {
 	mockExecuter6_5 := new(mockExecuter)
 	mockExecuter6_5.On("Execute", "varnishstat", []string{"-j", "-n", "/path/varnishinstance"}).Return(getBytes(t, "mock_response6_5.json"))
 	myclient6_5 := varnishClient{
-		exec:   mockExecuter6_5,
-		cfg:    createDefaultConfig().(*Config),
-		logger: zap.NewNop(),
+		exec:     mockExecuter6_5,
+		cfg:      createDefaultConfig().(*Config),
+		cacheDir: "/path/varnishinstance",
+		logger:   zap.NewNop(),
 	}
-	myclient6_5.cfg.CacheDir = "/path/varnishinstance"
 	stats6_5, err := myclient6_5.GetStats()
 	require.NoError(t, err)
 	require.NotNil(t, stats)
 	require.EqualValues(t, stats, stats6_5)
 }
 
+func TestParseStats(t *testing.T) {
+	for _, filename := range []string{"mock_response6_0.json", "mock_response6_5.json"} {
+		t.Run(filename, func(t *testing.T) {
+			body, err := getBytes(t, filename)
+			require.NoError(t, err)
+
+			stats, err := parseStats(body)
+			require.NoError(t, err)
+			require.EqualValues(t, 26, stats.MAINClientResp500.Value)
+			require.Equal(t, map[BackendKey]*BackendStats{
+				{VCL: "boot", Name: "default"}: {Requests: 32, BytesSent: 55, BytesReceived: 59, Connections: 31, Happy: 18446744073709551615},
+				{VCL: "boot", Name: "api"}:     {Requests: 38, BytesSent: 67, BytesReceived: 71, Connections: 37, Happy: 2},
+			}, stats.Backends)
+			require.Equal(t, map[string]*StorageStats{
+				"s0":        {Allocations: 39, AllocationFailures: 40, BytesUsed: 41, BytesFree: 42},
+				"Transient": {Allocations: 43, AllocationFailures: 44, BytesUsed: 45, BytesFree: 46},
+				"disk":      {Allocations: 47, AllocationFailures: 48, BytesUsed: 49, BytesFree: 50},
+			}, stats.Storages)
+		})
+	}
+
+	t.Run("backend in several vcls", func(t *testing.T) {
+		stats, err := parseStats([]byte(`{
+			"VBE.boot.default.req": {"value": 1},
+			"VBE.reload_20220511_164023.default.req": {"value": 2},
+			"VBE.boot.default.happy": {"value": 0},
+			"VBE.reload_20220511_164023.default.happy": {"value": 3}
+		}`))
+		require.NoError(t, err)
+		require.Equal(t, map[BackendKey]*BackendStats{
+			{VCL: "boot", Name: "default"}:                   {Requests: 1},
+			{VCL: "reload_20220511_164023", Name: "default"}: {Requests: 2, Happy: 3},
+		}, stats.Backends)
+	})
+
+	t.Run("invalid counter", func(t *testing.T) {
+		_, err := parseStats([]byte(`{"SMA.s0.c_req": {"value": -1}}`))
+		require.ErrorContains(t, err, "failed to parse counter SMA.s0.c_req")
+	})
+}
+
+func TestBackendHealth(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		backend BackendStats
+		probed  bool
+		healthy bool
+		known   bool
+	}{
+		{desc: "last probe succeeded", backend: BackendStats{Happy: 0b1011}, healthy: true, known: true},
+		{desc: "last probe failed", backend: BackendStats{Happy: 0b1010}, healthy: false, known: true},
+		{desc: "all probes failed", backend: BackendStats{Requests: 5}, probed: true, healthy: false, known: true},
+		{desc: "configured probe succeeded", backend: BackendStats{Happy: 1}, probed: true, healthy: true, known: true},
+		{desc: "no probe", backend: BackendStats{Requests: 5}, healthy: false, known: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			healthy, known := tc.backend.health(tc.probed)
+			require.Equal(t, tc.healthy, healthy)
+			require.Equal(t, tc.known, known)
+		})
+	}
+}
+
 type mockExecuter struct {
 	mock.Mock
 }
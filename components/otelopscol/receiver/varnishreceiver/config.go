@@ -15,8 +15,10 @@
 package varnishreceiver
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"go.opentelemetry.io/collector/scraper/scraperhelper"
 
@@ -29,6 +31,16 @@ type Config struct {
 	Metrics                        metadata.MetricsConfig `mapstructure:"metrics"`
 	CacheDir                       string                 `mapstructure:"cache_dir"`
 	ExecDir                        string                 `mapstructure:"exec_dir"`
+	Instances                      []InstanceConfig       `mapstructure:"instances"`
+	// ProbedBackends lists the names of the backends that have a health
+	// probe, so that their health is reported even when all of their recent
+	// probes failed.
+	ProbedBackends []string `mapstructure:"probed_backends"`
+}
+
+// InstanceConfig defines a varnish instance to collect metrics from.
+type InstanceConfig struct {
+	CacheDir string `mapstructure:"cache_dir"`
 }
 
 // Validate validates the config.
@@ -43,6 +55,37 @@ func (c *Config) Validate() error {
 			return fmt.Errorf(`"exec_dir" does not exists: %w`, err)
 		}
 	}
+	if len(c.Instances) > 0 && c.CacheDir != "" {
+		return errors.New(`"cache_dir" and "instances" cannot both be set`)
+	}
+
+	cacheNames := map[string]int{}
+	for i, instance := range c.Instances {
+		if instance.CacheDir == "" {
+			return fmt.Errorf(`"cache_dir" of instance %d is required`, i)
+		}
+		if _, err := os.Stat(instance.CacheDir); err != nil {
+			return fmt.Errorf(`"cache_dir" of instance %d does not exists: %w`, i, err)
+		}
+		name := filepath.Base(instance.CacheDir)
+		if j, ok := cacheNames[name]; ok {
+			return fmt.Errorf(`instances %d and %d have the same cache name %q`, j, i, name)
+		}
+		cacheNames[name] = i
+	}
 
 	return nil
 }
+
+// cacheDirs returns the cache dirs of the varnish instances to collect metrics from.
+func (c *Config) cacheDirs() []string {
+	if len(c.Instances) == 0 {
+		return []string{c.CacheDir}
+	}
+
+	cacheDirs := make([]string, 0, len(c.Instances))
+	for _, instance := range c.Instances {
+		cacheDirs = append(cacheDirs, instance.CacheDir)
+	}
+	return cacheDirs
+}
@@ -15,6 +15,8 @@
 package varnishreceiver
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -22,6 +24,9 @@ import (
 
 func TestValidate(t *testing.T) {
 	testDir := t.TempDir()
+	for _, dir := range []string{"first", "second", filepath.Join("other", "first")} {
+		require.NoError(t, os.MkdirAll(filepath.Join(testDir, dir), 0o755))
+	}
 	testCases := []struct {
 		desc                string
 		cfg                 Config
@@ -55,6 +60,48 @@ func TestValidate(t *testing.T) {
 			},
 			expectedErrContains: "",
 		},
+		{
+			desc: "valid instances",
+			cfg: Config{
+				Instances: []InstanceConfig{
+					{CacheDir: filepath.Join(testDir, "first")},
+					{CacheDir: filepath.Join(testDir, "second")},
+				},
+			},
+			expectedErrContains: "",
+		},
+		{
+			desc: "cache dir and instances",
+			cfg: Config{
+				CacheDir:  testDir,
+				Instances: []InstanceConfig{{CacheDir: filepath.Join(testDir, "first")}},
+			},
+			expectedErrContains: `"cache_dir" and "instances" cannot both be set`,
+		},
+		{
+			desc: "instance without cache dir",
+			cfg: Config{
+				Instances: []InstanceConfig{{}},
+			},
+			expectedErrContains: `"cache_dir" of instance 0 is required`,
+		},
+		{
+			desc: "missing instance cache dir",
+			cfg: Config{
+				Instances: []InstanceConfig{{CacheDir: "missing/cache_dir"}},
+			},
+			expectedErrContains: `"cache_dir" of instance 0 does not exists`,
+		},
+		{
+			desc: "instances with the same cache name",
+			cfg: Config{
+				Instances: []InstanceConfig{
+					{CacheDir: filepath.Join(testDir, "first")},
+					{CacheDir: filepath.Join(testDir, "other", "first")},
+				},
+			},
+			expectedErrContains: `instances 0 and 1 have the same cache name "first"`,
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.desc, func(t *testing.T) {
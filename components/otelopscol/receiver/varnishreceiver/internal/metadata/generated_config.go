@@ -77,6 +77,203 @@ func (ms *VarnishBackendRequestCountMetricConfig) Unmarshal(parser *confmap.Conf
 	return nil
 }
 
+// VarnishBackendServerConnectionCountMetricAttributeKey specifies the key of an attribute for the varnish.backend.server.connection.count metric.
+type VarnishBackendServerConnectionCountMetricAttributeKey string
+
+const (
+	VarnishBackendServerConnectionCountMetricAttributeKeyBackendName VarnishBackendServerConnectionCountMetricAttributeKey = "varnish.backend.name"
+	VarnishBackendServerConnectionCountMetricAttributeKeyVclName     VarnishBackendServerConnectionCountMetricAttributeKey = "varnish.vcl.name"
+)
+
+// VarnishBackendServerConnectionCountMetricConfig provides config for the varnish.backend.server.connection.count metric.
+type VarnishBackendServerConnectionCountMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                                  `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []VarnishBackendServerConnectionCountMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *VarnishBackendServerConnectionCountMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *VarnishBackendServerConnectionCountMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case VarnishBackendServerConnectionCountMetricAttributeKeyBackendName, VarnishBackendServerConnectionCountMetricAttributeKeyVclName:
+		default:
+			return fmt.Errorf("metric varnish.backend.server.connection.count doesn't have an attribute %v, valid attributes: [varnish.backend.name, varnish.vcl.name]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// VarnishBackendServerHealthMetricAttributeKey specifies the key of an attribute for the varnish.backend.server.health metric.
+type VarnishBackendServerHealthMetricAttributeKey string
+
+const (
+	VarnishBackendServerHealthMetricAttributeKeyBackendName VarnishBackendServerHealthMetricAttributeKey = "varnish.backend.name"
+	VarnishBackendServerHealthMetricAttributeKeyVclName     VarnishBackendServerHealthMetricAttributeKey = "varnish.vcl.name"
+)
+
+// VarnishBackendServerHealthMetricConfig provides config for the varnish.backend.server.health metric.
+type VarnishBackendServerHealthMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                         `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []VarnishBackendServerHealthMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *VarnishBackendServerHealthMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *VarnishBackendServerHealthMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case VarnishBackendServerHealthMetricAttributeKeyBackendName, VarnishBackendServerHealthMetricAttributeKeyVclName:
+		default:
+			return fmt.Errorf("metric varnish.backend.server.health doesn't have an attribute %v, valid attributes: [varnish.backend.name, varnish.vcl.name]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// VarnishBackendServerIoMetricAttributeKey specifies the key of an attribute for the varnish.backend.server.io metric.
+type VarnishBackendServerIoMetricAttributeKey string
+
+const (
+	VarnishBackendServerIoMetricAttributeKeyBackendName VarnishBackendServerIoMetricAttributeKey = "varnish.backend.name"
+	VarnishBackendServerIoMetricAttributeKeyVclName     VarnishBackendServerIoMetricAttributeKey = "varnish.vcl.name"
+	VarnishBackendServerIoMetricAttributeKeyIoDirection VarnishBackendServerIoMetricAttributeKey = "direction"
+)
+
+// VarnishBackendServerIoMetricConfig provides config for the varnish.backend.server.io metric.
+type VarnishBackendServerIoMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                     `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []VarnishBackendServerIoMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *VarnishBackendServerIoMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *VarnishBackendServerIoMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case VarnishBackendServerIoMetricAttributeKeyBackendName, VarnishBackendServerIoMetricAttributeKeyVclName, VarnishBackendServerIoMetricAttributeKeyIoDirection:
+		default:
+			return fmt.Errorf("metric varnish.backend.server.io doesn't have an attribute %v, valid attributes: [varnish.backend.name, varnish.vcl.name, direction]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// VarnishBackendServerRequestCountMetricAttributeKey specifies the key of an attribute for the varnish.backend.server.request.count metric.
+type VarnishBackendServerRequestCountMetricAttributeKey string
+
+const (
+	VarnishBackendServerRequestCountMetricAttributeKeyBackendName VarnishBackendServerRequestCountMetricAttributeKey = "varnish.backend.name"
+	VarnishBackendServerRequestCountMetricAttributeKeyVclName     VarnishBackendServerRequestCountMetricAttributeKey = "varnish.vcl.name"
+)
+
+// VarnishBackendServerRequestCountMetricConfig provides config for the varnish.backend.server.request.count metric.
+type VarnishBackendServerRequestCountMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                               `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []VarnishBackendServerRequestCountMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *VarnishBackendServerRequestCountMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *VarnishBackendServerRequestCountMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case VarnishBackendServerRequestCountMetricAttributeKeyBackendName, VarnishBackendServerRequestCountMetricAttributeKeyVclName:
+		default:
+			return fmt.Errorf("metric varnish.backend.server.request.count doesn't have an attribute %v, valid attributes: [varnish.backend.name, varnish.vcl.name]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
 // VarnishCacheOperationCountMetricAttributeKey specifies the key of an attribute for the varnish.cache.operation.count metric.
 type VarnishCacheOperationCountMetricAttributeKey string
 
@@ -349,6 +546,151 @@ func (ms *VarnishSessionCountMetricConfig) Validate() error {
 	return nil
 }
 
+// VarnishStorageAllocationCountMetricAttributeKey specifies the key of an attribute for the varnish.storage.allocation.count metric.
+type VarnishStorageAllocationCountMetricAttributeKey string
+
+const (
+	VarnishStorageAllocationCountMetricAttributeKeyStorageName VarnishStorageAllocationCountMetricAttributeKey = "varnish.storage.name"
+)
+
+// VarnishStorageAllocationCountMetricConfig provides config for the varnish.storage.allocation.count metric.
+type VarnishStorageAllocationCountMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                            `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []VarnishStorageAllocationCountMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *VarnishStorageAllocationCountMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *VarnishStorageAllocationCountMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case VarnishStorageAllocationCountMetricAttributeKeyStorageName:
+		default:
+			return fmt.Errorf("metric varnish.storage.allocation.count doesn't have an attribute %v, valid attributes: [varnish.storage.name]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// VarnishStorageAllocationFailedMetricAttributeKey specifies the key of an attribute for the varnish.storage.allocation.failed metric.
+type VarnishStorageAllocationFailedMetricAttributeKey string
+
+const (
+	VarnishStorageAllocationFailedMetricAttributeKeyStorageName VarnishStorageAllocationFailedMetricAttributeKey = "varnish.storage.name"
+)
+
+// VarnishStorageAllocationFailedMetricConfig provides config for the varnish.storage.allocation.failed metric.
+type VarnishStorageAllocationFailedMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                             `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []VarnishStorageAllocationFailedMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *VarnishStorageAllocationFailedMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *VarnishStorageAllocationFailedMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case VarnishStorageAllocationFailedMetricAttributeKeyStorageName:
+		default:
+			return fmt.Errorf("metric varnish.storage.allocation.failed doesn't have an attribute %v, valid attributes: [varnish.storage.name]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// VarnishStorageUsageMetricAttributeKey specifies the key of an attribute for the varnish.storage.usage metric.
+type VarnishStorageUsageMetricAttributeKey string
+
+const (
+	VarnishStorageUsageMetricAttributeKeyStorageName  VarnishStorageUsageMetricAttributeKey = "varnish.storage.name"
+	VarnishStorageUsageMetricAttributeKeyStorageState VarnishStorageUsageMetricAttributeKey = "state"
+)
+
+// VarnishStorageUsageMetricConfig provides config for the varnish.storage.usage metric.
+type VarnishStorageUsageMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                  `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []VarnishStorageUsageMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *VarnishStorageUsageMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *VarnishStorageUsageMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case VarnishStorageUsageMetricAttributeKeyStorageName, VarnishStorageUsageMetricAttributeKeyStorageState:
+		default:
+			return fmt.Errorf("metric varnish.storage.usage doesn't have an attribute %v, valid attributes: [varnish.storage.name, state]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
 // VarnishThreadOperationCountMetricAttributeKey specifies the key of an attribute for the varnish.thread.operation.count metric.
 type VarnishThreadOperationCountMetricAttributeKey string
 
@@ -399,17 +741,24 @@ func (ms *VarnishThreadOperationCountMetricConfig) Validate() error {
 
 // MetricsConfig provides config for varnish metrics.
 type MetricsConfig struct {
-	VarnishBackendConnectionCount  VarnishBackendConnectionCountMetricConfig  `mapstructure:"varnish.backend.connection.count"`
-	VarnishBackendRequestCount     VarnishBackendRequestCountMetricConfig     `mapstructure:"varnish.backend.request.count"`
-	VarnishCacheOperationCount     VarnishCacheOperationCountMetricConfig     `mapstructure:"varnish.cache.operation.count"`
-	VarnishClientRequestCount      VarnishClientRequestCountMetricConfig      `mapstructure:"varnish.client.request.count"`
-	VarnishClientRequestErrorCount VarnishClientRequestErrorCountMetricConfig `mapstructure:"varnish.client.request.error.count"`
-	VarnishObjectCount             VarnishObjectCountMetricConfig             `mapstructure:"varnish.object.count"`
-	VarnishObjectExpired           VarnishObjectExpiredMetricConfig           `mapstructure:"varnish.object.expired"`
-	VarnishObjectMoved             VarnishObjectMovedMetricConfig             `mapstructure:"varnish.object.moved"`
-	VarnishObjectNuked             VarnishObjectNukedMetricConfig             `mapstructure:"varnish.object.nuked"`
-	VarnishSessionCount            VarnishSessionCountMetricConfig            `mapstructure:"varnish.session.count"`
-	VarnishThreadOperationCount    VarnishThreadOperationCountMetricConfig    `mapstructure:"varnish.thread.operation.count"`
+	VarnishBackendConnectionCount       VarnishBackendConnectionCountMetricConfig       `mapstructure:"varnish.backend.connection.count"`
+	VarnishBackendRequestCount          VarnishBackendRequestCountMetricConfig          `mapstructure:"varnish.backend.request.count"`
+	VarnishBackendServerConnectionCount VarnishBackendServerConnectionCountMetricConfig `mapstructure:"varnish.backend.server.connection.count"`
+	VarnishBackendServerHealth          VarnishBackendServerHealthMetricConfig          `mapstructure:"varnish.backend.server.health"`
+	VarnishBackendServerIo              VarnishBackendServerIoMetricConfig              `mapstructure:"varnish.backend.server.io"`
+	VarnishBackendServerRequestCount    VarnishBackendServerRequestCountMetricConfig    `mapstructure:"varnish.backend.server.request.count"`
+	VarnishCacheOperationCount          VarnishCacheOperationCountMetricConfig          `mapstructure:"varnish.cache.operation.count"`
+	VarnishClientRequestCount           VarnishClientRequestCountMetricConfig           `mapstructure:"varnish.client.request.count"`
+	VarnishClientRequestErrorCount      VarnishClientRequestErrorCountMetricConfig      `mapstructure:"varnish.client.request.error.count"`
+	VarnishObjectCount                  VarnishObjectCountMetricConfig                  `mapstructure:"varnish.object.count"`
+	VarnishObjectExpired                VarnishObjectExpiredMetricConfig                `mapstructure:"varnish.object.expired"`
+	VarnishObjectMoved                  VarnishObjectMovedMetricConfig                  `mapstructure:"varnish.object.moved"`
+	VarnishObjectNuked                  VarnishObjectNukedMetricConfig                  `mapstructure:"varnish.object.nuked"`
+	VarnishSessionCount                 VarnishSessionCountMetricConfig                 `mapstructure:"varnish.session.count"`
+	VarnishStorageAllocationCount       VarnishStorageAllocationCountMetricConfig       `mapstructure:"varnish.storage.allocation.count"`
+	VarnishStorageAllocationFailed      VarnishStorageAllocationFailedMetricConfig      `mapstructure:"varnish.storage.allocation.failed"`
+	VarnishStorageUsage                 VarnishStorageUsageMetricConfig                 `mapstructure:"varnish.storage.usage"`
+	VarnishThreadOperationCount         VarnishThreadOperationCountMetricConfig         `mapstructure:"varnish.thread.operation.count"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -422,6 +771,26 @@ func DefaultMetricsConfig() MetricsConfig {
 		VarnishBackendRequestCount: VarnishBackendRequestCountMetricConfig{
 			Enabled: true,
 		},
+		VarnishBackendServerConnectionCount: VarnishBackendServerConnectionCountMetricConfig{
+			Enabled:             true,
+			AggregationStrategy: AggregationStrategySum,
+			EnabledAttributes:   []VarnishBackendServerConnectionCountMetricAttributeKey{VarnishBackendServerConnectionCountMetricAttributeKeyBackendName, VarnishBackendServerConnectionCountMetricAttributeKeyVclName},
+		},
+		VarnishBackendServerHealth: VarnishBackendServerHealthMetricConfig{
+			Enabled:             true,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []VarnishBackendServerHealthMetricAttributeKey{VarnishBackendServerHealthMetricAttributeKeyBackendName, VarnishBackendServerHealthMetricAttributeKeyVclName},
+		},
+		VarnishBackendServerIo: VarnishBackendServerIoMetricConfig{
+			Enabled:             true,
+			AggregationStrategy: AggregationStrategySum,
+			EnabledAttributes:   []VarnishBackendServerIoMetricAttributeKey{VarnishBackendServerIoMetricAttributeKeyBackendName, VarnishBackendServerIoMetricAttributeKeyVclName, VarnishBackendServerIoMetricAttributeKeyIoDirection},
+		},
+		VarnishBackendServerRequestCount: VarnishBackendServerRequestCountMetricConfig{
+			Enabled:             true,
+			AggregationStrategy: AggregationStrategySum,
+			EnabledAttributes:   []VarnishBackendServerRequestCountMetricAttributeKey{VarnishBackendServerRequestCountMetricAttributeKeyBackendName, VarnishBackendServerRequestCountMetricAttributeKeyVclName},
+		},
 		VarnishCacheOperationCount: VarnishCacheOperationCountMetricConfig{
 			Enabled:             true,
 			AggregationStrategy: AggregationStrategySum,
@@ -454,6 +823,21 @@ func DefaultMetricsConfig() MetricsConfig {
 			AggregationStrategy: AggregationStrategySum,
 			EnabledAttributes:   []VarnishSessionCountMetricAttributeKey{VarnishSessionCountMetricAttributeKeySessionType},
 		},
+		VarnishStorageAllocationCount: VarnishStorageAllocationCountMetricConfig{
+			Enabled:             true,
+			AggregationStrategy: AggregationStrategySum,
+			EnabledAttributes:   []VarnishStorageAllocationCountMetricAttributeKey{VarnishStorageAllocationCountMetricAttributeKeyStorageName},
+		},
+		VarnishStorageAllocationFailed: VarnishStorageAllocationFailedMetricConfig{
+			Enabled:             true,
+			AggregationStrategy: AggregationStrategySum,
+			EnabledAttributes:   []VarnishStorageAllocationFailedMetricAttributeKey{VarnishStorageAllocationFailedMetricAttributeKeyStorageName},
+		},
+		VarnishStorageUsage: VarnishStorageUsageMetricConfig{
+			Enabled:             true,
+			AggregationStrategy: AggregationStrategySum,
+			EnabledAttributes:   []VarnishStorageUsageMetricAttributeKey{VarnishStorageUsageMetricAttributeKeyStorageName, VarnishStorageUsageMetricAttributeKeyStorageState},
+		},
 		VarnishThreadOperationCount: VarnishThreadOperationCountMetricConfig{
 			Enabled:             true,
 			AggregationStrategy: AggregationStrategySum,
@@ -34,6 +34,26 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					VarnishBackendRequestCount: VarnishBackendRequestCountMetricConfig{
 						Enabled: true,
 					},
+					VarnishBackendServerConnectionCount: VarnishBackendServerConnectionCountMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategySum,
+						EnabledAttributes:   []VarnishBackendServerConnectionCountMetricAttributeKey{VarnishBackendServerConnectionCountMetricAttributeKeyBackendName, VarnishBackendServerConnectionCountMetricAttributeKeyVclName},
+					},
+					VarnishBackendServerHealth: VarnishBackendServerHealthMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []VarnishBackendServerHealthMetricAttributeKey{VarnishBackendServerHealthMetricAttributeKeyBackendName, VarnishBackendServerHealthMetricAttributeKeyVclName},
+					},
+					VarnishBackendServerIo: VarnishBackendServerIoMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategySum,
+						EnabledAttributes:   []VarnishBackendServerIoMetricAttributeKey{VarnishBackendServerIoMetricAttributeKeyBackendName, VarnishBackendServerIoMetricAttributeKeyVclName, VarnishBackendServerIoMetricAttributeKeyIoDirection},
+					},
+					VarnishBackendServerRequestCount: VarnishBackendServerRequestCountMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategySum,
+						EnabledAttributes:   []VarnishBackendServerRequestCountMetricAttributeKey{VarnishBackendServerRequestCountMetricAttributeKeyBackendName, VarnishBackendServerRequestCountMetricAttributeKeyVclName},
+					},
 					VarnishCacheOperationCount: VarnishCacheOperationCountMetricConfig{
 						Enabled:             true,
 						AggregationStrategy: AggregationStrategySum,
@@ -66,6 +86,21 @@ func TestMetricsBuilderConfig(t *testing.T) {
 						AggregationStrategy: AggregationStrategySum,
 						EnabledAttributes:   []VarnishSessionCountMetricAttributeKey{VarnishSessionCountMetricAttributeKeySessionType},
 					},
+					VarnishStorageAllocationCount: VarnishStorageAllocationCountMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategySum,
+						EnabledAttributes:   []VarnishStorageAllocationCountMetricAttributeKey{VarnishStorageAllocationCountMetricAttributeKeyStorageName},
+					},
+					VarnishStorageAllocationFailed: VarnishStorageAllocationFailedMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategySum,
+						EnabledAttributes:   []VarnishStorageAllocationFailedMetricAttributeKey{VarnishStorageAllocationFailedMetricAttributeKeyStorageName},
+					},
+					VarnishStorageUsage: VarnishStorageUsageMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategySum,
+						EnabledAttributes:   []VarnishStorageUsageMetricAttributeKey{VarnishStorageUsageMetricAttributeKeyStorageName, VarnishStorageUsageMetricAttributeKeyStorageState},
+					},
 					VarnishThreadOperationCount: VarnishThreadOperationCountMetricConfig{
 						Enabled:             true,
 						AggregationStrategy: AggregationStrategySum,
@@ -89,6 +124,26 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					VarnishBackendRequestCount: VarnishBackendRequestCountMetricConfig{
 						Enabled: false,
 					},
+					VarnishBackendServerConnectionCount: VarnishBackendServerConnectionCountMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategySum,
+						EnabledAttributes:   []VarnishBackendServerConnectionCountMetricAttributeKey{VarnishBackendServerConnectionCountMetricAttributeKeyBackendName, VarnishBackendServerConnectionCountMetricAttributeKeyVclName},
+					},
+					VarnishBackendServerHealth: VarnishBackendServerHealthMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []VarnishBackendServerHealthMetricAttributeKey{VarnishBackendServerHealthMetricAttributeKeyBackendName, VarnishBackendServerHealthMetricAttributeKeyVclName},
+					},
+					VarnishBackendServerIo: VarnishBackendServerIoMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategySum,
+						EnabledAttributes:   []VarnishBackendServerIoMetricAttributeKey{VarnishBackendServerIoMetricAttributeKeyBackendName, VarnishBackendServerIoMetricAttributeKeyVclName, VarnishBackendServerIoMetricAttributeKeyIoDirection},
+					},
+					VarnishBackendServerRequestCount: VarnishBackendServerRequestCountMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategySum,
+						EnabledAttributes:   []VarnishBackendServerRequestCountMetricAttributeKey{VarnishBackendServerRequestCountMetricAttributeKeyBackendName, VarnishBackendServerRequestCountMetricAttributeKeyVclName},
+					},
 					VarnishCacheOperationCount: VarnishCacheOperationCountMetricConfig{
 						Enabled:             false,
 						AggregationStrategy: AggregationStrategySum,
@@ -121,6 +176,21 @@ func TestMetricsBuilderConfig(t *testing.T) {
 						AggregationStrategy: AggregationStrategySum,
 						EnabledAttributes:   []VarnishSessionCountMetricAttributeKey{VarnishSessionCountMetricAttributeKeySessionType},
 					},
+					VarnishStorageAllocationCount: VarnishStorageAllocationCountMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategySum,
+						EnabledAttributes:   []VarnishStorageAllocationCountMetricAttributeKey{VarnishStorageAllocationCountMetricAttributeKeyStorageName},
+					},
+					VarnishStorageAllocationFailed: VarnishStorageAllocationFailedMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategySum,
+						EnabledAttributes:   []VarnishStorageAllocationFailedMetricAttributeKey{VarnishStorageAllocationFailedMetricAttributeKeyStorageName},
+					},
+					VarnishStorageUsage: VarnishStorageUsageMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategySum,
+						EnabledAttributes:   []VarnishStorageUsageMetricAttributeKey{VarnishStorageUsageMetricAttributeKeyStorageName, VarnishStorageUsageMetricAttributeKeyStorageState},
+					},
 					VarnishThreadOperationCount: VarnishThreadOperationCountMetricConfig{
 						Enabled:             false,
 						AggregationStrategy: AggregationStrategySum,
@@ -136,7 +206,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			cfg := loadMetricsBuilderConfig(t, tt.name)
-			diff := cmp.Diff(tt.want, cfg, cmpopts.IgnoreUnexported(VarnishBackendConnectionCountMetricConfig{}, VarnishBackendRequestCountMetricConfig{}, VarnishCacheOperationCountMetricConfig{}, VarnishClientRequestCountMetricConfig{}, VarnishClientRequestErrorCountMetricConfig{}, VarnishObjectCountMetricConfig{}, VarnishObjectExpiredMetricConfig{}, VarnishObjectMovedMetricConfig{}, VarnishObjectNukedMetricConfig{}, VarnishSessionCountMetricConfig{}, VarnishThreadOperationCountMetricConfig{}, ResourceAttributeConfig{}))
+			diff := cmp.Diff(tt.want, cfg, cmpopts.IgnoreUnexported(VarnishBackendConnectionCountMetricConfig{}, VarnishBackendRequestCountMetricConfig{}, VarnishBackendServerConnectionCountMetricConfig{}, VarnishBackendServerHealthMetricConfig{}, VarnishBackendServerIoMetricConfig{}, VarnishBackendServerRequestCountMetricConfig{}, VarnishCacheOperationCountMetricConfig{}, VarnishClientRequestCountMetricConfig{}, VarnishClientRequestErrorCountMetricConfig{}, VarnishObjectCountMetricConfig{}, VarnishObjectExpiredMetricConfig{}, VarnishObjectMovedMetricConfig{}, VarnishObjectNukedMetricConfig{}, VarnishSessionCountMetricConfig{}, VarnishStorageAllocationCountMetricConfig{}, VarnishStorageAllocationFailedMetricConfig{}, VarnishStorageUsageMetricConfig{}, VarnishThreadOperationCountMetricConfig{}, ResourceAttributeConfig{}))
 			require.Emptyf(t, diff, "Config mismatch (-expected +actual):\n%s", diff)
 		})
 	}
@@ -153,6 +223,54 @@ func TestVarnishBackendConnectionCountMetricsConfig_Validate(t *testing.T) {
 	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
 }
 
+func TestVarnishBackendServerConnectionCountMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().VarnishBackendServerConnectionCount
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []VarnishBackendServerConnectionCountMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric varnish.backend.server.connection.count doesn't have an attribute invalid, valid attributes: [varnish.backend.name, varnish.vcl.name]")
+
+	cfg = DefaultMetricsConfig().VarnishBackendServerConnectionCount
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestVarnishBackendServerHealthMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().VarnishBackendServerHealth
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []VarnishBackendServerHealthMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric varnish.backend.server.health doesn't have an attribute invalid, valid attributes: [varnish.backend.name, varnish.vcl.name]")
+
+	cfg = DefaultMetricsConfig().VarnishBackendServerHealth
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestVarnishBackendServerIoMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().VarnishBackendServerIo
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []VarnishBackendServerIoMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric varnish.backend.server.io doesn't have an attribute invalid, valid attributes: [varnish.backend.name, varnish.vcl.name, direction]")
+
+	cfg = DefaultMetricsConfig().VarnishBackendServerIo
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestVarnishBackendServerRequestCountMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().VarnishBackendServerRequestCount
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []VarnishBackendServerRequestCountMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric varnish.backend.server.request.count doesn't have an attribute invalid, valid attributes: [varnish.backend.name, varnish.vcl.name]")
+
+	cfg = DefaultMetricsConfig().VarnishBackendServerRequestCount
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
 func TestVarnishCacheOperationCountMetricsConfig_Validate(t *testing.T) {
 	cfg := DefaultMetricsConfig().VarnishCacheOperationCount
 	require.NoError(t, cfg.Validate())
@@ -201,6 +319,42 @@ func TestVarnishSessionCountMetricsConfig_Validate(t *testing.T) {
 	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
 }
 
+func TestVarnishStorageAllocationCountMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().VarnishStorageAllocationCount
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []VarnishStorageAllocationCountMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric varnish.storage.allocation.count doesn't have an attribute invalid, valid attributes: [varnish.storage.name]")
+
+	cfg = DefaultMetricsConfig().VarnishStorageAllocationCount
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestVarnishStorageAllocationFailedMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().VarnishStorageAllocationFailed
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []VarnishStorageAllocationFailedMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric varnish.storage.allocation.failed doesn't have an attribute invalid, valid attributes: [varnish.storage.name]")
+
+	cfg = DefaultMetricsConfig().VarnishStorageAllocationFailed
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestVarnishStorageUsageMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().VarnishStorageUsage
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []VarnishStorageUsageMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric varnish.storage.usage doesn't have an attribute invalid, valid attributes: [varnish.storage.name, state]")
+
+	cfg = DefaultMetricsConfig().VarnishStorageUsage
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
 func TestVarnishThreadOperationCountMetricsConfig_Validate(t *testing.T) {
 	cfg := DefaultMetricsConfig().VarnishThreadOperationCount
 	require.NoError(t, cfg.Validate())
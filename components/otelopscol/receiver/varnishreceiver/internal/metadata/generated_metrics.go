@@ -95,6 +95,32 @@ var MapAttributeCacheOperations = map[string]AttributeCacheOperations{
 	"hit_pass": AttributeCacheOperationsHitPass,
 }
 
+// AttributeIoDirection specifies the value io_direction attribute.
+type AttributeIoDirection int
+
+const (
+	_ AttributeIoDirection = iota
+	AttributeIoDirectionSent
+	AttributeIoDirectionReceived
+)
+
+// String returns the string representation of the AttributeIoDirection.
+func (av AttributeIoDirection) String() string {
+	switch av {
+	case AttributeIoDirectionSent:
+		return "sent"
+	case AttributeIoDirectionReceived:
+		return "received"
+	}
+	return ""
+}
+
+// MapAttributeIoDirection is a helper map of string to AttributeIoDirection attribute value.
+var MapAttributeIoDirection = map[string]AttributeIoDirection{
+	"sent":     AttributeIoDirectionSent,
+	"received": AttributeIoDirectionReceived,
+}
+
 // AttributeSessionType specifies the value session_type attribute.
 type AttributeSessionType int
 
@@ -151,6 +177,32 @@ var MapAttributeState = map[string]AttributeState{
 	"dropped":  AttributeStateDropped,
 }
 
+// AttributeStorageState specifies the value storage_state attribute.
+type AttributeStorageState int
+
+const (
+	_ AttributeStorageState = iota
+	AttributeStorageStateUsed
+	AttributeStorageStateFree
+)
+
+// String returns the string representation of the AttributeStorageState.
+func (av AttributeStorageState) String() string {
+	switch av {
+	case AttributeStorageStateUsed:
+		return "used"
+	case AttributeStorageStateFree:
+		return "free"
+	}
+	return ""
+}
+
+// MapAttributeStorageState is a helper map of string to AttributeStorageState attribute value.
+var MapAttributeStorageState = map[string]AttributeStorageState{
+	"used": AttributeStorageStateUsed,
+	"free": AttributeStorageStateFree,
+}
+
 // AttributeThreadOperations specifies the value thread_operations attribute.
 type AttributeThreadOperations int
 
@@ -189,6 +241,22 @@ var MetricsInfo = metricsInfo{
 	VarnishBackendRequestCount: metricInfo{
 		Name: "varnish.backend.request.count",
 	},
+	VarnishBackendServerConnectionCount: metricInfo{
+		Name:       "varnish.backend.server.connection.count",
+		Attributes: []string{"backend_name", "vcl_name"},
+	},
+	VarnishBackendServerHealth: metricInfo{
+		Name:       "varnish.backend.server.health",
+		Attributes: []string{"backend_name", "vcl_name"},
+	},
+	VarnishBackendServerIo: metricInfo{
+		Name:       "varnish.backend.server.io",
+		Attributes: []string{"backend_name", "vcl_name", "io_direction"},
+	},
+	VarnishBackendServerRequestCount: metricInfo{
+		Name:       "varnish.backend.server.request.count",
+		Attributes: []string{"backend_name", "vcl_name"},
+	},
 	VarnishCacheOperationCount: metricInfo{
 		Name:       "varnish.cache.operation.count",
 		Attributes: []string{"cache_operations"},
@@ -217,6 +285,18 @@ var MetricsInfo = metricsInfo{
 		Name:       "varnish.session.count",
 		Attributes: []string{"session_type"},
 	},
+	VarnishStorageAllocationCount: metricInfo{
+		Name:       "varnish.storage.allocation.count",
+		Attributes: []string{"storage_name"},
+	},
+	VarnishStorageAllocationFailed: metricInfo{
+		Name:       "varnish.storage.allocation.failed",
+		Attributes: []string{"storage_name"},
+	},
+	VarnishStorageUsage: metricInfo{
+		Name:       "varnish.storage.usage",
+		Attributes: []string{"storage_name", "storage_state"},
+	},
 	VarnishThreadOperationCount: metricInfo{
 		Name:       "varnish.thread.operation.count",
 		Attributes: []string{"thread_operations"},
@@ -224,17 +304,24 @@ var MetricsInfo = metricsInfo{
 }
 
 type metricsInfo struct {
-	VarnishBackendConnectionCount  metricInfo
-	VarnishBackendRequestCount     metricInfo
-	VarnishCacheOperationCount     metricInfo
-	VarnishClientRequestCount      metricInfo
-	VarnishClientRequestErrorCount metricInfo
-	VarnishObjectCount             metricInfo
-	VarnishObjectExpired           metricInfo
-	VarnishObjectMoved             metricInfo
-	VarnishObjectNuked             metricInfo
-	VarnishSessionCount            metricInfo
-	VarnishThreadOperationCount    metricInfo
+	VarnishBackendConnectionCount       metricInfo
+	VarnishBackendRequestCount          metricInfo
+	VarnishBackendServerConnectionCount metricInfo
+	VarnishBackendServerHealth          metricInfo
+	VarnishBackendServerIo              metricInfo
+	VarnishBackendServerRequestCount    metricInfo
+	VarnishCacheOperationCount          metricInfo
+	VarnishClientRequestCount           metricInfo
+	VarnishClientRequestErrorCount      metricInfo
+	VarnishObjectCount                  metricInfo
+	VarnishObjectExpired                metricInfo
+	VarnishObjectMoved                  metricInfo
+	VarnishObjectNuked                  metricInfo
+	VarnishSessionCount                 metricInfo
+	VarnishStorageAllocationCount       metricInfo
+	VarnishStorageAllocationFailed      metricInfo
+	VarnishStorageUsage                 metricInfo
+	VarnishThreadOperationCount         metricInfo
 }
 
 type metricInfo struct {
@@ -360,23 +447,400 @@ func (m *metricVarnishBackendRequestCount) recordDataPoint(start pcommon.Timesta
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricVarnishBackendRequestCount) updateCapacity() {
+func (m *metricVarnishBackendRequestCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricVarnishBackendRequestCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricVarnishBackendRequestCount(cfg VarnishBackendRequestCountMetricConfig) metricVarnishBackendRequestCount {
+	m := metricVarnishBackendRequestCount{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricVarnishBackendServerConnectionCount struct {
+	data          pmetric.Metric                                  // data buffer for generated metric.
+	config        VarnishBackendServerConnectionCountMetricConfig // metric config provided by user.
+	capacity      int                                             // max observed number of data points added to the metric.
+	aggDataPoints []int64                                         // slice containing number of aggregated datapoints at each index
+}
+
+// init fills varnish.backend.server.connection.count metric with initial data.
+func (m *metricVarnishBackendServerConnectionCount) init() {
+	m.data.SetName("varnish.backend.server.connection.count")
+	m.data.SetDescription("The current connections to the backend.")
+	m.data.SetUnit("{connections}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricVarnishBackendServerConnectionCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, backendNameAttributeValue string, vclNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, VarnishBackendServerConnectionCountMetricAttributeKeyBackendName) {
+		dp.Attributes().PutStr("varnish.backend.name", backendNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VarnishBackendServerConnectionCountMetricAttributeKeyVclName) {
+		dp.Attributes().PutStr("varnish.vcl.name", vclNameAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Sum().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricVarnishBackendServerConnectionCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricVarnishBackendServerConnectionCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Sum().DataPoints().At(i).SetIntValue(m.data.Sum().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricVarnishBackendServerConnectionCount(cfg VarnishBackendServerConnectionCountMetricConfig) metricVarnishBackendServerConnectionCount {
+	m := metricVarnishBackendServerConnectionCount{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricVarnishBackendServerHealth struct {
+	data          pmetric.Metric                         // data buffer for generated metric.
+	config        VarnishBackendServerHealthMetricConfig // metric config provided by user.
+	capacity      int                                    // max observed number of data points added to the metric.
+	aggDataPoints []int64                                // slice containing number of aggregated datapoints at each index
+}
+
+// init fills varnish.backend.server.health metric with initial data.
+func (m *metricVarnishBackendServerHealth) init() {
+	m.data.SetName("varnish.backend.server.health")
+	m.data.SetDescription("Whether the most recent health probe of the backend succeeded, 1 if it did and 0 otherwise. Backends with no successful probe among their last 64 have no data point unless they are listed in `probed_backends`.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricVarnishBackendServerHealth) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, backendNameAttributeValue string, vclNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, VarnishBackendServerHealthMetricAttributeKeyBackendName) {
+		dp.Attributes().PutStr("varnish.backend.name", backendNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VarnishBackendServerHealthMetricAttributeKeyVclName) {
+		dp.Attributes().PutStr("varnish.vcl.name", vclNameAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricVarnishBackendServerHealth) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricVarnishBackendServerHealth) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricVarnishBackendServerHealth(cfg VarnishBackendServerHealthMetricConfig) metricVarnishBackendServerHealth {
+	m := metricVarnishBackendServerHealth{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricVarnishBackendServerIo struct {
+	data          pmetric.Metric                     // data buffer for generated metric.
+	config        VarnishBackendServerIoMetricConfig // metric config provided by user.
+	capacity      int                                // max observed number of data points added to the metric.
+	aggDataPoints []int64                            // slice containing number of aggregated datapoints at each index
+}
+
+// init fills varnish.backend.server.io metric with initial data.
+func (m *metricVarnishBackendServerIo) init() {
+	m.data.SetName("varnish.backend.server.io")
+	m.data.SetDescription("The bytes of request and response headers and bodies exchanged with the backend.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricVarnishBackendServerIo) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, backendNameAttributeValue string, vclNameAttributeValue string, ioDirectionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, VarnishBackendServerIoMetricAttributeKeyBackendName) {
+		dp.Attributes().PutStr("varnish.backend.name", backendNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VarnishBackendServerIoMetricAttributeKeyVclName) {
+		dp.Attributes().PutStr("varnish.vcl.name", vclNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VarnishBackendServerIoMetricAttributeKeyIoDirection) {
+		dp.Attributes().PutStr("direction", ioDirectionAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Sum().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricVarnishBackendServerIo) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricVarnishBackendServerIo) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Sum().DataPoints().At(i).SetIntValue(m.data.Sum().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricVarnishBackendServerIo(cfg VarnishBackendServerIoMetricConfig) metricVarnishBackendServerIo {
+	m := metricVarnishBackendServerIo{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricVarnishBackendServerRequestCount struct {
+	data          pmetric.Metric                               // data buffer for generated metric.
+	config        VarnishBackendServerRequestCountMetricConfig // metric config provided by user.
+	capacity      int                                          // max observed number of data points added to the metric.
+	aggDataPoints []int64                                      // slice containing number of aggregated datapoints at each index
+}
+
+// init fills varnish.backend.server.request.count metric with initial data.
+func (m *metricVarnishBackendServerRequestCount) init() {
+	m.data.SetName("varnish.backend.server.request.count")
+	m.data.SetDescription("The requests sent to the backend count.")
+	m.data.SetUnit("{requests}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricVarnishBackendServerRequestCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, backendNameAttributeValue string, vclNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, VarnishBackendServerRequestCountMetricAttributeKeyBackendName) {
+		dp.Attributes().PutStr("varnish.backend.name", backendNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VarnishBackendServerRequestCountMetricAttributeKeyVclName) {
+		dp.Attributes().PutStr("varnish.vcl.name", vclNameAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Sum().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricVarnishBackendServerRequestCount) updateCapacity() {
 	if m.data.Sum().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricVarnishBackendRequestCount) emit(metrics pmetric.MetricSlice) {
+func (m *metricVarnishBackendServerRequestCount) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Sum().DataPoints().At(i).SetIntValue(m.data.Sum().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
 		m.init()
 	}
 }
 
-func newMetricVarnishBackendRequestCount(cfg VarnishBackendRequestCountMetricConfig) metricVarnishBackendRequestCount {
-	m := metricVarnishBackendRequestCount{config: cfg}
+func newMetricVarnishBackendServerRequestCount(cfg VarnishBackendServerRequestCountMetricConfig) metricVarnishBackendServerRequestCount {
+	m := metricVarnishBackendServerRequestCount{config: cfg}
 
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
@@ -957,6 +1421,282 @@ func newMetricVarnishSessionCount(cfg VarnishSessionCountMetricConfig) metricVar
 	return m
 }
 
+type metricVarnishStorageAllocationCount struct {
+	data          pmetric.Metric                            // data buffer for generated metric.
+	config        VarnishStorageAllocationCountMetricConfig // metric config provided by user.
+	capacity      int                                       // max observed number of data points added to the metric.
+	aggDataPoints []int64                                   // slice containing number of aggregated datapoints at each index
+}
+
+// init fills varnish.storage.allocation.count metric with initial data.
+func (m *metricVarnishStorageAllocationCount) init() {
+	m.data.SetName("varnish.storage.allocation.count")
+	m.data.SetDescription("The allocation requests made to the storage count.")
+	m.data.SetUnit("{allocations}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricVarnishStorageAllocationCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, storageNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, VarnishStorageAllocationCountMetricAttributeKeyStorageName) {
+		dp.Attributes().PutStr("varnish.storage.name", storageNameAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Sum().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricVarnishStorageAllocationCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricVarnishStorageAllocationCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Sum().DataPoints().At(i).SetIntValue(m.data.Sum().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricVarnishStorageAllocationCount(cfg VarnishStorageAllocationCountMetricConfig) metricVarnishStorageAllocationCount {
+	m := metricVarnishStorageAllocationCount{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricVarnishStorageAllocationFailed struct {
+	data          pmetric.Metric                             // data buffer for generated metric.
+	config        VarnishStorageAllocationFailedMetricConfig // metric config provided by user.
+	capacity      int                                        // max observed number of data points added to the metric.
+	aggDataPoints []int64                                    // slice containing number of aggregated datapoints at each index
+}
+
+// init fills varnish.storage.allocation.failed metric with initial data.
+func (m *metricVarnishStorageAllocationFailed) init() {
+	m.data.SetName("varnish.storage.allocation.failed")
+	m.data.SetDescription("The allocation requests to the storage that failed count.")
+	m.data.SetUnit("{allocations}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricVarnishStorageAllocationFailed) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, storageNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, VarnishStorageAllocationFailedMetricAttributeKeyStorageName) {
+		dp.Attributes().PutStr("varnish.storage.name", storageNameAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Sum().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricVarnishStorageAllocationFailed) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricVarnishStorageAllocationFailed) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Sum().DataPoints().At(i).SetIntValue(m.data.Sum().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricVarnishStorageAllocationFailed(cfg VarnishStorageAllocationFailedMetricConfig) metricVarnishStorageAllocationFailed {
+	m := metricVarnishStorageAllocationFailed{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricVarnishStorageUsage struct {
+	data          pmetric.Metric                  // data buffer for generated metric.
+	config        VarnishStorageUsageMetricConfig // metric config provided by user.
+	capacity      int                             // max observed number of data points added to the metric.
+	aggDataPoints []int64                         // slice containing number of aggregated datapoints at each index
+}
+
+// init fills varnish.storage.usage metric with initial data.
+func (m *metricVarnishStorageUsage) init() {
+	m.data.SetName("varnish.storage.usage")
+	m.data.SetDescription("The bytes of the storage by state.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricVarnishStorageUsage) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, storageNameAttributeValue string, storageStateAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, VarnishStorageUsageMetricAttributeKeyStorageName) {
+		dp.Attributes().PutStr("varnish.storage.name", storageNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VarnishStorageUsageMetricAttributeKeyStorageState) {
+		dp.Attributes().PutStr("state", storageStateAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Sum().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricVarnishStorageUsage) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricVarnishStorageUsage) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Sum().DataPoints().At(i).SetIntValue(m.data.Sum().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricVarnishStorageUsage(cfg VarnishStorageUsageMetricConfig) metricVarnishStorageUsage {
+	m := metricVarnishStorageUsage{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricVarnishThreadOperationCount struct {
 	data          pmetric.Metric                          // data buffer for generated metric.
 	config        VarnishThreadOperationCountMetricConfig // metric config provided by user.
@@ -1051,24 +1791,31 @@ func newMetricVarnishThreadOperationCount(cfg VarnishThreadOperationCountMetricC
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
-	config                               MetricsBuilderConfig // config of the metrics builder.
-	startTime                            pcommon.Timestamp    // start time that will be applied to all recorded data points.
-	metricsCapacity                      int                  // maximum observed number of metrics per resource.
-	metricsBuffer                        pmetric.Metrics      // accumulates metrics data before emitting.
-	buildInfo                            component.BuildInfo  // contains version information.
-	resourceAttributeIncludeFilter       map[string]filter.Filter
-	resourceAttributeExcludeFilter       map[string]filter.Filter
-	metricVarnishBackendConnectionCount  metricVarnishBackendConnectionCount
-	metricVarnishBackendRequestCount     metricVarnishBackendRequestCount
-	metricVarnishCacheOperationCount     metricVarnishCacheOperationCount
-	metricVarnishClientRequestCount      metricVarnishClientRequestCount
-	metricVarnishClientRequestErrorCount metricVarnishClientRequestErrorCount
-	metricVarnishObjectCount             metricVarnishObjectCount
-	metricVarnishObjectExpired           metricVarnishObjectExpired
-	metricVarnishObjectMoved             metricVarnishObjectMoved
-	metricVarnishObjectNuked             metricVarnishObjectNuked
-	metricVarnishSessionCount            metricVarnishSessionCount
-	metricVarnishThreadOperationCount    metricVarnishThreadOperationCount
+	config                                    MetricsBuilderConfig // config of the metrics builder.
+	startTime                                 pcommon.Timestamp    // start time that will be applied to all recorded data points.
+	metricsCapacity                           int                  // maximum observed number of metrics per resource.
+	metricsBuffer                             pmetric.Metrics      // accumulates metrics data before emitting.
+	buildInfo                                 component.BuildInfo  // contains version information.
+	resourceAttributeIncludeFilter            map[string]filter.Filter
+	resourceAttributeExcludeFilter            map[string]filter.Filter
+	metricVarnishBackendConnectionCount       metricVarnishBackendConnectionCount
+	metricVarnishBackendRequestCount          metricVarnishBackendRequestCount
+	metricVarnishBackendServerConnectionCount metricVarnishBackendServerConnectionCount
+	metricVarnishBackendServerHealth          metricVarnishBackendServerHealth
+	metricVarnishBackendServerIo              metricVarnishBackendServerIo
+	metricVarnishBackendServerRequestCount    metricVarnishBackendServerRequestCount
+	metricVarnishCacheOperationCount          metricVarnishCacheOperationCount
+	metricVarnishClientRequestCount           metricVarnishClientRequestCount
+	metricVarnishClientRequestErrorCount      metricVarnishClientRequestErrorCount
+	metricVarnishObjectCount                  metricVarnishObjectCount
+	metricVarnishObjectExpired                metricVarnishObjectExpired
+	metricVarnishObjectMoved                  metricVarnishObjectMoved
+	metricVarnishObjectNuked                  metricVarnishObjectNuked
+	metricVarnishSessionCount                 metricVarnishSessionCount
+	metricVarnishStorageAllocationCount       metricVarnishStorageAllocationCount
+	metricVarnishStorageAllocationFailed      metricVarnishStorageAllocationFailed
+	metricVarnishStorageUsage                 metricVarnishStorageUsage
+	metricVarnishThreadOperationCount         metricVarnishThreadOperationCount
 }
 
 // MetricBuilderOption applies changes to default metrics builder.
@@ -1090,23 +1837,30 @@ func WithStartTime(startTime pcommon.Timestamp) MetricBuilderOption {
 }
 func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, options ...MetricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		config:                               mbc,
-		startTime:                            pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                        pmetric.NewMetrics(),
-		buildInfo:                            settings.BuildInfo,
-		metricVarnishBackendConnectionCount:  newMetricVarnishBackendConnectionCount(mbc.Metrics.VarnishBackendConnectionCount),
-		metricVarnishBackendRequestCount:     newMetricVarnishBackendRequestCount(mbc.Metrics.VarnishBackendRequestCount),
-		metricVarnishCacheOperationCount:     newMetricVarnishCacheOperationCount(mbc.Metrics.VarnishCacheOperationCount),
-		metricVarnishClientRequestCount:      newMetricVarnishClientRequestCount(mbc.Metrics.VarnishClientRequestCount),
-		metricVarnishClientRequestErrorCount: newMetricVarnishClientRequestErrorCount(mbc.Metrics.VarnishClientRequestErrorCount),
-		metricVarnishObjectCount:             newMetricVarnishObjectCount(mbc.Metrics.VarnishObjectCount),
-		metricVarnishObjectExpired:           newMetricVarnishObjectExpired(mbc.Metrics.VarnishObjectExpired),
-		metricVarnishObjectMoved:             newMetricVarnishObjectMoved(mbc.Metrics.VarnishObjectMoved),
-		metricVarnishObjectNuked:             newMetricVarnishObjectNuked(mbc.Metrics.VarnishObjectNuked),
-		metricVarnishSessionCount:            newMetricVarnishSessionCount(mbc.Metrics.VarnishSessionCount),
-		metricVarnishThreadOperationCount:    newMetricVarnishThreadOperationCount(mbc.Metrics.VarnishThreadOperationCount),
-		resourceAttributeIncludeFilter:       make(map[string]filter.Filter),
-		resourceAttributeExcludeFilter:       make(map[string]filter.Filter),
+		config:                              mbc,
+		startTime:                           pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                       pmetric.NewMetrics(),
+		buildInfo:                           settings.BuildInfo,
+		metricVarnishBackendConnectionCount: newMetricVarnishBackendConnectionCount(mbc.Metrics.VarnishBackendConnectionCount),
+		metricVarnishBackendRequestCount:    newMetricVarnishBackendRequestCount(mbc.Metrics.VarnishBackendRequestCount),
+		metricVarnishBackendServerConnectionCount: newMetricVarnishBackendServerConnectionCount(mbc.Metrics.VarnishBackendServerConnectionCount),
+		metricVarnishBackendServerHealth:          newMetricVarnishBackendServerHealth(mbc.Metrics.VarnishBackendServerHealth),
+		metricVarnishBackendServerIo:              newMetricVarnishBackendServerIo(mbc.Metrics.VarnishBackendServerIo),
+		metricVarnishBackendServerRequestCount:    newMetricVarnishBackendServerRequestCount(mbc.Metrics.VarnishBackendServerRequestCount),
+		metricVarnishCacheOperationCount:          newMetricVarnishCacheOperationCount(mbc.Metrics.VarnishCacheOperationCount),
+		metricVarnishClientRequestCount:           newMetricVarnishClientRequestCount(mbc.Metrics.VarnishClientRequestCount),
+		metricVarnishClientRequestErrorCount:      newMetricVarnishClientRequestErrorCount(mbc.Metrics.VarnishClientRequestErrorCount),
+		metricVarnishObjectCount:                  newMetricVarnishObjectCount(mbc.Metrics.VarnishObjectCount),
+		metricVarnishObjectExpired:                newMetricVarnishObjectExpired(mbc.Metrics.VarnishObjectExpired),
+		metricVarnishObjectMoved:                  newMetricVarnishObjectMoved(mbc.Metrics.VarnishObjectMoved),
+		metricVarnishObjectNuked:                  newMetricVarnishObjectNuked(mbc.Metrics.VarnishObjectNuked),
+		metricVarnishSessionCount:                 newMetricVarnishSessionCount(mbc.Metrics.VarnishSessionCount),
+		metricVarnishStorageAllocationCount:       newMetricVarnishStorageAllocationCount(mbc.Metrics.VarnishStorageAllocationCount),
+		metricVarnishStorageAllocationFailed:      newMetricVarnishStorageAllocationFailed(mbc.Metrics.VarnishStorageAllocationFailed),
+		metricVarnishStorageUsage:                 newMetricVarnishStorageUsage(mbc.Metrics.VarnishStorageUsage),
+		metricVarnishThreadOperationCount:         newMetricVarnishThreadOperationCount(mbc.Metrics.VarnishThreadOperationCount),
+		resourceAttributeIncludeFilter:            make(map[string]filter.Filter),
+		resourceAttributeExcludeFilter:            make(map[string]filter.Filter),
 	}
 	if mbc.ResourceAttributes.VarnishCacheName.MetricsInclude != nil {
 		mb.resourceAttributeIncludeFilter["varnish.cache.name"] = filter.CreateFilter(mbc.ResourceAttributes.VarnishCacheName.MetricsInclude)
@@ -1185,6 +1939,10 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
 	mb.metricVarnishBackendConnectionCount.emit(ils.Metrics())
 	mb.metricVarnishBackendRequestCount.emit(ils.Metrics())
+	mb.metricVarnishBackendServerConnectionCount.emit(ils.Metrics())
+	mb.metricVarnishBackendServerHealth.emit(ils.Metrics())
+	mb.metricVarnishBackendServerIo.emit(ils.Metrics())
+	mb.metricVarnishBackendServerRequestCount.emit(ils.Metrics())
 	mb.metricVarnishCacheOperationCount.emit(ils.Metrics())
 	mb.metricVarnishClientRequestCount.emit(ils.Metrics())
 	mb.metricVarnishClientRequestErrorCount.emit(ils.Metrics())
@@ -1193,6 +1951,9 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	mb.metricVarnishObjectMoved.emit(ils.Metrics())
 	mb.metricVarnishObjectNuked.emit(ils.Metrics())
 	mb.metricVarnishSessionCount.emit(ils.Metrics())
+	mb.metricVarnishStorageAllocationCount.emit(ils.Metrics())
+	mb.metricVarnishStorageAllocationFailed.emit(ils.Metrics())
+	mb.metricVarnishStorageUsage.emit(ils.Metrics())
 	mb.metricVarnishThreadOperationCount.emit(ils.Metrics())
 
 	for _, op := range options {
@@ -1235,6 +1996,26 @@ func (mb *MetricsBuilder) RecordVarnishBackendRequestCountDataPoint(ts pcommon.T
 	mb.metricVarnishBackendRequestCount.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordVarnishBackendServerConnectionCountDataPoint adds a data point to varnish.backend.server.connection.count metric.
+func (mb *MetricsBuilder) RecordVarnishBackendServerConnectionCountDataPoint(ts pcommon.Timestamp, val int64, backendNameAttributeValue string, vclNameAttributeValue string) {
+	mb.metricVarnishBackendServerConnectionCount.recordDataPoint(mb.startTime, ts, val, backendNameAttributeValue, vclNameAttributeValue)
+}
+
+// RecordVarnishBackendServerHealthDataPoint adds a data point to varnish.backend.server.health metric.
+func (mb *MetricsBuilder) RecordVarnishBackendServerHealthDataPoint(ts pcommon.Timestamp, val int64, backendNameAttributeValue string, vclNameAttributeValue string) {
+	mb.metricVarnishBackendServerHealth.recordDataPoint(mb.startTime, ts, val, backendNameAttributeValue, vclNameAttributeValue)
+}
+
+// RecordVarnishBackendServerIoDataPoint adds a data point to varnish.backend.server.io metric.
+func (mb *MetricsBuilder) RecordVarnishBackendServerIoDataPoint(ts pcommon.Timestamp, val int64, backendNameAttributeValue string, vclNameAttributeValue string, ioDirectionAttributeValue AttributeIoDirection) {
+	mb.metricVarnishBackendServerIo.recordDataPoint(mb.startTime, ts, val, backendNameAttributeValue, vclNameAttributeValue, ioDirectionAttributeValue.String())
+}
+
+// RecordVarnishBackendServerRequestCountDataPoint adds a data point to varnish.backend.server.request.count metric.
+func (mb *MetricsBuilder) RecordVarnishBackendServerRequestCountDataPoint(ts pcommon.Timestamp, val int64, backendNameAttributeValue string, vclNameAttributeValue string) {
+	mb.metricVarnishBackendServerRequestCount.recordDataPoint(mb.startTime, ts, val, backendNameAttributeValue, vclNameAttributeValue)
+}
+
 // RecordVarnishCacheOperationCountDataPoint adds a data point to varnish.cache.operation.count metric.
 func (mb *MetricsBuilder) RecordVarnishCacheOperationCountDataPoint(ts pcommon.Timestamp, val int64, cacheOperationsAttributeValue AttributeCacheOperations) {
 	mb.metricVarnishCacheOperationCount.recordDataPoint(mb.startTime, ts, val, cacheOperationsAttributeValue.String())
@@ -1275,6 +2056,21 @@ func (mb *MetricsBuilder) RecordVarnishSessionCountDataPoint(ts pcommon.Timestam
 	mb.metricVarnishSessionCount.recordDataPoint(mb.startTime, ts, val, sessionTypeAttributeValue.String())
 }
 
+// RecordVarnishStorageAllocationCountDataPoint adds a data point to varnish.storage.allocation.count metric.
+func (mb *MetricsBuilder) RecordVarnishStorageAllocationCountDataPoint(ts pcommon.Timestamp, val int64, storageNameAttributeValue string) {
+	mb.metricVarnishStorageAllocationCount.recordDataPoint(mb.startTime, ts, val, storageNameAttributeValue)
+}
+
+// RecordVarnishStorageAllocationFailedDataPoint adds a data point to varnish.storage.allocation.failed metric.
+func (mb *MetricsBuilder) RecordVarnishStorageAllocationFailedDataPoint(ts pcommon.Timestamp, val int64, storageNameAttributeValue string) {
+	mb.metricVarnishStorageAllocationFailed.recordDataPoint(mb.startTime, ts, val, storageNameAttributeValue)
+}
+
+// RecordVarnishStorageUsageDataPoint adds a data point to varnish.storage.usage metric.
+func (mb *MetricsBuilder) RecordVarnishStorageUsageDataPoint(ts pcommon.Timestamp, val int64, storageNameAttributeValue string, storageStateAttributeValue AttributeStorageState) {
+	mb.metricVarnishStorageUsage.recordDataPoint(mb.startTime, ts, val, storageNameAttributeValue, storageStateAttributeValue.String())
+}
+
 // RecordVarnishThreadOperationCountDataPoint adds a data point to varnish.thread.operation.count metric.
 func (mb *MetricsBuilder) RecordVarnishThreadOperationCountDataPoint(ts pcommon.Timestamp, val int64, threadOperationsAttributeValue AttributeThreadOperations) {
 	mb.metricVarnishThreadOperationCount.recordDataPoint(mb.startTime, ts, val, threadOperationsAttributeValue.String())
@@ -68,10 +68,17 @@ func TestMetricsBuilder(t *testing.T) {
 			mb := NewMetricsBuilder(loadMetricsBuilderConfig(t, tt.name), settings, WithStartTime(start))
 			aggMap := make(map[string]string) // contains the aggregation strategies for each metric name
 			aggMap["varnish.backend.connection.count"] = mb.metricVarnishBackendConnectionCount.config.AggregationStrategy
+			aggMap["varnish.backend.server.connection.count"] = mb.metricVarnishBackendServerConnectionCount.config.AggregationStrategy
+			aggMap["varnish.backend.server.health"] = mb.metricVarnishBackendServerHealth.config.AggregationStrategy
+			aggMap["varnish.backend.server.io"] = mb.metricVarnishBackendServerIo.config.AggregationStrategy
+			aggMap["varnish.backend.server.request.count"] = mb.metricVarnishBackendServerRequestCount.config.AggregationStrategy
 			aggMap["varnish.cache.operation.count"] = mb.metricVarnishCacheOperationCount.config.AggregationStrategy
 			aggMap["varnish.client.request.count"] = mb.metricVarnishClientRequestCount.config.AggregationStrategy
 			aggMap["varnish.client.request.error.count"] = mb.metricVarnishClientRequestErrorCount.config.AggregationStrategy
 			aggMap["varnish.session.count"] = mb.metricVarnishSessionCount.config.AggregationStrategy
+			aggMap["varnish.storage.allocation.count"] = mb.metricVarnishStorageAllocationCount.config.AggregationStrategy
+			aggMap["varnish.storage.allocation.failed"] = mb.metricVarnishStorageAllocationFailed.config.AggregationStrategy
+			aggMap["varnish.storage.usage"] = mb.metricVarnishStorageUsage.config.AggregationStrategy
 			aggMap["varnish.thread.operation.count"] = mb.metricVarnishThreadOperationCount.config.AggregationStrategy
 
 			expectedWarnings := 0
@@ -92,6 +99,30 @@ func TestMetricsBuilder(t *testing.T) {
 			mb.RecordVarnishBackendRequestCountDataPoint(ts, 1)
 			defaultMetricsCount++
 			allMetricsCount++
+			mb.RecordVarnishBackendServerConnectionCountDataPoint(ts, 1, "backend_name-val", "vcl_name-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordVarnishBackendServerConnectionCountDataPoint(ts, 3, "backend_name-val-2", "vcl_name-val-2")
+			}
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordVarnishBackendServerHealthDataPoint(ts, 1, "backend_name-val", "vcl_name-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordVarnishBackendServerHealthDataPoint(ts, 3, "backend_name-val-2", "vcl_name-val-2")
+			}
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordVarnishBackendServerIoDataPoint(ts, 1, "backend_name-val", "vcl_name-val", AttributeIoDirectionSent)
+			if tt.name == "reaggregate_set" {
+				mb.RecordVarnishBackendServerIoDataPoint(ts, 3, "backend_name-val-2", "vcl_name-val-2", AttributeIoDirectionReceived)
+			}
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordVarnishBackendServerRequestCountDataPoint(ts, 1, "backend_name-val", "vcl_name-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordVarnishBackendServerRequestCountDataPoint(ts, 3, "backend_name-val-2", "vcl_name-val-2")
+			}
+			defaultMetricsCount++
+			allMetricsCount++
 			mb.RecordVarnishCacheOperationCountDataPoint(ts, 1, AttributeCacheOperationsHit)
 			if tt.name == "reaggregate_set" {
 				mb.RecordVarnishCacheOperationCountDataPoint(ts, 3, AttributeCacheOperationsMiss)
@@ -128,6 +159,24 @@ func TestMetricsBuilder(t *testing.T) {
 			}
 			defaultMetricsCount++
 			allMetricsCount++
+			mb.RecordVarnishStorageAllocationCountDataPoint(ts, 1, "storage_name-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordVarnishStorageAllocationCountDataPoint(ts, 3, "storage_name-val-2")
+			}
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordVarnishStorageAllocationFailedDataPoint(ts, 1, "storage_name-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordVarnishStorageAllocationFailedDataPoint(ts, 3, "storage_name-val-2")
+			}
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordVarnishStorageUsageDataPoint(ts, 1, "storage_name-val", AttributeStorageStateUsed)
+			if tt.name == "reaggregate_set" {
+				mb.RecordVarnishStorageUsageDataPoint(ts, 3, "storage_name-val-2", AttributeStorageStateFree)
+			}
+			defaultMetricsCount++
+			allMetricsCount++
 			mb.RecordVarnishThreadOperationCountDataPoint(ts, 1, AttributeThreadOperationsCreated)
 			if tt.name == "reaggregate_set" {
 				mb.RecordVarnishThreadOperationCountDataPoint(ts, 3, AttributeThreadOperationsDestroyed)
@@ -139,10 +188,17 @@ func TestMetricsBuilder(t *testing.T) {
 			metrics := mb.Emit(WithResource(res))
 			if tt.name == "reaggregate_set" {
 				assert.Empty(t, mb.metricVarnishBackendConnectionCount.aggDataPoints)
+				assert.Empty(t, mb.metricVarnishBackendServerConnectionCount.aggDataPoints)
+				assert.Empty(t, mb.metricVarnishBackendServerHealth.aggDataPoints)
+				assert.Empty(t, mb.metricVarnishBackendServerIo.aggDataPoints)
+				assert.Empty(t, mb.metricVarnishBackendServerRequestCount.aggDataPoints)
 				assert.Empty(t, mb.metricVarnishCacheOperationCount.aggDataPoints)
 				assert.Empty(t, mb.metricVarnishClientRequestCount.aggDataPoints)
 				assert.Empty(t, mb.metricVarnishClientRequestErrorCount.aggDataPoints)
 				assert.Empty(t, mb.metricVarnishSessionCount.aggDataPoints)
+				assert.Empty(t, mb.metricVarnishStorageAllocationCount.aggDataPoints)
+				assert.Empty(t, mb.metricVarnishStorageAllocationFailed.aggDataPoints)
+				assert.Empty(t, mb.metricVarnishStorageUsage.aggDataPoints)
 				assert.Empty(t, mb.metricVarnishThreadOperationCount.aggDataPoints)
 			}
 
@@ -229,6 +285,203 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "varnish.backend.server.connection.count":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["varnish.backend.server.connection.count"], "Found a duplicate in the metrics slice: varnish.backend.server.connection.count")
+						validatedMetrics["varnish.backend.server.connection.count"] = true
+						assert.Equal(t, pmetric.MetricTypeSum, mi.Type())
+						assert.Equal(t, 1, mi.Sum().DataPoints().Len())
+						assert.Equal(t, "The current connections to the backend.", mi.Description())
+						assert.Equal(t, "{connections}", mi.Unit())
+						assert.False(t, mi.Sum().IsMonotonic())
+						assert.Equal(t, pmetric.AggregationTemporalityCumulative, mi.Sum().AggregationTemporality())
+						dp := mi.Sum().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						backendNameAttrVal, ok := dp.Attributes().Get("varnish.backend.name")
+						assert.True(t, ok)
+						assert.Equal(t, "backend_name-val", backendNameAttrVal.Str())
+						vclNameAttrVal, ok := dp.Attributes().Get("varnish.vcl.name")
+						assert.True(t, ok)
+						assert.Equal(t, "vcl_name-val", vclNameAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["varnish.backend.server.connection.count"], "Found a duplicate in the metrics slice: varnish.backend.server.connection.count")
+						validatedMetrics["varnish.backend.server.connection.count"] = true
+						assert.Equal(t, pmetric.MetricTypeSum, mi.Type())
+						assert.Equal(t, 1, mi.Sum().DataPoints().Len())
+						assert.Equal(t, "The current connections to the backend.", mi.Description())
+						assert.Equal(t, "{connections}", mi.Unit())
+						assert.False(t, mi.Sum().IsMonotonic())
+						assert.Equal(t, pmetric.AggregationTemporalityCumulative, mi.Sum().AggregationTemporality())
+						dp := mi.Sum().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["varnish.backend.server.connection.count"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("varnish.backend.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("varnish.vcl.name")
+						assert.False(t, ok)
+					}
+				case "varnish.backend.server.health":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["varnish.backend.server.health"], "Found a duplicate in the metrics slice: varnish.backend.server.health")
+						validatedMetrics["varnish.backend.server.health"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "Whether the most recent health probe of the backend succeeded, 1 if it did and 0 otherwise. Backends with no successful probe among their last 64 have no data point unless they are listed in `probed_backends`.", mi.Description())
+						assert.Equal(t, "1", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						backendNameAttrVal, ok := dp.Attributes().Get("varnish.backend.name")
+						assert.True(t, ok)
+						assert.Equal(t, "backend_name-val", backendNameAttrVal.Str())
+						vclNameAttrVal, ok := dp.Attributes().Get("varnish.vcl.name")
+						assert.True(t, ok)
+						assert.Equal(t, "vcl_name-val", vclNameAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["varnish.backend.server.health"], "Found a duplicate in the metrics slice: varnish.backend.server.health")
+						validatedMetrics["varnish.backend.server.health"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "Whether the most recent health probe of the backend succeeded, 1 if it did and 0 otherwise. Backends with no successful probe among their last 64 have no data point unless they are listed in `probed_backends`.", mi.Description())
+						assert.Equal(t, "1", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["varnish.backend.server.health"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("varnish.backend.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("varnish.vcl.name")
+						assert.False(t, ok)
+					}
+				case "varnish.backend.server.io":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["varnish.backend.server.io"], "Found a duplicate in the metrics slice: varnish.backend.server.io")
+						validatedMetrics["varnish.backend.server.io"] = true
+						assert.Equal(t, pmetric.MetricTypeSum, mi.Type())
+						assert.Equal(t, 1, mi.Sum().DataPoints().Len())
+						assert.Equal(t, "The bytes of request and response headers and bodies exchanged with the backend.", mi.Description())
+						assert.Equal(t, "By", mi.Unit())
+						assert.True(t, mi.Sum().IsMonotonic())
+						assert.Equal(t, pmetric.AggregationTemporalityCumulative, mi.Sum().AggregationTemporality())
+						dp := mi.Sum().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						backendNameAttrVal, ok := dp.Attributes().Get("varnish.backend.name")
+						assert.True(t, ok)
+						assert.Equal(t, "backend_name-val", backendNameAttrVal.Str())
+						vclNameAttrVal, ok := dp.Attributes().Get("varnish.vcl.name")
+						assert.True(t, ok)
+						assert.Equal(t, "vcl_name-val", vclNameAttrVal.Str())
+						ioDirectionAttrVal, ok := dp.Attributes().Get("direction")
+						assert.True(t, ok)
+						assert.Equal(t, "sent", ioDirectionAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["varnish.backend.server.io"], "Found a duplicate in the metrics slice: varnish.backend.server.io")
+						validatedMetrics["varnish.backend.server.io"] = true
+						assert.Equal(t, pmetric.MetricTypeSum, mi.Type())
+						assert.Equal(t, 1, mi.Sum().DataPoints().Len())
+						assert.Equal(t, "The bytes of request and response headers and bodies exchanged with the backend.", mi.Description())
+						assert.Equal(t, "By", mi.Unit())
+						assert.True(t, mi.Sum().IsMonotonic())
+						assert.Equal(t, pmetric.AggregationTemporalityCumulative, mi.Sum().AggregationTemporality())
+						dp := mi.Sum().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["varnish.backend.server.io"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("varnish.backend.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("varnish.vcl.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("direction")
+						assert.False(t, ok)
+					}
+				case "varnish.backend.server.request.count":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["varnish.backend.server.request.count"], "Found a duplicate in the metrics slice: varnish.backend.server.request.count")
+						validatedMetrics["varnish.backend.server.request.count"] = true
+						assert.Equal(t, pmetric.MetricTypeSum, mi.Type())
+						assert.Equal(t, 1, mi.Sum().DataPoints().Len())
+						assert.Equal(t, "The requests sent to the backend count.", mi.Description())
+						assert.Equal(t, "{requests}", mi.Unit())
+						assert.True(t, mi.Sum().IsMonotonic())
+						assert.Equal(t, pmetric.AggregationTemporalityCumulative, mi.Sum().AggregationTemporality())
+						dp := mi.Sum().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						backendNameAttrVal, ok := dp.Attributes().Get("varnish.backend.name")
+						assert.True(t, ok)
+						assert.Equal(t, "backend_name-val", backendNameAttrVal.Str())
+						vclNameAttrVal, ok := dp.Attributes().Get("varnish.vcl.name")
+						assert.True(t, ok)
+						assert.Equal(t, "vcl_name-val", vclNameAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["varnish.backend.server.request.count"], "Found a duplicate in the metrics slice: varnish.backend.server.request.count")
+						validatedMetrics["varnish.backend.server.request.count"] = true
+						assert.Equal(t, pmetric.MetricTypeSum, mi.Type())
+						assert.Equal(t, 1, mi.Sum().DataPoints().Len())
+						assert.Equal(t, "The requests sent to the backend count.", mi.Description())
+						assert.Equal(t, "{requests}", mi.Unit())
+						assert.True(t, mi.Sum().IsMonotonic())
+						assert.Equal(t, pmetric.AggregationTemporalityCumulative, mi.Sum().AggregationTemporality())
+						dp := mi.Sum().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["varnish.backend.server.request.count"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("varnish.backend.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("varnish.vcl.name")
+						assert.False(t, ok)
+					}
 				case "varnish.cache.operation.count":
 					if tt.name != "reaggregate_set" {
 						assert.False(t, validatedMetrics["varnish.cache.operation.count"], "Found a duplicate in the metrics slice: varnish.cache.operation.count")
@@ -461,6 +714,143 @@ func TestMetricsBuilder(t *testing.T) {
 						_, ok := dp.Attributes().Get("kind")
 						assert.False(t, ok)
 					}
+				case "varnish.storage.allocation.count":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["varnish.storage.allocation.count"], "Found a duplicate in the metrics slice: varnish.storage.allocation.count")
+						validatedMetrics["varnish.storage.allocation.count"] = true
+						assert.Equal(t, pmetric.MetricTypeSum, mi.Type())
+						assert.Equal(t, 1, mi.Sum().DataPoints().Len())
+						assert.Equal(t, "The allocation requests made to the storage count.", mi.Description())
+						assert.Equal(t, "{allocations}", mi.Unit())
+						assert.True(t, mi.Sum().IsMonotonic())
+						assert.Equal(t, pmetric.AggregationTemporalityCumulative, mi.Sum().AggregationTemporality())
+						dp := mi.Sum().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						storageNameAttrVal, ok := dp.Attributes().Get("varnish.storage.name")
+						assert.True(t, ok)
+						assert.Equal(t, "storage_name-val", storageNameAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["varnish.storage.allocation.count"], "Found a duplicate in the metrics slice: varnish.storage.allocation.count")
+						validatedMetrics["varnish.storage.allocation.count"] = true
+						assert.Equal(t, pmetric.MetricTypeSum, mi.Type())
+						assert.Equal(t, 1, mi.Sum().DataPoints().Len())
+						assert.Equal(t, "The allocation requests made to the storage count.", mi.Description())
+						assert.Equal(t, "{allocations}", mi.Unit())
+						assert.True(t, mi.Sum().IsMonotonic())
+						assert.Equal(t, pmetric.AggregationTemporalityCumulative, mi.Sum().AggregationTemporality())
+						dp := mi.Sum().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["varnish.storage.allocation.count"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("varnish.storage.name")
+						assert.False(t, ok)
+					}
+				case "varnish.storage.allocation.failed":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["varnish.storage.allocation.failed"], "Found a duplicate in the metrics slice: varnish.storage.allocation.failed")
+						validatedMetrics["varnish.storage.allocation.failed"] = true
+						assert.Equal(t, pmetric.MetricTypeSum, mi.Type())
+						assert.Equal(t, 1, mi.Sum().DataPoints().Len())
+						assert.Equal(t, "The allocation requests to the storage that failed count.", mi.Description())
+						assert.Equal(t, "{allocations}", mi.Unit())
+						assert.True(t, mi.Sum().IsMonotonic())
+						assert.Equal(t, pmetric.AggregationTemporalityCumulative, mi.Sum().AggregationTemporality())
+						dp := mi.Sum().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						storageNameAttrVal, ok := dp.Attributes().Get("varnish.storage.name")
+						assert.True(t, ok)
+						assert.Equal(t, "storage_name-val", storageNameAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["varnish.storage.allocation.failed"], "Found a duplicate in the metrics slice: varnish.storage.allocation.failed")
+						validatedMetrics["varnish.storage.allocation.failed"] = true
+						assert.Equal(t, pmetric.MetricTypeSum, mi.Type())
+						assert.Equal(t, 1, mi.Sum().DataPoints().Len())
+						assert.Equal(t, "The allocation requests to the storage that failed count.", mi.Description())
+						assert.Equal(t, "{allocations}", mi.Unit())
+						assert.True(t, mi.Sum().IsMonotonic())
+						assert.Equal(t, pmetric.AggregationTemporalityCumulative, mi.Sum().AggregationTemporality())
+						dp := mi.Sum().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["varnish.storage.allocation.failed"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("varnish.storage.name")
+						assert.False(t, ok)
+					}
+				case "varnish.storage.usage":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["varnish.storage.usage"], "Found a duplicate in the metrics slice: varnish.storage.usage")
+						validatedMetrics["varnish.storage.usage"] = true
+						assert.Equal(t, pmetric.MetricTypeSum, mi.Type())
+						assert.Equal(t, 1, mi.Sum().DataPoints().Len())
+						assert.Equal(t, "The bytes of the storage by state.", mi.Description())
+						assert.Equal(t, "By", mi.Unit())
+						assert.False(t, mi.Sum().IsMonotonic())
+						assert.Equal(t, pmetric.AggregationTemporalityCumulative, mi.Sum().AggregationTemporality())
+						dp := mi.Sum().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						storageNameAttrVal, ok := dp.Attributes().Get("varnish.storage.name")
+						assert.True(t, ok)
+						assert.Equal(t, "storage_name-val", storageNameAttrVal.Str())
+						storageStateAttrVal, ok := dp.Attributes().Get("state")
+						assert.True(t, ok)
+						assert.Equal(t, "used", storageStateAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["varnish.storage.usage"], "Found a duplicate in the metrics slice: varnish.storage.usage")
+						validatedMetrics["varnish.storage.usage"] = true
+						assert.Equal(t, pmetric.MetricTypeSum, mi.Type())
+						assert.Equal(t, 1, mi.Sum().DataPoints().Len())
+						assert.Equal(t, "The bytes of the storage by state.", mi.Description())
+						assert.Equal(t, "By", mi.Unit())
+						assert.False(t, mi.Sum().IsMonotonic())
+						assert.Equal(t, pmetric.AggregationTemporalityCumulative, mi.Sum().AggregationTemporality())
+						dp := mi.Sum().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["varnish.storage.usage"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("varnish.storage.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("state")
+						assert.False(t, ok)
+					}
 				case "varnish.thread.operation.count":
 					if tt.name != "reaggregate_set" {
 						assert.False(t, validatedMetrics["varnish.thread.operation.count"], "Found a duplicate in the metrics slice: varnish.thread.operation.count")
@@ -23,13 +23,6 @@ import (
 	"github.com/GoogleCloudPlatform/opentelemetry-operations-collector/components/otelopscol/receiver/varnishreceiver/internal/metadata"
 )
 
-// FullStats holds stats from a 6.5+ response.
-type FullStats struct {
-	Version   int    `json:"version"`
-	Timestamp string `json:"timestamp"`
-	Counters  Stats  `json:"counters"`
-}
-
 // Stats holds the metric stats.
 type Stats struct {
 	MAINBackendConn struct {
@@ -110,6 +103,46 @@ type Stats struct {
 	MAINBackendReq struct {
 		Value int64 `json:"value"`
 	} `json:"MAIN.backend_req"`
+
+	// Backends holds the VBE.* counters by VCL and backend name.
+	Backends map[BackendKey]*BackendStats `json:"-"`
+	// Storages holds the SMA.* and SMF.* counters by storage name.
+	Storages map[string]*StorageStats `json:"-"`
+}
+
+// BackendKey identifies a backend. Varnish keeps separate counters for the
+// backends of each loaded VCL, including the cold ones.
+type BackendKey struct {
+	VCL  string
+	Name string
+}
+
+// BackendStats holds the stats of a backend.
+type BackendStats struct {
+	Requests      int64
+	BytesSent     int64
+	BytesReceived int64
+	Connections   int64
+	// Happy is the bitmap of the results of the recent health probes, the
+	// most recent one in the least significant bit.
+	Happy uint64
+}
+
+// health returns whether the backend is healthy, and false for known if it
+// can't tell whether the backend has a health probe. The happy bitmap of a
+// backend without a probe is always 0, like the one of a backend whose last
+// 64 probes all failed, so a backend with a 0 bitmap is only known to be
+// unhealthy if it is configured as probed.
+func (b *BackendStats) health(probed bool) (healthy bool, known bool) {
+	return b.Happy&1 == 1, probed || b.Happy != 0
+}
+
+// StorageStats holds the stats of a storage backend.
+type StorageStats struct {
+	Allocations        int64
+	AllocationFailures int64
+	BytesUsed          int64
+	BytesFree          int64
 }
 
 func (v *varnishScraper) recordVarnishBackendConnectionsCountDataPoint(now pcommon.Timestamp, stats *Stats) {
@@ -186,3 +219,29 @@ func (v *varnishScraper) recordVarnishClientRequestErrorCountDataPoint(now pcomm
 		v.mb.RecordVarnishClientRequestErrorCountDataPoint(now, attributeValue, attributeName)
 	}
 }
+
+func (v *varnishScraper) recordVarnishBackendServerDataPoints(now pcommon.Timestamp, stats *Stats) {
+	for key, backend := range stats.Backends {
+		v.mb.RecordVarnishBackendServerRequestCountDataPoint(now, backend.Requests, key.Name, key.VCL)
+		v.mb.RecordVarnishBackendServerIoDataPoint(now, backend.BytesSent, key.Name, key.VCL, metadata.AttributeIoDirectionSent)
+		v.mb.RecordVarnishBackendServerIoDataPoint(now, backend.BytesReceived, key.Name, key.VCL, metadata.AttributeIoDirectionReceived)
+		v.mb.RecordVarnishBackendServerConnectionCountDataPoint(now, backend.Connections, key.Name, key.VCL)
+		_, probed := v.probedBackends[key.Name]
+		if healthy, known := backend.health(probed); known {
+			var value int64
+			if healthy {
+				value = 1
+			}
+			v.mb.RecordVarnishBackendServerHealthDataPoint(now, value, key.Name, key.VCL)
+		}
+	}
+}
+
+func (v *varnishScraper) recordVarnishStorageDataPoints(now pcommon.Timestamp, stats *Stats) {
+	for name, storage := range stats.Storages {
+		v.mb.RecordVarnishStorageAllocationCountDataPoint(now, storage.Allocations, name)
+		v.mb.RecordVarnishStorageAllocationFailedDataPoint(now, storage.AllocationFailures, name)
+		v.mb.RecordVarnishStorageUsageDataPoint(now, storage.BytesUsed, name, metadata.AttributeStorageStateUsed)
+		v.mb.RecordVarnishStorageUsageDataPoint(now, storage.BytesFree, name, metadata.AttributeStorageStateFree)
+	}
+}
@@ -16,6 +16,7 @@ package varnishreceiver
 
 import (
 	"context"
+	"errors"
 	"os"
 	"path/filepath"
 	"time"
@@ -24,62 +25,101 @@ import (
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/scraper/scrapererror"
 	"go.uber.org/zap"
 
 	"github.com/GoogleCloudPlatform/opentelemetry-operations-collector/components/otelopscol/receiver/varnishreceiver/internal/metadata"
 )
 
 type varnishScraper struct {
-	client            client
+	instances         []*varnishInstance
 	config            *Config
+	probedBackends    map[string]struct{}
 	telemetrySettings component.TelemetrySettings
 	mb                *metadata.MetricsBuilder
-	cacheName         string
+}
+
+// varnishInstance is a varnish instance the scraper collects metrics from.
+type varnishInstance struct {
+	client    client
+	cacheDir  string
+	cacheName string
 }
 
 func newVarnishScraper(settings receiver.Settings, config *Config) *varnishScraper {
+	mbConfig := metadata.DefaultMetricsBuilderConfig()
+	mbConfig.Metrics = config.Metrics
+	probedBackends := make(map[string]struct{}, len(config.ProbedBackends))
+	for _, name := range config.ProbedBackends {
+		probedBackends[name] = struct{}{}
+	}
 	return &varnishScraper{
 		telemetrySettings: settings.TelemetrySettings,
 		config:            config,
-		mb:                metadata.NewMetricsBuilder(metadata.DefaultMetricsBuilderConfig(), settings),
+		probedBackends:    probedBackends,
+		mb:                metadata.NewMetricsBuilder(mbConfig, settings),
 	}
 }
 
 func (v *varnishScraper) start(_ context.Context, host component.Host) error {
-	v.client = newVarnishClient(v.config, host, v.telemetrySettings)
-	return v.setCacheName()
-}
-
-// setCacheName sets the cache name to the targeted varnish instance.
-func (v *varnishScraper) setCacheName() error {
-	if v.config.CacheDir == "" {
-		hostname, err := os.Hostname()
+	v.instances = nil
+	for _, cacheDir := range v.config.cacheDirs() {
+		name, err := cacheName(cacheDir)
 		if err != nil {
 			return err
 		}
-		v.cacheName = hostname
-		return nil
+		v.instances = append(v.instances, &varnishInstance{
+			client:    newVarnishClient(v.config, cacheDir, host, v.telemetrySettings),
+			cacheDir:  cacheDir,
+			cacheName: name,
+		})
 	}
-
-	v.cacheName = filepath.Base(v.config.CacheDir)
 	return nil
 }
 
+// cacheName returns the cache name of the varnish instance of cacheDir.
+func cacheName(cacheDir string) (string, error) {
+	if cacheDir == "" {
+		return os.Hostname()
+	}
+
+	return filepath.Base(cacheDir), nil
+}
+
 func (v *varnishScraper) scrape(context.Context) (pmetric.Metrics, error) {
-	stats, err := v.client.GetStats()
+	var errs []error
+	for _, instance := range v.instances {
+		if err := v.scrapeInstance(instance); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 && len(errs) == len(v.instances) {
+		return pmetric.NewMetrics(), errors.Join(errs...)
+	}
+
+	metrics := v.mb.Emit()
+	if len(errs) > 0 {
+		return metrics, scrapererror.NewPartialScrapeError(errors.Join(errs...), len(errs))
+	}
+	return metrics, nil
+}
+
+func (v *varnishScraper) scrapeInstance(instance *varnishInstance) error {
+	stats, err := instance.client.GetStats()
 	if err != nil {
 		v.telemetrySettings.Logger.Error("Failed to execute varnishstat",
-			zap.String("Cache Dir:", v.config.CacheDir),
+			zap.String("Cache Dir:", instance.cacheDir),
 			zap.String("Executable Directory:", v.config.ExecDir),
 			zap.Error(err),
 		)
-		return pmetric.NewMetrics(), err
+		return err
 	}
 
 	now := pcommon.NewTimestampFromTime(time.Now())
 
 	rb := v.mb.NewResourceBuilder()
-	rb.SetVarnishCacheName(v.cacheName)
+	rb.SetVarnishCacheName(instance.cacheName)
 
 	v.recordVarnishBackendConnectionsCountDataPoint(now, stats)
 	v.recordVarnishCacheOperationsCountDataPoint(now, stats)
@@ -87,6 +127,8 @@ func (v *varnishScraper) scrape(context.Context) (pmetric.Metrics, error) {
 	v.recordVarnishSessionCountDataPoint(now, stats)
 	v.recordVarnishClientRequestsCountDataPoint(now, stats)
 	v.recordVarnishClientRequestErrorCountDataPoint(now, stats)
+	v.recordVarnishBackendServerDataPoints(now, stats)
+	v.recordVarnishStorageDataPoints(now, stats)
 
 	v.mb.RecordVarnishObjectExpiredDataPoint(now, stats.MAINNExpired.Value)
 	v.mb.RecordVarnishObjectNukedDataPoint(now, stats.MAINNLruNuked.Value)
@@ -94,5 +136,6 @@ func (v *varnishScraper) scrape(context.Context) (pmetric.Metrics, error) {
 	v.mb.RecordVarnishObjectCountDataPoint(now, stats.MAINNObject.Value)
 	v.mb.RecordVarnishBackendRequestCountDataPoint(now, stats.MAINBackendReq.Value)
 
-	return v.mb.Emit(metadata.WithResource(rb.Emit())), nil
+	v.mb.EmitForResource(metadata.WithResource(rb.Emit()))
+	return nil
 }
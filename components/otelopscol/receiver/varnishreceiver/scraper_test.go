@@ -28,6 +28,7 @@ import (
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/scraper/scrapererror"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"go.uber.org/zap/zaptest/observer"
@@ -43,7 +44,7 @@ func TestScrape(t *testing.T) {
 		mockClient.On("GetStats").Return(getStats(t, "mock_response6_5.json"))
 
 		scraper := newVarnishScraper(receivertest.NewNopSettings(metadata.Type), cfg)
-		scraper.client = mockClient
+		scraper.instances = []*varnishInstance{{client: mockClient, cacheName: "cache_name"}}
 		actualMetrics, err := scraper.scrape(context.Background())
 		require.NoError(t, err)
 		require.Equal(t, 1, actualMetrics.ResourceMetrics().Len())
@@ -56,7 +57,7 @@ func TestScrape(t *testing.T) {
 		mockClient.On("GetStats").Return(getStats(t, "mock_response6_0.json"))
 
 		scraper := newVarnishScraper(receivertest.NewNopSettings(metadata.Type), cfg)
-		scraper.client = mockClient
+		scraper.instances = []*varnishInstance{{client: mockClient, cacheName: "cache_name"}}
 		actualMetrics, err := scraper.scrape(context.Background())
 		require.NoError(t, err)
 		require.Equal(t, 1, actualMetrics.ResourceMetrics().Len())
@@ -72,7 +73,7 @@ func TestScrape(t *testing.T) {
 		mockClient := new(mockClient)
 		mockClient.On("GetStats").Return(getStats(t, ""))
 		scraper := newVarnishScraper(settings, cfg)
-		scraper.client = mockClient
+		scraper.instances = []*varnishInstance{{client: mockClient, cacheName: "cache_name"}}
 
 		_, err := scraper.scrape(context.Background())
 		require.NotNil(t, err)
@@ -89,11 +90,110 @@ func TestScrape(t *testing.T) {
 		}, logs.AllUntimed())
 	})
 
+	t.Run("partial scrape error", func(t *testing.T) {
+		failingClient := new(mockClient)
+		failingClient.On("GetStats").Return(getStats(t, ""))
+		mockClient := new(mockClient)
+		mockClient.On("GetStats").Return(getStats(t, "mock_response6_5.json"))
+
+		scraper := newVarnishScraper(receivertest.NewNopSettings(metadata.Type), cfg)
+		scraper.instances = []*varnishInstance{
+			{client: failingClient, cacheDir: "/path/failing", cacheName: "failing"},
+			{client: mockClient, cacheDir: "/path/cache_name", cacheName: "cache_name"},
+		}
+
+		actualMetrics, err := scraper.scrape(context.Background())
+		require.True(t, scrapererror.IsPartialScrapeError(err))
+		require.ErrorContains(t, err, "bad response")
+		require.Equal(t, 1, actualMetrics.ResourceMetrics().Len())
+		cacheName, ok := actualMetrics.ResourceMetrics().At(0).Resource().Attributes().Get("varnish.cache.name")
+		require.True(t, ok)
+		require.Equal(t, "cache_name", cacheName.Str())
+	})
+}
+
+func TestScrapeDisabledMetrics(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.Metrics.VarnishBackendServerHealth.Enabled = false
+
+	mockClient := new(mockClient)
+	mockClient.On("GetStats").Return(getStats(t, "mock_response6_5.json"))
+
+	scraper := newVarnishScraper(receivertest.NewNopSettings(metadata.Type), cfg)
+	scraper.instances = []*varnishInstance{{client: mockClient, cacheName: "cache_name"}}
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	ms := actualMetrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	names := map[string]bool{}
+	for i := 0; i < ms.Len(); i++ {
+		names[ms.At(i).Name()] = true
+	}
+	require.False(t, names["varnish.backend.server.health"])
+	require.True(t, names["varnish.backend.server.request.count"])
+}
+
+func TestScrapeProbedBackends(t *testing.T) {
+	stats := &Stats{
+		Backends: map[BackendKey]*BackendStats{
+			{VCL: "boot", Name: "dead"}:    {Requests: 1},
+			{VCL: "boot", Name: "noprobe"}: {Requests: 2},
+		},
+	}
+
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.ProbedBackends = []string{"dead"}
+
+	mockClient := new(mockClient)
+	mockClient.On("GetStats").Return(stats, nil)
+
+	scraper := newVarnishScraper(receivertest.NewNopSettings(metadata.Type), cfg)
+	scraper.instances = []*varnishInstance{{client: mockClient, cacheName: "cache_name"}}
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	ms := actualMetrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		if m := ms.At(i); m.Name() == "varnish.backend.server.health" {
+			require.Equal(t, map[string]int64{
+				"map[varnish.backend.name:dead varnish.vcl.name:boot]": int64(0),
+			}, intValuesByAttributes(m.Gauge().DataPoints()))
+			return
+		}
+	}
+	t.Fatal("varnish.backend.server.health not found")
+}
+
+func TestScrapeInstances(t *testing.T) {
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+
+	scraper := newVarnishScraper(receivertest.NewNopSettings(metadata.Type), cfg)
+	for _, name := range []string{"first", "second"} {
+		mockClient := new(mockClient)
+		mockClient.On("GetStats").Return(getStats(t, "mock_response6_5.json"))
+		scraper.instances = append(scraper.instances, &varnishInstance{client: mockClient, cacheName: name})
+	}
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	rms := actualMetrics.ResourceMetrics()
+	require.Equal(t, 2, rms.Len())
+	for i, name := range []string{"first", "second"} {
+		cacheName, ok := rms.At(i).Resource().Attributes().Get("varnish.cache.name")
+		require.True(t, ok)
+		require.Equal(t, name, cacheName.Str())
+
+		resourceMetrics := pmetric.NewMetrics()
+		rms.At(i).CopyTo(resourceMetrics.ResourceMetrics().AppendEmpty())
+		validateScraperResult(t, resourceMetrics)
+	}
 }
 
 func validateScraperResult(t *testing.T, actualMetrics pmetric.Metrics) {
-	require.Equal(t, actualMetrics.MetricCount(), 11)
-	require.Equal(t, actualMetrics.DataPointCount(), 26)
+	require.Equal(t, actualMetrics.MetricCount(), 18)
+	require.Equal(t, actualMetrics.DataPointCount(), 48)
 
 	ilms := actualMetrics.ResourceMetrics().At(0).ScopeMetrics()
 	require.Equal(t, 1, ilms.Len())
@@ -221,10 +321,63 @@ func validateScraperResult(t *testing.T, actualMetrics pmetric.Metrics) {
 			dps := m.Sum().DataPoints()
 			require.Equal(t, 1, dps.Len())
 			require.EqualValues(t, int64(22), dps.At(0).IntValue())
+		case "varnish.backend.server.request.count":
+			require.Equal(t, map[string]int64{
+				"map[varnish.backend.name:api varnish.vcl.name:boot]":     int64(38),
+				"map[varnish.backend.name:default varnish.vcl.name:boot]": int64(32),
+			}, intValuesByAttributes(m.Sum().DataPoints()))
+		case "varnish.backend.server.io":
+			require.Equal(t, map[string]int64{
+				"map[direction:received varnish.backend.name:api varnish.vcl.name:boot]":     int64(71),
+				"map[direction:received varnish.backend.name:default varnish.vcl.name:boot]": int64(59),
+				"map[direction:sent varnish.backend.name:api varnish.vcl.name:boot]":         int64(67),
+				"map[direction:sent varnish.backend.name:default varnish.vcl.name:boot]":     int64(55),
+			}, intValuesByAttributes(m.Sum().DataPoints()))
+		case "varnish.backend.server.connection.count":
+			require.Equal(t, map[string]int64{
+				"map[varnish.backend.name:api varnish.vcl.name:boot]":     int64(37),
+				"map[varnish.backend.name:default varnish.vcl.name:boot]": int64(31),
+			}, intValuesByAttributes(m.Sum().DataPoints()))
+		case "varnish.backend.server.health":
+			require.Equal(t, map[string]int64{
+				"map[varnish.backend.name:api varnish.vcl.name:boot]":     int64(0),
+				"map[varnish.backend.name:default varnish.vcl.name:boot]": int64(1),
+			}, intValuesByAttributes(m.Gauge().DataPoints()))
+		case "varnish.storage.allocation.count":
+			require.Equal(t, map[string]int64{
+				"map[varnish.storage.name:Transient]": int64(43),
+				"map[varnish.storage.name:disk]":      int64(47),
+				"map[varnish.storage.name:s0]":        int64(39),
+			}, intValuesByAttributes(m.Sum().DataPoints()))
+		case "varnish.storage.allocation.failed":
+			require.Equal(t, map[string]int64{
+				"map[varnish.storage.name:Transient]": int64(44),
+				"map[varnish.storage.name:disk]":      int64(48),
+				"map[varnish.storage.name:s0]":        int64(40),
+			}, intValuesByAttributes(m.Sum().DataPoints()))
+		case "varnish.storage.usage":
+			require.Equal(t, map[string]int64{
+				"map[state:free varnish.storage.name:Transient]": int64(46),
+				"map[state:free varnish.storage.name:disk]":      int64(50),
+				"map[state:free varnish.storage.name:s0]":        int64(42),
+				"map[state:used varnish.storage.name:Transient]": int64(45),
+				"map[state:used varnish.storage.name:disk]":      int64(49),
+				"map[state:used varnish.storage.name:s0]":        int64(41),
+			}, intValuesByAttributes(m.Sum().DataPoints()))
 		}
 	}
 }
 
+// intValuesByAttributes returns the values of the data points keyed by their attributes.
+func intValuesByAttributes(dps pmetric.NumberDataPointSlice) map[string]int64 {
+	values := map[string]int64{}
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		values[fmt.Sprint(dp.Attributes().AsRaw())] = dp.IntValue()
+	}
+	return values
+}
+
 func TestStart(t *testing.T) {
 	t.Run("start with default hostname", func(t *testing.T) {
 		hostname, err := os.Hostname()
@@ -235,7 +388,8 @@ func TestStart(t *testing.T) {
 		scraper := newVarnishScraper(receivertest.NewNopSettings(metadata.Type), cfg)
 		err = scraper.start(context.Background(), componenttest.NewNopHost())
 		require.NoError(t, err)
-		require.EqualValues(t, hostname, scraper.cacheName)
+		require.Len(t, scraper.instances, 1)
+		require.EqualValues(t, hostname, scraper.instances[0].cacheName)
 	})
 	t.Run("start with specified cache dir", func(t *testing.T) {
 		f := NewFactory()
@@ -244,33 +398,41 @@ func TestStart(t *testing.T) {
 		scraper := newVarnishScraper(receivertest.NewNopSettings(metadata.Type), cfg)
 		err := scraper.start(context.Background(), componenttest.NewNopHost())
 		require.NoError(t, err)
-		require.EqualValues(t, "cache_name", scraper.cacheName)
+		require.Len(t, scraper.instances, 1)
+		require.EqualValues(t, "cache_name", scraper.instances[0].cacheName)
 	})
-}
-
-func TestSetDefaultCacheName(t *testing.T) {
-	t.Run("missing cache dir", func(t *testing.T) {
+	t.Run("start with instances", func(t *testing.T) {
 		f := NewFactory()
 		cfg := f.CreateDefaultConfig().(*Config)
+		cfg.Instances = []InstanceConfig{
+			{CacheDir: "/path/first"},
+			{CacheDir: "/path/second"},
+		}
 		scraper := newVarnishScraper(receivertest.NewNopSettings(metadata.Type), cfg)
+		err := scraper.start(context.Background(), componenttest.NewNopHost())
+		require.NoError(t, err)
+		require.Len(t, scraper.instances, 2)
+		require.EqualValues(t, "/path/first", scraper.instances[0].cacheDir)
+		require.EqualValues(t, "first", scraper.instances[0].cacheName)
+		require.EqualValues(t, "/path/second", scraper.instances[1].cacheDir)
+		require.EqualValues(t, "second", scraper.instances[1].cacheName)
+	})
+}
 
+func TestCacheName(t *testing.T) {
+	t.Run("missing cache dir", func(t *testing.T) {
 		hostname, err := os.Hostname()
 		require.NoError(t, err)
 
-		err = scraper.setCacheName()
+		name, err := cacheName("")
 		require.NoError(t, err)
-		require.EqualValues(t, hostname, scraper.cacheName)
+		require.EqualValues(t, hostname, name)
 	})
 
 	t.Run("found cache dir", func(t *testing.T) {
-		f := NewFactory()
-		cfg := f.CreateDefaultConfig().(*Config)
-		cfg.CacheDir = "/path/cache_name"
-		scraper := newVarnishScraper(receivertest.NewNopSettings(metadata.Type), cfg)
-
-		err := scraper.setCacheName()
+		name, err := cacheName("/path/cache_name")
 		require.NoError(t, err)
-		require.EqualValues(t, "cache_name", scraper.cacheName)
+		require.EqualValues(t, "cache_name", name)
 	})
 }
 
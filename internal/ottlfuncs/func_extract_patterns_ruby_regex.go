@@ -17,6 +17,7 @@ package ottlfuncs
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
 
@@ -29,6 +30,9 @@ type ExtractPatternsRubyRegexArguments[K any] struct {
 	Target          ottl.StringGetter[K]
 	Pattern         string
 	OmitEmptyValues ottl.Optional[bool]
+	RetryLimit      ottl.Optional[int64]
+	Encoding        ottl.Optional[string]
+	TimeLimit       ottl.Optional[string]
 }
 
 func NewExtractPatternsRubyRegexFactory[K any]() ottl.Factory[K] {
@@ -47,15 +51,60 @@ func createExtractPatternsRubyRegexFunction[K any](_ ottl.FunctionContext, oArgs
 		omitEmptyValues = args.OmitEmptyValues.Get()
 	}
 
-	return extractPatternsRubyRegex(args.Target, args.Pattern, omitEmptyValues)
+	return extractPatternsRubyRegex(args.Target, args.Pattern, omitEmptyValues, args.RetryLimit, args.Encoding, args.TimeLimit)
 }
 
-func extractPatternsRubyRegex[K any](target ottl.StringGetter[K], pattern string, omitEmtpyValues bool) (ottl.ExprFunc[K], error) {
-	r, err := rubex.NewRegexp(pattern, rubex.ONIG_OPTION_DEFAULT)
+func extractPatternsRubyRegex[K any](target ottl.StringGetter[K], pattern string, omitEmtpyValues bool, retryLimit ottl.Optional[int64], encoding ottl.Optional[string], timeLimit ottl.Optional[string]) (ottl.ExprFunc[K], error) {
+	extractor, err := newRubyRegexExtractor(pattern, omitEmtpyValues, retryLimit, encoding, timeLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := extractor.Extract(val)
+		if err != nil {
+			return nil, fmt.Errorf("ExtractPatternsRubyRegex failed to match pattern %q: %w", pattern, err)
+		}
+		return result, nil
+	}, nil
+}
+
+// RubyRegexExtractor extracts the named capture groups of a Ruby regex, the
+// same way as ExtractPatternsRubyRegex.
+type RubyRegexExtractor struct {
+	r               *rubex.Regexp
+	omitEmptyValues bool
+	// namedCaptureGroups is the number of named capture groups of r.
+	namedCaptureGroups int
+	// indexesPool holds the buffers the indexes of the matches are stored
+	// in, so that extracting doesn't allocate them for every value.
+	indexesPool sync.Pool
+}
+
+// NewRubyRegexExtractor compiles pattern, which must have at least one named
+// capture group, with the limits of params applied to its searches.
+func NewRubyRegexExtractor(pattern string, omitEmptyValues bool, params rubex.MatchParams) (*RubyRegexExtractor, error) {
+	r, err := cachedRubyRegex(pattern, params, rubex.EncodingUTF8)
+	if err != nil {
+		return nil, fmt.Errorf("the pattern supplied to ExtractPatternsRubyRegex is not a valid pattern: %w", err)
+	}
+	return newRubyRegexExtractorFromRegexp(r, omitEmptyValues)
+}
+
+func newRubyRegexExtractor(pattern string, omitEmptyValues bool, retryLimit ottl.Optional[int64], encoding ottl.Optional[string], timeLimit ottl.Optional[string]) (*RubyRegexExtractor, error) {
+	r, err := compileRubyRegex(pattern, retryLimit, encoding, timeLimit)
 	if err != nil {
 		return nil, fmt.Errorf("the pattern supplied to ExtractPatternsRubyRegex is not a valid pattern: %w", err)
 	}
+	return newRubyRegexExtractorFromRegexp(r, omitEmptyValues)
+}
 
+func newRubyRegexExtractorFromRegexp(r *rubex.Regexp, omitEmptyValues bool) (*RubyRegexExtractor, error) {
 	namedCaptureGroups := 0
 	subExpNames := r.SubexpNames()
 	for _, groupName := range subExpNames {
@@ -68,26 +117,44 @@ func extractPatternsRubyRegex[K any](target ottl.StringGetter[K], pattern string
 		return nil, fmt.Errorf("at least 1 named capture group must be supplied in the given regex")
 	}
 
-	return func(ctx context.Context, tCtx K) (any, error) {
-		val, err := target.Get(ctx, tCtx)
-		if err != nil {
-			return nil, err
-		}
-
-		matches := r.FindStringSubmatch(val)
-		if matches == nil {
-			return pcommon.NewMap(), nil
-		}
+	e := &RubyRegexExtractor{r: r, omitEmptyValues: omitEmptyValues, namedCaptureGroups: namedCaptureGroups}
+	e.indexesPool.New = func() any {
+		indexes := make([]int, 2*(r.NumSubexp()+1))
+		return &indexes
+	}
+	return e, nil
+}
 
-		result := pcommon.NewMap()
-		for i, subexp := range r.SubexpNames() {
-			if subexp != "" {
-				if omitEmtpyValues && matches[i+1] == "" {
-					continue
-				}
-				result.PutStr(subexp, matches[i+1])
+// Extract returns the named capture groups of the first match in val, or an
+// empty map if the pattern doesn't match. The captures are converted to UTF-8
+// if the pattern has another encoding.
+func (e *RubyRegexExtractor) Extract(val string) (pcommon.Map, error) {
+	result := pcommon.NewMap()
+	indexes := e.indexesPool.Get().(*[]int)
+	defer e.indexesPool.Put(indexes)
+	match, err := e.r.FindStringSubmatchIndexInto(*indexes, val)
+	if err != nil || match == nil {
+		return result, err
+	}
+	*indexes = match
+
+	result.EnsureCapacity(e.namedCaptureGroups)
+	for i, subexp := range e.r.SubexpNames() {
+		if subexp != "" {
+			start, end := match[2*(i+1)], match[2*(i+1)+1]
+			var capture string
+			if start >= 0 && end >= 0 {
+				capture = val[start:end]
+			}
+			if e.omitEmptyValues && capture == "" {
+				continue
 			}
+			capture, err := decodeRubyRegexString(e.r, capture)
+			if err != nil {
+				return pcommon.NewMap(), err
+			}
+			result.PutStr(subexp, capture)
 		}
-		return result, err
-	}, nil
+	}
+	return result, nil
 }
@@ -23,6 +23,8 @@ import (
 	"go.opentelemetry.io/collector/pdata/pcommon"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+	rubex "github.com/GoogleCloudPlatform/opentelemetry-operations-collector/third_party/go-oniguruma"
 )
 
 func Test_extractPatternsRubyRegex(t *testing.T) {
@@ -116,7 +118,7 @@ func Test_extractPatternsRubyRegex(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			exprFunc, err := extractPatternsRubyRegex(tt.target, tt.pattern, tt.omitEmptyValues)
+			exprFunc, err := extractPatternsRubyRegex(tt.target, tt.pattern, tt.omitEmptyValues, ottl.Optional[int64]{}, ottl.Optional[string]{}, ottl.Optional[string]{})
 			assert.NoError(t, err)
 
 			result, err := exprFunc(context.Background(), nil)
@@ -166,7 +168,7 @@ func Test_extractPatternsRubyRegex_validation(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			exprFunc, err := extractPatternsRubyRegex[any](tt.target, tt.pattern, tt.omitEmptyValues)
+			exprFunc, err := extractPatternsRubyRegex[any](tt.target, tt.pattern, tt.omitEmptyValues, ottl.Optional[int64]{}, ottl.Optional[string]{}, ottl.Optional[string]{})
 			assert.Error(t, err)
 			assert.Nil(t, exprFunc)
 		})
@@ -202,7 +204,7 @@ func Test_extractPatternsRubyRegex_bad_input(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			exprFunc, err := extractPatternsRubyRegex[any](tt.target, tt.pattern, tt.omitEmptyValues)
+			exprFunc, err := extractPatternsRubyRegex[any](tt.target, tt.pattern, tt.omitEmptyValues, ottl.Optional[int64]{}, ottl.Optional[string]{}, ottl.Optional[string]{})
 			assert.NoError(t, err)
 
 			result, err := exprFunc(nil, nil)
@@ -211,3 +213,66 @@ func Test_extractPatternsRubyRegex_bad_input(t *testing.T) {
 		})
 	}
 }
+
+func Test_extractPatternsRubyRegex_encoding(t *testing.T) {
+	for _, encoding := range []string{"Shift_JIS", "EUC-JP", "UTF-16LE"} {
+		t.Run(encoding, func(t *testing.T) {
+			enc, err := rubex.ParseEncoding(encoding)
+			require.NoError(t, err)
+			line, err := enc.Encode("ERROR 接続に失敗しました")
+			require.NoError(t, err)
+			target := &ottl.StandardStringGetter[any]{
+				Getter: func(context.Context, any) (any, error) {
+					return string(line), nil
+				},
+			}
+
+			exprFunc, err := extractPatternsRubyRegex[any](target, `^(?<level>\w+) (?<message>接続.*)$`, false, ottl.Optional[int64]{}, ottl.NewTestingOptional(encoding), ottl.Optional[string]{})
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+
+			expected := pcommon.NewMap()
+			expected.PutStr("level", "ERROR")
+			expected.PutStr("message", "接続に失敗しました")
+			assert.Equal(t, expected.AsRaw(), result.(pcommon.Map).AsRaw())
+		})
+	}
+}
+
+func BenchmarkRubyRegexExtractor(b *testing.B) {
+	const line = `192.168.0.1 - - [28/Feb/2013:12:00:00 +0900] "GET / HTTP/1.1" 200 777`
+	extractor, err := NewRubyRegexExtractor(`^(?<host>[^ ]*) [^ ]* (?<user>[^ ]*) \[(?<time>[^\]]*)\] "(?<method>\S+) (?<path>[^ ]*) \S*" (?<code>[^ ]*) (?<size>[^ ]*)$`, false, rubex.MatchParams{})
+	require.NoError(b, err)
+
+	b.Run("Extract", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			result, err := extractor.Extract(line)
+			if err != nil || result.Len() != 7 {
+				b.Fatal(result.AsRaw(), err)
+			}
+		}
+	})
+
+	// FindStringSubmatchErr is how the captures were extracted before, with
+	// their indexes and the captures themselves allocated for every value.
+	b.Run("FindStringSubmatchErr", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			result := pcommon.NewMap()
+			matches, err := extractor.r.FindStringSubmatchErr(line)
+			if err != nil {
+				b.Fatal(err)
+			}
+			for i, subexp := range extractor.r.SubexpNames() {
+				if subexp != "" {
+					result.PutStr(subexp, matches[i+1])
+				}
+			}
+			if result.Len() != 7 {
+				b.Fatal(result.AsRaw())
+			}
+		}
+	})
+}
@@ -19,14 +19,15 @@ import (
 	"errors"
 	"fmt"
 
-	rubex "github.com/GoogleCloudPlatform/opentelemetry-operations-collector/third_party/go-oniguruma"
-
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 )
 
 type IsMatchRubyRegexArguments[K any] struct {
-	Target  ottl.StringLikeGetter[K]
-	Pattern string
+	Target     ottl.StringLikeGetter[K]
+	Pattern    string
+	RetryLimit ottl.Optional[int64]
+	Encoding   ottl.Optional[string]
+	TimeLimit  ottl.Optional[string]
 }
 
 func NewIsMatchRubyRegexFactory[K any]() ottl.Factory[K] {
@@ -40,11 +41,11 @@ func createIsMatchRubyRegexFunction[K any](_ ottl.FunctionContext, oArgs ottl.Ar
 		return nil, errors.New("IsMatchRubyRegexFactory args must be of type *IsMatchRubyRegexArguments[K]")
 	}
 
-	return isMatchRubyRegex(args.Target, args.Pattern)
+	return isMatchRubyRegex(args.Target, args.Pattern, args.RetryLimit, args.Encoding, args.TimeLimit)
 }
 
-func isMatchRubyRegex[K any](target ottl.StringLikeGetter[K], pattern string) (ottl.ExprFunc[K], error) {
-	compiledPattern, err := rubex.Compile(pattern)
+func isMatchRubyRegex[K any](target ottl.StringLikeGetter[K], pattern string, retryLimit ottl.Optional[int64], encoding ottl.Optional[string], timeLimit ottl.Optional[string]) (ottl.ExprFunc[K], error) {
+	compiledPattern, err := compileRubyRegex(pattern, retryLimit, encoding, timeLimit)
 	if err != nil {
 		return nil, fmt.Errorf("the pattern supplied to IsMatchRubyRegex is not a valid regexp pattern: %w", err)
 	}
@@ -56,6 +57,10 @@ func isMatchRubyRegex[K any](target ottl.StringLikeGetter[K], pattern string) (o
 		if val == nil {
 			return false, nil
 		}
-		return compiledPattern.MatchString(*val), nil
+		matched, err := compiledPattern.MatchStringErr(*val)
+		if err != nil {
+			return nil, fmt.Errorf("IsMatchRubyRegex failed to match pattern %q: %w", pattern, err)
+		}
+		return matched, nil
 	}, nil
 }
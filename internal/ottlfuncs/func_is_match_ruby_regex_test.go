@@ -16,6 +16,7 @@ package ottlfuncs
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -23,6 +24,8 @@ import (
 	"go.opentelemetry.io/collector/pdata/pcommon"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+	rubex "github.com/GoogleCloudPlatform/opentelemetry-operations-collector/third_party/go-oniguruma"
 )
 
 func Test_isMatchRubyRegex(t *testing.T) {
@@ -137,7 +140,7 @@ func Test_isMatchRubyRegex(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			exprFunc, err := isMatchRubyRegex(tt.target, tt.pattern)
+			exprFunc, err := isMatchRubyRegex(tt.target, tt.pattern, ottl.Optional[int64]{}, ottl.Optional[string]{}, ottl.Optional[string]{})
 			assert.NoError(t, err)
 			result, err := exprFunc(t.Context(), nil)
 			assert.NoError(t, err)
@@ -152,7 +155,7 @@ func Test_isMatchRubyRegex_validation(t *testing.T) {
 			return "anything", nil
 		},
 	}
-	_, err := isMatchRubyRegex[any](target, "[z-a]")
+	_, err := isMatchRubyRegex[any](target, "[z-a]", ottl.Optional[int64]{}, ottl.Optional[string]{}, ottl.Optional[string]{})
 	require.Error(t, err)
 }
 
@@ -162,8 +165,65 @@ func Test_isMatchRubyRegex_error(t *testing.T) {
 			return make(chan int), nil
 		},
 	}
-	exprFunc, err := isMatchRubyRegex[any](target, "test")
+	exprFunc, err := isMatchRubyRegex[any](target, "test", ottl.Optional[int64]{}, ottl.Optional[string]{}, ottl.Optional[string]{})
 	assert.NoError(t, err)
 	_, err = exprFunc(t.Context(), nil)
 	require.Error(t, err)
 }
+
+func Test_isMatchRubyRegex_timeLimit(t *testing.T) {
+	target := &ottl.StandardStringLikeGetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return strings.Repeat("a", 40), nil
+		},
+	}
+
+	exprFunc, err := isMatchRubyRegex[any](target, "(a+)+b", ottl.Optional[int64]{}, ottl.Optional[string]{}, ottl.NewTestingOptional("10ms"))
+	require.NoError(t, err)
+	_, err = exprFunc(t.Context(), nil)
+	assert.ErrorIs(t, err, rubex.ErrTimeLimitOver)
+}
+
+func Test_isMatchRubyRegex_retryLimit(t *testing.T) {
+	target := &ottl.StandardStringLikeGetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return strings.Repeat("a", 30), nil
+		},
+	}
+
+	_, err := isMatchRubyRegex[any](target, "(a+)+b", ottl.NewTestingOptional[int64](0), ottl.Optional[string]{}, ottl.Optional[string]{})
+	require.Error(t, err)
+
+	exprFunc, err := isMatchRubyRegex[any](target, "(a+)+b", ottl.NewTestingOptional[int64](1000), ottl.Optional[string]{}, ottl.Optional[string]{})
+	require.NoError(t, err)
+	_, err = exprFunc(t.Context(), nil)
+	assert.ErrorIs(t, err, rubex.ErrRetryLimitInSearchOver)
+
+	exprFunc, err = isMatchRubyRegex[any](target, "a+", ottl.NewTestingOptional[int64](1000), ottl.Optional[string]{}, ottl.Optional[string]{})
+	require.NoError(t, err)
+	result, err := exprFunc(t.Context(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, true, result)
+}
+
+func Test_isMatchRubyRegex_encoding(t *testing.T) {
+	line, err := rubex.EncodingEUCJP.Encode("警告: ディスク容量")
+	require.NoError(t, err)
+	target := &ottl.StandardStringLikeGetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return string(line), nil
+		},
+	}
+
+	exprFunc, err := isMatchRubyRegex[any](target, `^警告:`, ottl.Optional[int64]{}, ottl.NewTestingOptional("EUC-JP"), ottl.Optional[string]{})
+	require.NoError(t, err)
+	result, err := exprFunc(t.Context(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, true, result)
+
+	exprFunc, err = isMatchRubyRegex[any](target, `^警告:`, ottl.Optional[int64]{}, ottl.Optional[string]{}, ottl.Optional[string]{})
+	require.NoError(t, err)
+	result, err = exprFunc(t.Context(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, false, result)
+}
@@ -0,0 +1,249 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// The time types of fluentd parsers.
+const (
+	timeTypeString   = "string"
+	timeTypeFloat    = "float"
+	timeTypeUnixtime = "unixtime"
+	timeTypeMixed    = "mixed"
+)
+
+// iso8601Format is the format fluentd uses for Ruby's Time.iso8601.
+const iso8601Format = "%iso8601"
+
+type ParseFluentdTimeArguments[K any] struct {
+	Time      ottl.Getter[K]
+	Format    string
+	Fallbacks ottl.Optional[[]string]
+	TimeType  ottl.Optional[string]
+	Timezone  ottl.Optional[string]
+	Locale    ottl.Optional[string]
+}
+
+func NewParseFluentdTimeFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("ParseFluentdTime", &ParseFluentdTimeArguments[K]{}, createParseFluentdTimeFunction[K])
+}
+
+func createParseFluentdTimeFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*ParseFluentdTimeArguments[K])
+	if !ok {
+		return nil, errors.New("ParseFluentdTimeFactory args must be of type *ParseFluentdTimeArguments[K]")
+	}
+
+	return parseFluentdTime(args.Time, args.Format, args.Fallbacks, args.TimeType, args.Timezone, args.Locale, time.Now)
+}
+
+// fluentdTimeParser parses a time of one of the formats of ParseFluentdTime.
+type fluentdTimeParser func(value any, now time.Time) (time.Time, error)
+
+// parseFluentdTime parses times the way fluentd parsers configured with
+// time_type, time_format, time_format_fallbacks, timezone and the Ruby
+// strptime formats do. The time type defaults to "string", which parses
+// values with format. With "float" and "unixtime", values are seconds since
+// the epoch, and format must be empty. With "mixed", format and then each of
+// the fallbacks are tried in turn, and they can also be "float" or
+// "unixtime". Times without an offset are in timezone, or the local time zone
+// by default.
+func parseFluentdTime[K any](target ottl.Getter[K], format string, fallbacks ottl.Optional[[]string], timeType, timezone, locale ottl.Optional[string], now func() time.Time) (ottl.ExprFunc[K], error) {
+	loc := time.Local
+	if !timezone.IsEmpty() {
+		var err error
+		if loc, err = loadFluentdTimezone(timezone.Get()); err != nil {
+			return nil, err
+		}
+	}
+
+	var names *strptimeNames
+	if !locale.IsEmpty() {
+		var err error
+		if names, err = newStrptimeNames(locale.Get()); err != nil {
+			return nil, err
+		}
+	}
+
+	tt := timeTypeString
+	if !timeType.IsEmpty() {
+		tt = timeType.Get()
+	}
+	if !fallbacks.IsEmpty() && tt != timeTypeMixed {
+		return nil, fmt.Errorf("fallbacks require the %q time type", timeTypeMixed)
+	}
+
+	var formats []string
+	switch tt {
+	case timeTypeString:
+		if format == "" {
+			return nil, fmt.Errorf("the %q time type requires a format", timeTypeString)
+		}
+		formats = []string{format}
+	case timeTypeFloat, timeTypeUnixtime:
+		if format != "" {
+			return nil, fmt.Errorf("the %q time type doesn't take a format", tt)
+		}
+		formats = []string{tt}
+	case timeTypeMixed:
+		if format != "" {
+			formats = append(formats, format)
+		}
+		formats = append(formats, fallbacks.Get()...)
+		if len(formats) == 0 {
+			return nil, fmt.Errorf("the %q time type requires a format or fallbacks", timeTypeMixed)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported time type %q", tt)
+	}
+
+	parsers := make([]fluentdTimeParser, 0, len(formats))
+	for _, f := range formats {
+		parser, err := newFluentdTimeParser(f, names, loc)
+		if err != nil {
+			return nil, err
+		}
+		parsers = append(parsers, parser)
+	}
+
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		if v, ok := val.(pcommon.Value); ok {
+			val = v.AsRaw()
+		}
+
+		current := now()
+		errs := make([]error, 0, len(parsers))
+		for i, parser := range parsers {
+			t, err := parser(val, current)
+			if err == nil {
+				return t, nil
+			}
+			errs = append(errs, fmt.Errorf("%q: %w", formats[i], err))
+		}
+		return nil, fmt.Errorf("ParseFluentdTime failed to parse %v: %w", val, errors.Join(errs...))
+	}, nil
+}
+
+func newFluentdTimeParser(format string, names *strptimeNames, loc *time.Location) (fluentdTimeParser, error) {
+	switch format {
+	case timeTypeFloat:
+		return parseFloatTime, nil
+	case timeTypeUnixtime:
+		return parseUnixtime, nil
+	case iso8601Format:
+		return func(value any, _ time.Time) (time.Time, error) {
+			s, ok := value.(string)
+			if !ok {
+				return time.Time{}, fmt.Errorf("expected a string but got %T", value)
+			}
+			if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+				return t, nil
+			}
+			return time.ParseInLocation("2006-01-02T15:04:05.999999999", s, loc)
+		}, nil
+	}
+
+	elems, err := compileStrptime(format)
+	if err != nil {
+		return nil, err
+	}
+	return func(value any, now time.Time) (time.Time, error) {
+		s, ok := value.(string)
+		if !ok {
+			return time.Time{}, fmt.Errorf("expected a string but got %T", value)
+		}
+		return strptime(elems, s, names, loc, now)
+	}, nil
+}
+
+// parseFloatTime parses seconds since the epoch with a fraction. Strings are
+// parsed without going through a float64, so that they keep their
+// nanoseconds.
+func parseFloatTime(value any, _ time.Time) (time.Time, error) {
+	switch v := value.(type) {
+	case int64:
+		return time.Unix(v, 0), nil
+	case float64:
+		sec, frac := math.Modf(v)
+		return time.Unix(int64(sec), int64(frac*1e9)), nil
+	case string:
+		sec, frac, _ := strings.Cut(v, ".")
+		s, err := strconv.ParseInt(sec, 10, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if frac == "" {
+			return time.Unix(s, 0), nil
+		}
+		if len(frac) > 9 {
+			frac = frac[:9]
+		}
+		ns, err := strconv.ParseUint(frac+strings.Repeat("0", 9-len(frac)), 10, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if strings.HasPrefix(sec, "-") {
+			return time.Unix(s, -int64(ns)), nil
+		}
+		return time.Unix(s, int64(ns)), nil
+	}
+	return time.Time{}, fmt.Errorf("expected a number but got %T", value)
+}
+
+// parseUnixtime parses whole seconds since the epoch.
+func parseUnixtime(value any, _ time.Time) (time.Time, error) {
+	switch v := value.(type) {
+	case int64:
+		return time.Unix(v, 0), nil
+	case float64:
+		return time.Unix(int64(v), 0), nil
+	case string:
+		s, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return time.Unix(s, 0), nil
+	}
+	return time.Time{}, fmt.Errorf("expected a number but got %T", value)
+}
+
+// loadFluentdTimezone loads a timezone in one of the forms fluentd accepts:
+// "+09:00", "+0900", "+09" or an IANA name such as "Asia/Tokyo".
+func loadFluentdTimezone(timezone string) (*time.Location, error) {
+	if loc, end, err := readUTCOffset(timezone, 0); err == nil && end == len(timezone) {
+		return loc, nil
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q: %w", timezone, err)
+	}
+	return loc, nil
+}
@@ -0,0 +1,223 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func newGetter(val any) ottl.Getter[any] {
+	return &ottl.StandardGetSetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return val, nil
+		},
+	}
+}
+
+func Test_parseFluentdTime(t *testing.T) {
+	now := func() time.Time { return time.Date(2024, time.June, 15, 10, 20, 30, 0, time.UTC) }
+
+	tests := []struct {
+		name      string
+		value     any
+		format    string
+		fallbacks ottl.Optional[[]string]
+		timeType  ottl.Optional[string]
+		timezone  ottl.Optional[string]
+		locale    ottl.Optional[string]
+		expected  time.Time
+	}{
+		{
+			name:     "string",
+			value:    "2023-01-02 03:04:05.123456 +0900",
+			format:   "%Y-%m-%d %H:%M:%S.%N %z",
+			expected: time.Date(2023, time.January, 2, 3, 4, 5, 123456000, time.FixedZone("", 9*3600)),
+		},
+		{
+			name:     "pcommon.Value",
+			value:    pcommon.NewValueStr("2023-01-02 03:04:05"),
+			format:   "%Y-%m-%d %H:%M:%S",
+			timezone: ottl.NewTestingOptional("+09:00"),
+			expected: time.Date(2023, time.January, 2, 3, 4, 5, 0, time.FixedZone("", 9*3600)),
+		},
+		{
+			name:     "timezone name",
+			value:    "Jan  2 03:04:05",
+			format:   "%b %e %H:%M:%S",
+			timezone: ottl.NewTestingOptional("America/New_York"),
+			expected: time.Date(2024, time.January, 2, 8, 4, 5, 0, time.UTC),
+		},
+		{
+			name:     "locale",
+			value:    "2 févr. 2023",
+			format:   "%e %b %Y",
+			timezone: ottl.NewTestingOptional("UTC"),
+			locale:   ottl.NewTestingOptional("fr-FR"),
+			expected: time.Date(2023, time.February, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "iso8601",
+			value:    "2023-01-02T03:04:05.5Z",
+			format:   "%iso8601",
+			expected: time.Date(2023, time.January, 2, 3, 4, 5, 500000000, time.UTC),
+		},
+		{
+			name:     "float string",
+			value:    "1700000000.123456789",
+			timeType: ottl.NewTestingOptional(timeTypeFloat),
+			expected: time.Unix(1700000000, 123456789),
+		},
+		{
+			name:     "float",
+			value:    1700000000.5,
+			timeType: ottl.NewTestingOptional(timeTypeFloat),
+			expected: time.Unix(1700000000, 500000000),
+		},
+		{
+			name:     "unixtime",
+			value:    int64(1700000000),
+			timeType: ottl.NewTestingOptional(timeTypeUnixtime),
+			expected: time.Unix(1700000000, 0),
+		},
+		{
+			name:      "mixed format",
+			value:     "2023-01-02",
+			format:    "%Y-%m-%d",
+			fallbacks: ottl.NewTestingOptional([]string{timeTypeUnixtime}),
+			timeType:  ottl.NewTestingOptional(timeTypeMixed),
+			timezone:  ottl.NewTestingOptional("UTC"),
+			expected:  time.Date(2023, time.January, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "mixed fallback",
+			value:     "1700000000",
+			format:    "%Y-%m-%d",
+			fallbacks: ottl.NewTestingOptional([]string{"%d/%m/%Y", timeTypeUnixtime}),
+			timeType:  ottl.NewTestingOptional(timeTypeMixed),
+			expected:  time.Unix(1700000000, 0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := parseFluentdTime(newGetter(tt.value), tt.format, tt.fallbacks, tt.timeType, tt.timezone, tt.locale, now)
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			require.IsType(t, time.Time{}, result)
+			assert.True(t, tt.expected.Equal(result.(time.Time)), "expected %s, got %s", tt.expected, result)
+		})
+	}
+}
+
+func Test_parseFluentdTime_validation(t *testing.T) {
+	tests := []struct {
+		name      string
+		format    string
+		fallbacks ottl.Optional[[]string]
+		timeType  ottl.Optional[string]
+		timezone  ottl.Optional[string]
+		locale    ottl.Optional[string]
+	}{
+		{
+			name: "missing format",
+		},
+		{
+			name:   "invalid format",
+			format: "%Y-%q",
+		},
+		{
+			name:     "format with float",
+			format:   "%s",
+			timeType: ottl.NewTestingOptional(timeTypeFloat),
+		},
+		{
+			name:      "fallbacks without mixed",
+			format:    "%s",
+			fallbacks: ottl.NewTestingOptional([]string{"%Y"}),
+		},
+		{
+			name:      "invalid fallback",
+			format:    "%s",
+			fallbacks: ottl.NewTestingOptional([]string{"%Q%"}),
+			timeType:  ottl.NewTestingOptional(timeTypeMixed),
+		},
+		{
+			name:     "unsupported time type",
+			format:   "%s",
+			timeType: ottl.NewTestingOptional("rfc3339"),
+		},
+		{
+			name:     "invalid timezone",
+			format:   "%s",
+			timezone: ottl.NewTestingOptional("Mars/Olympus_Mons"),
+		},
+		{
+			name:   "invalid locale",
+			format: "%s",
+			locale: ottl.NewTestingOptional("xx-XX"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := parseFluentdTime(newGetter(nil), tt.format, tt.fallbacks, tt.timeType, tt.timezone, tt.locale, time.Now)
+			assert.Error(t, err)
+			assert.Nil(t, exprFunc)
+		})
+	}
+}
+
+func Test_parseFluentdTime_error(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    any
+		timeType string
+	}{
+		{
+			name:     "no format matches",
+			value:    "2023-01-02",
+			timeType: timeTypeMixed,
+		},
+		{
+			name:     "not a number",
+			value:    "yesterday",
+			timeType: timeTypeFloat,
+		},
+		{
+			name:     "map",
+			value:    map[string]any{},
+			timeType: timeTypeUnixtime,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format := ""
+			if tt.timeType == timeTypeMixed {
+				format = "%d/%m/%Y"
+			}
+			exprFunc, err := parseFluentdTime(newGetter(tt.value), format, ottl.Optional[[]string]{}, ottl.NewTestingOptional(tt.timeType), ottl.Optional[string]{}, ottl.Optional[string]{}, time.Now)
+			require.NoError(t, err)
+			_, err = exprFunc(context.Background(), nil)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+const (
+	modeKey   = "key"
+	modeValue = "value"
+)
+
+type ReplaceAllPatternsRubyRegexArguments[K any] struct {
+	Target            ottl.PMapGetSetter[K]
+	Mode              string
+	Pattern           string
+	Replacement       ottl.StringGetter[K]
+	Function          ottl.Optional[ottl.FunctionGetter[K]]
+	ReplacementFormat ottl.Optional[ottl.StringGetter[K]]
+	RetryLimit        ottl.Optional[int64]
+	Encoding          ottl.Optional[string]
+	TimeLimit         ottl.Optional[string]
+}
+
+func NewReplaceAllPatternsRubyRegexFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("replace_all_patterns_ruby_regex", &ReplaceAllPatternsRubyRegexArguments[K]{}, createReplaceAllPatternsRubyRegexFunction[K])
+}
+
+func createReplaceAllPatternsRubyRegexFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*ReplaceAllPatternsRubyRegexArguments[K])
+
+	if !ok {
+		return nil, fmt.Errorf("ReplaceAllPatternsRubyRegexFactory args must be of type *ReplaceAllPatternsRubyRegexArguments[K]")
+	}
+
+	return replaceAllPatternsRubyRegex(args.Target, args.Mode, args.Pattern, args.Replacement, args.Function, args.ReplacementFormat, args.RetryLimit, args.Encoding, args.TimeLimit)
+}
+
+func replaceAllPatternsRubyRegex[K any](target ottl.PMapGetSetter[K], mode string, pattern string, replacement ottl.StringGetter[K], fn ottl.Optional[ottl.FunctionGetter[K]], replacementFormat ottl.Optional[ottl.StringGetter[K]], retryLimit ottl.Optional[int64], encoding ottl.Optional[string], timeLimit ottl.Optional[string]) (ottl.ExprFunc[K], error) {
+	r, err := compileRubyRegex(pattern, retryLimit, encoding, timeLimit)
+	if err != nil {
+		return nil, fmt.Errorf("the pattern supplied to replace_all_patterns_ruby_regex is not a valid pattern: %w", err)
+	}
+	if mode != modeValue && mode != modeKey {
+		return nil, fmt.Errorf("invalid mode %v, must be either 'key' or 'value'", mode)
+	}
+
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		replacementVal, err := replacement.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		// Every replacement is computed before the target is updated, so that
+		// an error partway through the map leaves it unchanged.
+		switch mode {
+		case modeValue:
+			updated := make(map[string]string)
+			for key, value := range val.All() {
+				if value.Type() != pcommon.ValueTypeStr {
+					continue
+				}
+				updatedStr, err := replaceAllRubyRegex(ctx, tCtx, r, value.Str(), replacementVal, fn, replacementFormat)
+				if err != nil {
+					return nil, fmt.Errorf("replace_all_patterns_ruby_regex failed to replace pattern %q: %w", pattern, err)
+				}
+				updated[key] = updatedStr
+			}
+			for key, value := range val.All() {
+				if updatedStr, ok := updated[key]; ok {
+					value.SetStr(updatedStr)
+				}
+			}
+		case modeKey:
+			keys := make([]string, 0, val.Len())
+			for key := range val.All() {
+				key, err = replaceAllRubyRegex(ctx, tCtx, r, key, replacementVal, fn, replacementFormat)
+				if err != nil {
+					return nil, fmt.Errorf("replace_all_patterns_ruby_regex failed to replace pattern %q: %w", pattern, err)
+				}
+				keys = append(keys, key)
+			}
+			// Keys can't be updated in place, so the values are moved to a new
+			// map which is then moved back to the target.
+			updated := pcommon.NewMap()
+			updated.EnsureCapacity(val.Len())
+			i := 0
+			for _, value := range val.All() {
+				value.MoveTo(updated.PutEmpty(keys[i]))
+				i++
+			}
+			updated.MoveTo(val)
+		}
+		return nil, target.Set(ctx, tCtx, val)
+	}, nil
+}
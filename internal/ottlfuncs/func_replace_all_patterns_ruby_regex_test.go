@@ -0,0 +1,223 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	contribottlfuncs "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+	rubex "github.com/GoogleCloudPlatform/opentelemetry-operations-collector/third_party/go-oniguruma"
+)
+
+func Test_replaceAllPatternsRubyRegex(t *testing.T) {
+	input := pcommon.NewMap()
+	input.PutStr("user_name", "alice")
+	input.PutStr("user_password", "secret")
+	input.PutInt("user_id", 123)
+	input.PutStr("host", "alice-laptop")
+
+	target := &ottl.StandardPMapGetSetter[pcommon.Map]{
+		Getter: func(_ context.Context, tCtx pcommon.Map) (pcommon.Map, error) {
+			return tCtx, nil
+		},
+		Setter: func(_ context.Context, tCtx pcommon.Map, val any) error {
+			val.(pcommon.Map).CopyTo(tCtx)
+			return nil
+		},
+	}
+
+	tests := []struct {
+		name        string
+		mode        string
+		pattern     string
+		replacement string
+		function    ottl.Optional[ottl.FunctionGetter[pcommon.Map]]
+		expected    func(pcommon.Map)
+	}{
+		{
+			name:        "values with lookahead",
+			mode:        modeValue,
+			pattern:     "alice(?=-)",
+			replacement: "bob",
+			expected: func(expected pcommon.Map) {
+				expected.PutStr("user_name", "alice")
+				expected.PutStr("user_password", "secret")
+				expected.PutInt("user_id", 123)
+				expected.PutStr("host", "bob-laptop")
+			},
+		},
+		{
+			name:        "values with function",
+			mode:        modeValue,
+			pattern:     "^(?<first>\\w)",
+			replacement: "\\k<first>",
+			function: ottl.NewTestingOptional[ottl.FunctionGetter[pcommon.Map]](ottl.StandardFunctionGetter[pcommon.Map]{
+				Fact: contribottlfuncs.NewToUpperCaseFactory[pcommon.Map](),
+			}),
+			expected: func(expected pcommon.Map) {
+				expected.PutStr("user_name", "Alice")
+				expected.PutStr("user_password", "Secret")
+				expected.PutInt("user_id", 123)
+				expected.PutStr("host", "Alice-laptop")
+			},
+		},
+		{
+			name:        "keys with back-reference",
+			mode:        modeKey,
+			pattern:     "^user_(\\w+)",
+			replacement: "user.\\1",
+			expected: func(expected pcommon.Map) {
+				expected.PutStr("user.name", "alice")
+				expected.PutStr("user.password", "secret")
+				expected.PutInt("user.id", 123)
+				expected.PutStr("host", "alice-laptop")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scenarioMap := pcommon.NewMap()
+			input.CopyTo(scenarioMap)
+
+			exprFunc, err := replaceAllPatternsRubyRegex(target, tt.mode, tt.pattern, &ottl.StandardStringGetter[pcommon.Map]{
+				Getter: func(context.Context, pcommon.Map) (any, error) {
+					return tt.replacement, nil
+				},
+			}, tt.function, ottl.Optional[ottl.StringGetter[pcommon.Map]]{}, ottl.Optional[int64]{}, ottl.Optional[string]{}, ottl.Optional[string]{})
+			require.NoError(t, err)
+
+			_, err = exprFunc(context.Background(), scenarioMap)
+			require.NoError(t, err)
+
+			expected := pcommon.NewMap()
+			tt.expected(expected)
+			assert.Equal(t, expected.AsRaw(), scenarioMap.AsRaw())
+		})
+	}
+}
+
+func Test_replaceAllPatternsRubyRegex_error(t *testing.T) {
+	input := pcommon.NewMap()
+	input.PutStr("ab", "ab")
+	input.PutStr(strings.Repeat("a", 30), strings.Repeat("a", 30))
+
+	target := &ottl.StandardPMapGetSetter[pcommon.Map]{
+		Getter: func(_ context.Context, tCtx pcommon.Map) (pcommon.Map, error) {
+			return tCtx, nil
+		},
+		Setter: func(_ context.Context, tCtx pcommon.Map, val any) error {
+			val.(pcommon.Map).CopyTo(tCtx)
+			return nil
+		},
+	}
+
+	for _, mode := range []string{modeValue, modeKey} {
+		t.Run(mode, func(t *testing.T) {
+			scenarioMap := pcommon.NewMap()
+			input.CopyTo(scenarioMap)
+
+			exprFunc, err := replaceAllPatternsRubyRegex(target, mode, "(a+)+b", &ottl.StandardStringGetter[pcommon.Map]{
+				Getter: func(context.Context, pcommon.Map) (any, error) {
+					return "c", nil
+				},
+			}, ottl.Optional[ottl.FunctionGetter[pcommon.Map]]{}, ottl.Optional[ottl.StringGetter[pcommon.Map]]{}, ottl.NewTestingOptional[int64](1000), ottl.Optional[string]{}, ottl.Optional[string]{})
+			require.NoError(t, err)
+
+			_, err = exprFunc(context.Background(), scenarioMap)
+			assert.ErrorIs(t, err, rubex.ErrRetryLimitInSearchOver)
+			assert.Equal(t, input.AsRaw(), scenarioMap.AsRaw())
+		})
+	}
+}
+
+func Test_replaceAllPatternsRubyRegex_validation(t *testing.T) {
+	tests := []struct {
+		name       string
+		mode       string
+		pattern    string
+		retryLimit ottl.Optional[int64]
+		encoding   ottl.Optional[string]
+	}{
+		{
+			name:    "invalid pattern",
+			mode:    modeValue,
+			pattern: "(",
+		},
+		{
+			name:    "invalid mode",
+			mode:    "both",
+			pattern: "foo",
+		},
+		{
+			name:       "non-positive retry limit",
+			mode:       modeValue,
+			pattern:    "foo",
+			retryLimit: ottl.NewTestingOptional[int64](0),
+		},
+		{
+			name:     "unsupported encoding",
+			mode:     modeValue,
+			pattern:  "foo",
+			encoding: ottl.NewTestingOptional("EBCDIC"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := replaceAllPatternsRubyRegex[any](&ottl.StandardPMapGetSetter[any]{}, tt.mode, tt.pattern, &ottl.StandardStringGetter[any]{}, ottl.Optional[ottl.FunctionGetter[any]]{}, ottl.Optional[ottl.StringGetter[any]]{}, tt.retryLimit, tt.encoding, ottl.Optional[string]{})
+			assert.Error(t, err)
+			assert.Nil(t, exprFunc)
+		})
+	}
+}
+
+func Test_replaceAllPatternsRubyRegex_encodingEmptyMatches(t *testing.T) {
+	target := &ottl.StandardPMapGetSetter[pcommon.Map]{
+		Getter: func(_ context.Context, tCtx pcommon.Map) (pcommon.Map, error) {
+			return tCtx, nil
+		},
+		Setter: func(_ context.Context, tCtx pcommon.Map, val any) error {
+			val.(pcommon.Map).CopyTo(tCtx)
+			return nil
+		},
+	}
+	replacement := &ottl.StandardStringGetter[pcommon.Map]{
+		Getter: func(context.Context, pcommon.Map) (any, error) {
+			return "-", nil
+		},
+	}
+
+	for _, encoding := range []rubex.Encoding{rubex.EncodingShiftJIS, rubex.EncodingEUCJP, rubex.EncodingUTF16LE, rubex.EncodingUTF16BE} {
+		t.Run(encoding.String(), func(t *testing.T) {
+			encoded, err := encoding.Encode("aあb")
+			require.NoError(t, err)
+			input := pcommon.NewMap()
+			input.PutStr("line", string(encoded))
+
+			exprFunc, err := replaceAllPatternsRubyRegex(target, modeValue, `x*`, replacement, ottl.Optional[ottl.FunctionGetter[pcommon.Map]]{}, ottl.Optional[ottl.StringGetter[pcommon.Map]]{}, ottl.Optional[int64]{}, ottl.NewTestingOptional(encoding.String()), ottl.Optional[string]{})
+			require.NoError(t, err)
+			_, err = exprFunc(context.Background(), input)
+			require.NoError(t, err)
+			assert.Equal(t, map[string]any{"line": "-a-あ-b-"}, input.AsRaw())
+		})
+	}
+}
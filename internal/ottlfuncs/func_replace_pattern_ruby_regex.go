@@ -0,0 +1,192 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+	rubex "github.com/GoogleCloudPlatform/opentelemetry-operations-collector/third_party/go-oniguruma"
+)
+
+var (
+	validReplacementFormatRegex   = regexp.MustCompile(`^(.*?%s.*?)$`)
+	invalidReplacementFormatRegex = regexp.MustCompile(`%[^s]`)
+)
+
+type ReplacePatternRubyRegexArguments[K any] struct {
+	Target            ottl.GetSetter[K]
+	Pattern           string
+	Replacement       ottl.StringGetter[K]
+	Function          ottl.Optional[ottl.FunctionGetter[K]]
+	ReplacementFormat ottl.Optional[ottl.StringGetter[K]]
+	RetryLimit        ottl.Optional[int64]
+	Encoding          ottl.Optional[string]
+	TimeLimit         ottl.Optional[string]
+}
+
+// replaceRubyRegexFuncArgs are the arguments passed to the optional function
+// applied to each replacement.
+type replaceRubyRegexFuncArgs[K any] struct {
+	Input ottl.StringGetter[K]
+}
+
+func NewReplacePatternRubyRegexFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("replace_pattern_ruby_regex", &ReplacePatternRubyRegexArguments[K]{}, createReplacePatternRubyRegexFunction[K])
+}
+
+func createReplacePatternRubyRegexFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*ReplacePatternRubyRegexArguments[K])
+
+	if !ok {
+		return nil, fmt.Errorf("ReplacePatternRubyRegexFactory args must be of type *ReplacePatternRubyRegexArguments[K]")
+	}
+
+	return replacePatternRubyRegex(args.Target, args.Pattern, args.Replacement, args.Function, args.ReplacementFormat, args.RetryLimit, args.Encoding, args.TimeLimit)
+}
+
+func replacePatternRubyRegex[K any](target ottl.GetSetter[K], pattern string, replacement ottl.StringGetter[K], fn ottl.Optional[ottl.FunctionGetter[K]], replacementFormat ottl.Optional[ottl.StringGetter[K]], retryLimit ottl.Optional[int64], encoding ottl.Optional[string], timeLimit ottl.Optional[string]) (ottl.ExprFunc[K], error) {
+	r, err := compileRubyRegex(pattern, retryLimit, encoding, timeLimit)
+	if err != nil {
+		return nil, fmt.Errorf("the pattern supplied to replace_pattern_ruby_regex is not a valid pattern: %w", err)
+	}
+
+	return func(ctx context.Context, tCtx K) (any, error) {
+		originalVal, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		originalValStr, ok := originalVal.(string)
+		if !ok {
+			return nil, nil
+		}
+		matched, err := r.MatchStringErr(originalValStr)
+		if err != nil {
+			return nil, fmt.Errorf("replace_pattern_ruby_regex failed to match pattern %q: %w", pattern, err)
+		}
+		if !matched {
+			return nil, nil
+		}
+		replacementVal, err := replacement.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		updatedStr, err := replaceAllRubyRegex(ctx, tCtx, r, originalValStr, replacementVal, fn, replacementFormat)
+		if err != nil {
+			return nil, fmt.Errorf("replace_pattern_ruby_regex failed to replace pattern %q: %w", pattern, err)
+		}
+		return nil, target.Set(ctx, tCtx, updatedStr)
+	}, nil
+}
+
+// replaceAllRubyRegex replaces every match of r in s with replacement, in
+// which Ruby-style back-references (\k<name> and \1) are substituted with
+// the captures of the match. If fn is set, it is called with each expanded
+// replacement and its result, formatted with replacementFormat if set, is
+// used instead. If r has another encoding than UTF-8, s is in that encoding
+// and the result is converted to UTF-8.
+func replaceAllRubyRegex[K any](ctx context.Context, tCtx K, r *rubex.Regexp, s, replacement string, fn ottl.Optional[ottl.FunctionGetter[K]], replacementFormat ottl.Optional[ottl.StringGetter[K]]) (string, error) {
+	transcoded := isTranscodedRubyRegex(r)
+	if fn.IsEmpty() && !transcoded {
+		return r.GsubErr(s, replacement)
+	}
+
+	var replaceErr error
+	updated, err := r.GsubFuncErr(s, func(_ string, captures map[string]string) string {
+		if replaceErr != nil {
+			return ""
+		}
+		var replaced string
+		replaced, replaceErr = expandRubyRegexReplacement(ctx, tCtx, r, captures, replacement, fn, replacementFormat)
+		return replaced
+	})
+	if err != nil {
+		return "", err
+	}
+	if replaceErr != nil {
+		return "", replaceErr
+	}
+	return decodeRubyRegexString(r, updated)
+}
+
+// expandRubyRegexReplacement returns the replacement of a match with the
+// given captures, in the encoding of r.
+func expandRubyRegexReplacement[K any](ctx context.Context, tCtx K, r *rubex.Regexp, captures map[string]string, replacement string, fn ottl.Optional[ottl.FunctionGetter[K]], replacementFormat ottl.Optional[ottl.StringGetter[K]]) (string, error) {
+	transcoded := isTranscodedRubyRegex(r)
+	if transcoded {
+		for name, capture := range captures {
+			decoded, err := decodeRubyRegexString(r, capture)
+			if err != nil {
+				return "", err
+			}
+			captures[name] = decoded
+		}
+	}
+
+	replaced := rubex.Expand(replacement, captures)
+	if !fn.IsEmpty() {
+		var err error
+		replaced, err = applyReplaceFunction(ctx, tCtx, fn.Get(), replaced, replacementFormat)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if !transcoded {
+		return replaced, nil
+	}
+	encoded, err := r.Encoding().Encode(replaced)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
+
+func applyReplaceFunction[K any](ctx context.Context, tCtx K, fn ottl.FunctionGetter[K], replacement string, replacementFormat ottl.Optional[ottl.StringGetter[K]]) (string, error) {
+	expr, err := fn.Get(&replaceRubyRegexFuncArgs[K]{
+		Input: ottl.StandardStringGetter[K]{
+			Getter: func(context.Context, K) (any, error) {
+				return replacement, nil
+			},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	result, err := expr.Eval(ctx, tCtx)
+	if err != nil {
+		return "", err
+	}
+	resultStr, ok := result.(string)
+	if !ok {
+		return "", errors.New("the replacement value must be a string")
+	}
+
+	if replacementFormat.IsEmpty() {
+		return resultStr, nil
+	}
+	format, err := replacementFormat.Get().Get(ctx, tCtx)
+	if err != nil {
+		return "", err
+	}
+	if !validReplacementFormatRegex.MatchString(format) || invalidReplacementFormatRegex.MatchString(format) {
+		return "", errors.New("replacementFormat must be format string containing a single %s and no other format specifiers")
+	}
+	return fmt.Sprintf(format, resultStr), nil
+}
@@ -0,0 +1,278 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	contribottlfuncs "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+	rubex "github.com/GoogleCloudPlatform/opentelemetry-operations-collector/third_party/go-oniguruma"
+)
+
+func newStringGetter(s string) ottl.StringGetter[pcommon.Value] {
+	return &ottl.StandardStringGetter[pcommon.Value]{
+		Getter: func(context.Context, pcommon.Value) (any, error) {
+			return s, nil
+		},
+	}
+}
+
+func newFunctionGetter(factory ottl.Factory[pcommon.Value]) ottl.Optional[ottl.FunctionGetter[pcommon.Value]] {
+	return ottl.NewTestingOptional[ottl.FunctionGetter[pcommon.Value]](ottl.StandardFunctionGetter[pcommon.Value]{
+		Fact: factory,
+	})
+}
+
+func Test_replacePatternRubyRegex(t *testing.T) {
+	target := &ottl.StandardGetSetter[pcommon.Value]{
+		Getter: func(_ context.Context, tCtx pcommon.Value) (any, error) {
+			return tCtx.AsRaw(), nil
+		},
+		Setter: func(_ context.Context, tCtx pcommon.Value, val any) error {
+			tCtx.SetStr(val.(string))
+			return nil
+		},
+	}
+
+	tests := []struct {
+		name              string
+		input             pcommon.Value
+		pattern           string
+		replacement       string
+		function          ottl.Optional[ottl.FunctionGetter[pcommon.Value]]
+		replacementFormat ottl.Optional[ottl.StringGetter[pcommon.Value]]
+		expected          pcommon.Value
+	}{
+		{
+			name:        "lookbehind",
+			input:       pcommon.NewValueStr("hello world"),
+			pattern:     "(?<=hello )world",
+			replacement: "there",
+			expected:    pcommon.NewValueStr("hello there"),
+		},
+		{
+			name:        "possessive quantifier",
+			input:       pcommon.NewValueStr("aaa b aa"),
+			pattern:     "a++",
+			replacement: "x",
+			expected:    pcommon.NewValueStr("x b x"),
+		},
+		{
+			name:        "numbered back-references",
+			input:       pcommon.NewValueStr("key=value"),
+			pattern:     "(\\w+)=(\\w+)",
+			replacement: "\\2=\\1",
+			expected:    pcommon.NewValueStr("value=key"),
+		},
+		{
+			name:        "named back-references",
+			input:       pcommon.NewValueStr("key=value"),
+			pattern:     "(?<key>\\w+)=(?<value>\\w+)",
+			replacement: "\\k<value>:\\k<key>",
+			expected:    pcommon.NewValueStr("value:key"),
+		},
+		{
+			name:        "no match",
+			input:       pcommon.NewValueStr("hello world"),
+			pattern:     "(?<=goodbye )world",
+			replacement: "there",
+			expected:    pcommon.NewValueStr("hello world"),
+		},
+		{
+			name:        "non-string target",
+			input:       pcommon.NewValueInt(123),
+			pattern:     "\\d+",
+			replacement: "x",
+			expected:    pcommon.NewValueInt(123),
+		},
+		{
+			name:        "function",
+			input:       pcommon.NewValueStr("user=alice password=secret"),
+			pattern:     "(?<=password=)(?<password>\\w+)",
+			replacement: "\\k<password>",
+			function:    newFunctionGetter(contribottlfuncs.NewToUpperCaseFactory[pcommon.Value]()),
+			expected:    pcommon.NewValueStr("user=alice password=SECRET"),
+		},
+		{
+			name:              "function and replacement format",
+			input:             pcommon.NewValueStr("a=x b=y"),
+			pattern:           "(\\w)=(\\w)",
+			replacement:       "\\2",
+			function:          newFunctionGetter(contribottlfuncs.NewToUpperCaseFactory[pcommon.Value]()),
+			replacementFormat: ottl.NewTestingOptional[ottl.StringGetter[pcommon.Value]](newStringGetter("<%s>")),
+			expected:          pcommon.NewValueStr("<X> <Y>"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := replacePatternRubyRegex(target, tt.pattern, newStringGetter(tt.replacement), tt.function, tt.replacementFormat, ottl.Optional[int64]{}, ottl.Optional[string]{}, ottl.Optional[string]{})
+			require.NoError(t, err)
+
+			result, err := exprFunc(context.Background(), tt.input)
+			require.NoError(t, err)
+			assert.Nil(t, result)
+			assert.Equal(t, tt.expected, tt.input)
+		})
+	}
+}
+
+func Test_replacePatternRubyRegex_validation(t *testing.T) {
+	target := &ottl.StandardGetSetter[any]{}
+	replacement := &ottl.StandardStringGetter[any]{}
+	exprFunc, err := replacePatternRubyRegex[any](target, "(", replacement, ottl.Optional[ottl.FunctionGetter[any]]{}, ottl.Optional[ottl.StringGetter[any]]{}, ottl.Optional[int64]{}, ottl.Optional[string]{}, ottl.Optional[string]{})
+	assert.Error(t, err)
+	assert.Nil(t, exprFunc)
+}
+
+func Test_replacePatternRubyRegex_error(t *testing.T) {
+	target := &ottl.StandardGetSetter[pcommon.Value]{
+		Getter: func(_ context.Context, tCtx pcommon.Value) (any, error) {
+			return tCtx.AsRaw(), nil
+		},
+		Setter: func(_ context.Context, tCtx pcommon.Value, val any) error {
+			tCtx.SetStr(val.(string))
+			return nil
+		},
+	}
+
+	tests := []struct {
+		name              string
+		replacement       string
+		function          ottl.Optional[ottl.FunctionGetter[pcommon.Value]]
+		replacementFormat ottl.Optional[ottl.StringGetter[pcommon.Value]]
+	}{
+		{
+			name:        "function returns non-string",
+			replacement: "{}",
+			function:    newFunctionGetter(contribottlfuncs.NewParseJSONFactory[pcommon.Value]()),
+		},
+		{
+			name:              "invalid replacement format",
+			replacement:       "there",
+			function:          newFunctionGetter(contribottlfuncs.NewToUpperCaseFactory[pcommon.Value]()),
+			replacementFormat: ottl.NewTestingOptional[ottl.StringGetter[pcommon.Value]](newStringGetter("%d")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := replacePatternRubyRegex(target, "world", newStringGetter(tt.replacement), tt.function, tt.replacementFormat, ottl.Optional[int64]{}, ottl.Optional[string]{}, ottl.Optional[string]{})
+			require.NoError(t, err)
+
+			input := pcommon.NewValueStr("hello world")
+			_, err = exprFunc(context.Background(), input)
+			assert.Error(t, err)
+			assert.Equal(t, "hello world", input.Str())
+		})
+	}
+}
+
+func Test_replacePatternRubyRegex_retryLimit(t *testing.T) {
+	target := &ottl.StandardGetSetter[pcommon.Value]{
+		Getter: func(_ context.Context, tCtx pcommon.Value) (any, error) {
+			return tCtx.AsRaw(), nil
+		},
+		Setter: func(_ context.Context, tCtx pcommon.Value, val any) error {
+			tCtx.SetStr(val.(string))
+			return nil
+		},
+	}
+	exprFunc, err := replacePatternRubyRegex(target, "(a+)+b", newStringGetter("c"), ottl.Optional[ottl.FunctionGetter[pcommon.Value]]{}, ottl.Optional[ottl.StringGetter[pcommon.Value]]{}, ottl.NewTestingOptional[int64](1000), ottl.Optional[string]{}, ottl.Optional[string]{})
+	require.NoError(t, err)
+
+	input := pcommon.NewValueStr(strings.Repeat("a", 30))
+	_, err = exprFunc(context.Background(), input)
+	assert.ErrorIs(t, err, rubex.ErrRetryLimitInSearchOver)
+	assert.Equal(t, strings.Repeat("a", 30), input.Str())
+}
+
+func Test_replacePatternRubyRegex_encoding(t *testing.T) {
+	target := &ottl.StandardGetSetter[pcommon.Value]{
+		Getter: func(_ context.Context, tCtx pcommon.Value) (any, error) {
+			return tCtx.AsRaw(), nil
+		},
+		Setter: func(_ context.Context, tCtx pcommon.Value, val any) error {
+			tCtx.SetStr(val.(string))
+			return nil
+		},
+	}
+	line, err := rubex.EncodingUTF16LE.Encode("ユーザー=山田 パスワード=秘密")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		function ottl.Optional[ottl.FunctionGetter[pcommon.Value]]
+		expected string
+	}{
+		{
+			name:     "back-references",
+			expected: "ユーザー=山田 パスワード=[パスワード]",
+		},
+		{
+			name:     "function",
+			function: newFunctionGetter(contribottlfuncs.NewSHA256Factory[pcommon.Value]()),
+			expected: "ユーザー=山田 677aa606c00fb53496063ae11a9e3dd0154b949ef047f64840d70a55e2ca2c60",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := replacePatternRubyRegex(target, `(?<key>パスワード)=\S+`, newStringGetter(`\k<key>=[\k<key>]`), tt.function, ottl.Optional[ottl.StringGetter[pcommon.Value]]{}, ottl.Optional[int64]{}, ottl.NewTestingOptional("UTF-16LE"), ottl.Optional[string]{})
+			require.NoError(t, err)
+
+			input := pcommon.NewValueStr(string(line))
+			_, err = exprFunc(context.Background(), input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, input.Str())
+		})
+	}
+}
+
+func Test_replacePatternRubyRegex_encodingEmptyMatches(t *testing.T) {
+	target := &ottl.StandardGetSetter[pcommon.Value]{
+		Getter: func(_ context.Context, tCtx pcommon.Value) (any, error) {
+			return tCtx.AsRaw(), nil
+		},
+		Setter: func(_ context.Context, tCtx pcommon.Value, val any) error {
+			tCtx.SetStr(val.(string))
+			return nil
+		},
+	}
+	const line = "aあ\nbい"
+
+	for _, pattern := range []string{`x*`, `\b`, `^`} {
+		// The empty matches are at the same characters as in UTF-8.
+		expected := rubex.MustCompile(pattern).ReplaceAllString(line, "-")
+		for _, encoding := range []rubex.Encoding{rubex.EncodingShiftJIS, rubex.EncodingEUCJP, rubex.EncodingUTF16LE, rubex.EncodingUTF16BE} {
+			t.Run(pattern+" "+encoding.String(), func(t *testing.T) {
+				encoded, err := encoding.Encode(line)
+				require.NoError(t, err)
+				exprFunc, err := replacePatternRubyRegex(target, pattern, newStringGetter("-"), ottl.Optional[ottl.FunctionGetter[pcommon.Value]]{}, ottl.Optional[ottl.StringGetter[pcommon.Value]]{}, ottl.Optional[int64]{}, ottl.NewTestingOptional(encoding.String()), ottl.Optional[string]{})
+				require.NoError(t, err)
+
+				input := pcommon.NewValueStr(string(encoded))
+				_, err = exprFunc(context.Background(), input)
+				require.NoError(t, err)
+				assert.Equal(t, expected, input.Str())
+			})
+		}
+	}
+}
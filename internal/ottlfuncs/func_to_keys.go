@@ -0,0 +1,64 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type ToKeysArguments[K any] struct {
+	Target ottl.Getter[K]
+	Sort   ottl.Optional[bool]
+	Depth  ottl.Optional[int64]
+}
+
+func NewToKeysFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("ToKeys", &ToKeysArguments[K]{}, createToKeysFunction[K])
+}
+
+func createToKeysFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*ToKeysArguments[K])
+	if !ok {
+		return nil, errors.New("ToKeysFactory args must be of type *ToKeysArguments[K]")
+	}
+
+	return toKeys(args.Target, args.Sort, args.Depth)
+}
+
+func toKeys[K any](target ottl.Getter[K], sorted ottl.Optional[bool], depth ottl.Optional[int64]) (ottl.ExprFunc[K], error) {
+	getEntries, err := newMapEntriesGetter("ToKeys", target, sorted, depth)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context, tCtx K) (any, error) {
+		entries, err := getEntries(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		res := pcommon.NewSlice()
+		res.EnsureCapacity(len(entries))
+		for _, entry := range entries {
+			res.AppendEmpty().SetStr(entry.key)
+		}
+		return res, nil
+	}, nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_toKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  any
+		sorted  ottl.Optional[bool]
+		depth   ottl.Optional[int64]
+		wantRaw []any
+	}{
+		{
+			name:    "slice of maps",
+			target:  newSliceOfMaps(),
+			wantRaw: []any{"param2", "param1", "count"},
+		},
+		{
+			name:    "sorted slice of maps",
+			target:  newSliceOfMaps(),
+			sorted:  ottl.NewTestingOptional(true),
+			wantRaw: []any{"param1", "param2", "count"},
+		},
+		{
+			name:    "map",
+			target:  newNestedMap(),
+			wantRaw: []any{"service", "labels"},
+		},
+		{
+			name:    "flattened map",
+			target:  newNestedMap(),
+			depth:   ottl.NewTestingOptional[int64](3),
+			wantRaw: []any{"service", "labels.zone", "labels.instance.id", "labels.app"},
+		},
+		{
+			name:    "sorted flattened map",
+			target:  newNestedMap(),
+			sorted:  ottl.NewTestingOptional(true),
+			depth:   ottl.NewTestingOptional[int64](2),
+			wantRaw: []any{"labels.app", "labels.instance", "labels.zone", "service"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			exprFunc, err := toKeys(newGetter(tc.target), tc.sorted, tc.depth)
+			require.NoError(t, err)
+			got, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantRaw, got.(pcommon.Slice).AsRaw())
+		})
+	}
+}
+
+func Test_toKeys_error(t *testing.T) {
+	_, err := toKeys(newGetter(nil), ottl.Optional[bool]{}, ottl.NewTestingOptional[int64](-1))
+	assert.Error(t, err)
+
+	exprFunc, err := toKeys(newGetter(int64(1)), ottl.Optional[bool]{}, ottl.Optional[int64]{})
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), nil)
+	assert.ErrorContains(t, err, "ToKeys expects a pcommon.Map or a slice of pcommon.Map, but got int64")
+}
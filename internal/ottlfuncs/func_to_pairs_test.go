@@ -0,0 +1,77 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_toPairs(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  any
+		sorted  ottl.Optional[bool]
+		depth   ottl.Optional[int64]
+		wantRaw []any
+	}{
+		{
+			name:   "slice of maps",
+			target: newSliceOfMaps(),
+			wantRaw: []any{
+				map[string]any{"key": "param2", "value": "stopped"},
+				map[string]any{"key": "param1", "value": "Software Protection"},
+				map[string]any{"key": "count", "value": int64(3)},
+			},
+		},
+		{
+			name:   "sorted flattened map",
+			target: newNestedMap(),
+			sorted: ottl.NewTestingOptional(true),
+			depth:  ottl.NewTestingOptional[int64](3),
+			wantRaw: []any{
+				map[string]any{"key": "labels.app", "value": "frontend"},
+				map[string]any{"key": "labels.instance.id", "value": "1234"},
+				map[string]any{"key": "labels.zone", "value": "us-east1-b"},
+				map[string]any{"key": "service", "value": "api"},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			exprFunc, err := toPairs(newGetter(tc.target), tc.sorted, tc.depth)
+			require.NoError(t, err)
+			got, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantRaw, got.(pcommon.Slice).AsRaw())
+		})
+	}
+}
+
+func Test_toPairs_error(t *testing.T) {
+	_, err := toPairs(newGetter(nil), ottl.Optional[bool]{}, ottl.NewTestingOptional[int64](0))
+	assert.Error(t, err)
+
+	exprFunc, err := toPairs(newGetter([]any{map[string]any{}, "foo"}), ottl.Optional[bool]{}, ottl.Optional[int64]{})
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), nil)
+	assert.ErrorContains(t, err, "ToPairs expects a slice of pcommon.Map, but got Str")
+}
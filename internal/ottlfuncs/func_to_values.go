@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
 
@@ -25,7 +26,9 @@ import (
 )
 
 type ToValuesArguments[K any] struct {
-	Target ottl.PSliceGetter[K]
+	Target ottl.Getter[K]
+	Sort   ottl.Optional[bool]
+	Depth  ottl.Optional[int64]
 }
 
 func NewToValuesFactory[K any]() ottl.Factory[K] {
@@ -38,27 +41,123 @@ func createToValuesFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments)
 		return nil, errors.New("ToValuesFactory args must be of type *ToValuesArguments[K]")
 	}
 
-	return toValues(args.Target)
+	return toValues(args.Target, args.Sort, args.Depth)
 }
 
-func toValues[K any](target ottl.PSliceGetter[K]) (ottl.ExprFunc[K], error) {
+func toValues[K any](target ottl.Getter[K], sorted ottl.Optional[bool], depth ottl.Optional[int64]) (ottl.ExprFunc[K], error) {
+	getEntries, err := newMapEntriesGetter("ToValues", target, sorted, depth)
+	if err != nil {
+		return nil, err
+	}
+
 	return func(ctx context.Context, tCtx K) (any, error) {
+		entries, err := getEntries(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+
 		res := pcommon.NewSlice()
-		target, err := target.Get(ctx, tCtx)
+		res.EnsureCapacity(len(entries))
+		for _, entry := range entries {
+			entry.value.CopyTo(res.AppendEmpty())
+		}
+		return res, nil
+	}, nil
+}
+
+// mapEntry is an entry of the maps given to ToValues, ToKeys and ToPairs.
+type mapEntry struct {
+	key   string
+	value pcommon.Value
+}
+
+type mapEntriesGetter[K any] func(ctx context.Context, tCtx K) ([]mapEntry, error)
+
+// newMapEntriesGetter returns a function getting the entries of target, which
+// is a map or a slice of maps. The maps nested in them are flattened up to
+// depth levels, which is 1 by default, and their entries' keys are prefixed
+// with the keys of their parents and a dot. If sorted is set, the entries of
+// each map are sorted by key; otherwise they keep their order.
+func newMapEntriesGetter[K any](name string, target ottl.Getter[K], sorted ottl.Optional[bool], depth ottl.Optional[int64]) (mapEntriesGetter[K], error) {
+	maxDepth := int64(1)
+	if !depth.IsEmpty() {
+		maxDepth = depth.Get()
+		if maxDepth < 1 {
+			return nil, fmt.Errorf("the depth of %s must be at least 1, but got %d", name, maxDepth)
+		}
+	}
+	sortEntries := !sorted.IsEmpty() && sorted.Get()
+
+	return func(ctx context.Context, tCtx K) ([]mapEntry, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		maps, err := toMaps(name, val)
 		if err != nil {
 			return nil, err
 		}
 
-		for i := 0; i < target.Len(); i++ {
-			m := target.At(i)
-			if m.Type() == pcommon.ValueTypeMap {
-				for _, v := range m.Map().All() {
-					v.CopyTo(res.AppendEmpty())
-				}
-			} else {
-				return nil, fmt.Errorf("ToValues expects a slice of pcommon.Map, but got %s", m.Type())
+		var entries []mapEntry
+		for _, m := range maps {
+			start := len(entries)
+			entries = appendMapEntries(entries, "", m, maxDepth)
+			if sortEntries {
+				added := entries[start:]
+				sort.SliceStable(added, func(i, j int) bool {
+					return added[i].key < added[j].key
+				})
 			}
 		}
-		return res, nil
+		return entries, nil
 	}, nil
 }
+
+// toMaps returns the maps of val, which is a map or a slice of maps.
+func toMaps(name string, val any) ([]pcommon.Map, error) {
+	switch v := val.(type) {
+	case pcommon.Map:
+		return []pcommon.Map{v}, nil
+	case map[string]any:
+		m := pcommon.NewMap()
+		if err := m.FromRaw(v); err != nil {
+			return nil, err
+		}
+		return []pcommon.Map{m}, nil
+	case pcommon.Slice:
+		maps := make([]pcommon.Map, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			m := v.At(i)
+			if m.Type() != pcommon.ValueTypeMap {
+				return nil, fmt.Errorf("%s expects a slice of pcommon.Map, but got %s", name, m.Type())
+			}
+			maps = append(maps, m.Map())
+		}
+		return maps, nil
+	case []any:
+		s := pcommon.NewSlice()
+		if err := s.FromRaw(v); err != nil {
+			return nil, err
+		}
+		return toMaps(name, s)
+	case pcommon.Value:
+		switch v.Type() {
+		case pcommon.ValueTypeMap:
+			return toMaps(name, v.Map())
+		case pcommon.ValueTypeSlice:
+			return toMaps(name, v.Slice())
+		}
+	}
+	return nil, fmt.Errorf("%s expects a pcommon.Map or a slice of pcommon.Map, but got %T", name, val)
+}
+
+func appendMapEntries(entries []mapEntry, prefix string, m pcommon.Map, depth int64) []mapEntry {
+	for k, v := range m.All() {
+		if v.Type() == pcommon.ValueTypeMap && depth > 1 {
+			entries = appendMapEntries(entries, prefix+k+".", v.Map(), depth-1)
+			continue
+		}
+		entries = append(entries, mapEntry{key: prefix + k, value: v})
+	}
+	return entries
+}
@@ -19,21 +19,44 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 )
 
+func newSliceOfMaps() pcommon.Slice {
+	s := pcommon.NewSlice()
+	m1 := s.AppendEmpty().SetEmptyMap()
+	m1.PutStr("param2", "stopped")
+	m1.PutStr("param1", "Software Protection")
+	m2 := s.AppendEmpty().SetEmptyMap()
+	m2.PutInt("count", 3)
+	return s
+}
+
+func newNestedMap() pcommon.Map {
+	m := pcommon.NewMap()
+	m.PutStr("service", "api")
+	labels := m.PutEmptyMap("labels")
+	labels.PutStr("zone", "us-east1-b")
+	labels.PutEmptyMap("instance").PutStr("id", "1234")
+	labels.PutStr("app", "frontend")
+	return m
+}
+
 func Test_toValues(t *testing.T) {
 
 	tests := []struct {
 		name    string
-		target  ottl.PSliceGetter[any]
+		target  ottl.Getter[any]
+		sorted  ottl.Optional[bool]
+		depth   ottl.Optional[int64]
 		wantRaw []any
 	}{
 		{
 			name: "a slice of maps with string values",
-			target: ottl.StandardPSliceGetter[any]{
+			target: &ottl.StandardGetSetter[any]{
 				Getter: func(context.Context, any) (any, error) {
 					s := pcommon.NewSlice()
 
@@ -51,7 +74,7 @@ func Test_toValues(t *testing.T) {
 		},
 		{
 			name: "a slice of maps, with entries in different order, to ensure order is preserved in the result",
-			target: ottl.StandardPSliceGetter[any]{
+			target: &ottl.StandardGetSetter[any]{
 				Getter: func(context.Context, any) (any, error) {
 					s := pcommon.NewSlice()
 
@@ -67,10 +90,49 @@ func Test_toValues(t *testing.T) {
 
 			wantRaw: []any{"stopped", "Software Protection"},
 		},
+		{
+			name:    "a slice of maps with several entries, in the order of the entries",
+			target:  newGetter(newSliceOfMaps()),
+			wantRaw: []any{"stopped", "Software Protection", int64(3)},
+		},
+		{
+			name:    "sorted slice of maps",
+			target:  newGetter(newSliceOfMaps()),
+			sorted:  ottl.NewTestingOptional(true),
+			wantRaw: []any{"Software Protection", "stopped", int64(3)},
+		},
+		{
+			name:    "sorted raw slice of maps",
+			target:  newGetter(newSliceOfMaps().AsRaw()),
+			sorted:  ottl.NewTestingOptional(true),
+			wantRaw: []any{"Software Protection", "stopped", int64(3)},
+		},
+		{
+			name:   "map",
+			target: newGetter(newNestedMap()),
+			wantRaw: []any{
+				"api",
+				map[string]any{"zone": "us-east1-b", "instance": map[string]any{"id": "1234"}, "app": "frontend"},
+			},
+		},
+		{
+			name:    "raw map flattened and sorted",
+			target:  newGetter(newNestedMap().AsRaw()),
+			sorted:  ottl.NewTestingOptional(true),
+			depth:   ottl.NewTestingOptional[int64](2),
+			wantRaw: []any{"frontend", map[string]any{"id": "1234"}, "us-east1-b", "api"},
+		},
+		{
+			name:    "map value flattened and sorted",
+			target:  newGetter(pcommon.NewValueMap()),
+			sorted:  ottl.NewTestingOptional(true),
+			depth:   ottl.NewTestingOptional[int64](10),
+			wantRaw: []any{},
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			exprFunc, err := toValues(tc.target)
+			exprFunc, err := toValues(tc.target, tc.sorted, tc.depth)
 			assert.NoError(t, err)
 			gotSlice, err := exprFunc(nil, nil)
 			assert.NoError(t, err)
@@ -79,3 +141,36 @@ func Test_toValues(t *testing.T) {
 		})
 	}
 }
+
+func Test_toValues_validation(t *testing.T) {
+	_, err := toValues(newGetter(nil), ottl.Optional[bool]{}, ottl.NewTestingOptional[int64](0))
+	assert.ErrorContains(t, err, "the depth of ToValues must be at least 1")
+}
+
+func Test_toValues_error(t *testing.T) {
+	tests := []struct {
+		name   string
+		target any
+	}{
+		{
+			name:   "string",
+			target: "foo",
+		},
+		{
+			name:   "slice of strings",
+			target: []any{"foo"},
+		},
+		{
+			name:   "string value",
+			target: pcommon.NewValueStr("foo"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			exprFunc, err := toValues(newGetter(tc.target), ottl.Optional[bool]{}, ottl.Optional[int64]{})
+			require.NoError(t, err)
+			_, err = exprFunc(context.Background(), nil)
+			assert.Error(t, err)
+		})
+	}
+}
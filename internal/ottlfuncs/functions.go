@@ -16,13 +16,60 @@ package ottlfuncs
 
 import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlresource"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlscope"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 )
 
-func LogFunctions() []ottl.Factory[*ottllog.TransformContext] {
-	return []ottl.Factory[*ottllog.TransformContext]{
-		NewExtractPatternsRubyRegexFactory[*ottllog.TransformContext](),
-		NewIsMatchRubyRegexFactory[*ottllog.TransformContext](),
-		NewToValuesFactory[*ottllog.TransformContext](),
+// functions returns the factories of every function defined in this package
+// for the context K.
+func functions[K any]() []ottl.Factory[K] {
+	return []ottl.Factory[K]{
+		NewExtractPatternsRubyRegexFactory[K](),
+		NewIsMatchRubyRegexFactory[K](),
+		NewReplaceAllPatternsRubyRegexFactory[K](),
+		NewReplacePatternRubyRegexFactory[K](),
+		NewToKeysFactory[K](),
+		NewToPairsFactory[K](),
+		NewToValuesFactory[K](),
 	}
 }
+
+// LogFunctions also includes ParseFluentdTime, which parses the times of
+// logs the way fluentd does.
+func LogFunctions() []ottl.Factory[*ottllog.TransformContext] {
+	return append(functions[*ottllog.TransformContext](), NewParseFluentdTimeFactory[*ottllog.TransformContext]())
+}
+
+// MetricFunctions returns the functions defined in this package for the
+// metric context.
+func MetricFunctions() []ottl.Factory[*ottlmetric.TransformContext] {
+	return functions[*ottlmetric.TransformContext]()
+}
+
+// DataPointFunctions returns the functions defined in this package for the
+// datapoint context.
+func DataPointFunctions() []ottl.Factory[*ottldatapoint.TransformContext] {
+	return functions[*ottldatapoint.TransformContext]()
+}
+
+// SpanFunctions returns the functions defined in this package for the span
+// context.
+func SpanFunctions() []ottl.Factory[*ottlspan.TransformContext] {
+	return functions[*ottlspan.TransformContext]()
+}
+
+// ResourceFunctions returns the functions defined in this package for the
+// resource context.
+func ResourceFunctions() []ottl.Factory[*ottlresource.TransformContext] {
+	return functions[*ottlresource.TransformContext]()
+}
+
+// ScopeFunctions returns the functions defined in this package for the scope
+// context.
+func ScopeFunctions() []ottl.Factory[*ottlscope.TransformContext] {
+	return functions[*ottlscope.TransformContext]()
+}
@@ -0,0 +1,182 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlresource"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlscope"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
+	contribottlfuncs "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+)
+
+// statements uses every function of this package available in every context.
+// They only access resource attributes so that they can be parsed in every
+// context.
+var statements = []string{
+	`set(resource.attributes["extracted"], ExtractPatternsRubyRegex(resource.attributes["line"], "^(?<greeting>\\w+)", encoding = "UTF-8"))`,
+	`set(resource.attributes["matched"], IsMatchRubyRegex(resource.attributes["line"], "(?<=hello )world", retry_limit = 100000, time_limit = "100ms"))`,
+	`set(resource.attributes["values"], ToValues(resource.attributes["records"]))`,
+	`set(resource.attributes["keys"], ToKeys(resource.attributes["records"], sort = true))`,
+	`set(resource.attributes["pairs"], ToPairs(resource.attributes["records"][0]))`,
+	`replace_pattern_ruby_regex(resource.attributes["line"], "(?<=hello )(?<name>\\w+)", "\\k<name>!")`,
+	`replace_all_patterns_ruby_regex(resource.attributes, "key", "^(rec)(?=ords)", "\\1_")`,
+}
+
+// logStatements use the functions which are only available for logs.
+var logStatements = []string{
+	`set(time, ParseFluentdTime(attributes["time"], "%d/%b/%Y:%H:%M:%S %z"))`,
+}
+
+type newParserFunc[K any] func(map[string]ottl.Factory[K], component.TelemetrySettings, ...ottl.Option[K]) (ottl.Parser[K], error)
+
+// executeStatements parses statements with the given functions and the set
+// editor, and executes them against tCtx.
+func executeStatements[K any](t *testing.T, newParser newParserFunc[K], functions []ottl.Factory[K], statements []string, tCtx K) {
+	t.Helper()
+
+	functionsMap := map[string]ottl.Factory[K]{}
+	for _, f := range append(functions, contribottlfuncs.NewSetFactory[K]()) {
+		functionsMap[f.Name()] = f
+	}
+
+	parser, err := newParser(functionsMap, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	parsed, err := parser.ParseStatements(statements)
+	require.NoError(t, err)
+
+	for _, statement := range parsed {
+		_, _, err := statement.Execute(context.Background(), tCtx)
+		require.NoError(t, err)
+	}
+}
+
+func newResource() pcommon.Resource {
+	resource := pcommon.NewResource()
+	resource.Attributes().PutStr("line", "hello world")
+	records := resource.Attributes().PutEmptySlice("records")
+	records.AppendEmpty().SetEmptyMap().PutStr("first", "foo")
+	records.AppendEmpty().SetEmptyMap().PutStr("second", "bar")
+	return resource
+}
+
+func assertResource(t *testing.T, resource pcommon.Resource) {
+	t.Helper()
+
+	expected := pcommon.NewMap()
+	expected.PutStr("line", "hello world!")
+	records := expected.PutEmptySlice("rec_ords")
+	records.AppendEmpty().SetEmptyMap().PutStr("first", "foo")
+	records.AppendEmpty().SetEmptyMap().PutStr("second", "bar")
+	expected.PutEmptyMap("extracted").PutStr("greeting", "hello")
+	expected.PutBool("matched", true)
+	values := expected.PutEmptySlice("values")
+	values.AppendEmpty().SetStr("foo")
+	values.AppendEmpty().SetStr("bar")
+	keys := expected.PutEmptySlice("keys")
+	keys.AppendEmpty().SetStr("first")
+	keys.AppendEmpty().SetStr("second")
+	pair := expected.PutEmptySlice("pairs").AppendEmpty().SetEmptyMap()
+	pair.PutStr("key", "first")
+	pair.PutStr("value", "foo")
+
+	assert.Equal(t, expected.AsRaw(), resource.Attributes().AsRaw())
+}
+
+func TestLogFunctions(t *testing.T) {
+	rl := plog.NewResourceLogs()
+	newResource().CopyTo(rl.Resource())
+	sl := rl.ScopeLogs().AppendEmpty()
+	lr := sl.LogRecords().AppendEmpty()
+	lr.Attributes().PutStr("time", "10/Oct/2000:13:55:36 -0700")
+	tCtx := ottllog.NewTransformContextPtr(rl, sl, lr)
+	defer tCtx.Close()
+
+	executeStatements(t, ottllog.NewParser, LogFunctions(), append(statements, logStatements...), tCtx)
+	assertResource(t, rl.Resource())
+	assert.Equal(t, time.Date(2000, time.October, 10, 20, 55, 36, 0, time.UTC), lr.Timestamp().AsTime())
+}
+
+func TestMetricFunctions(t *testing.T) {
+	rm := pmetric.NewResourceMetrics()
+	newResource().CopyTo(rm.Resource())
+	sm := rm.ScopeMetrics().AppendEmpty()
+	tCtx := ottlmetric.NewTransformContextPtr(rm, sm, sm.Metrics().AppendEmpty())
+	defer tCtx.Close()
+
+	executeStatements(t, ottlmetric.NewParser, MetricFunctions(), statements, tCtx)
+	assertResource(t, rm.Resource())
+}
+
+func TestDataPointFunctions(t *testing.T) {
+	rm := pmetric.NewResourceMetrics()
+	newResource().CopyTo(rm.Resource())
+	sm := rm.ScopeMetrics().AppendEmpty()
+	metric := sm.Metrics().AppendEmpty()
+	dp := metric.SetEmptyGauge().DataPoints().AppendEmpty()
+	tCtx := ottldatapoint.NewTransformContextPtr(rm, sm, metric, dp)
+	defer tCtx.Close()
+
+	executeStatements(t, ottldatapoint.NewParser, DataPointFunctions(), statements, tCtx)
+	assertResource(t, rm.Resource())
+}
+
+func TestSpanFunctions(t *testing.T) {
+	rs := ptrace.NewResourceSpans()
+	newResource().CopyTo(rs.Resource())
+	ss := rs.ScopeSpans().AppendEmpty()
+	tCtx := ottlspan.NewTransformContextPtr(rs, ss, ss.Spans().AppendEmpty())
+	defer tCtx.Close()
+
+	executeStatements(t, ottlspan.NewParser, SpanFunctions(), statements, tCtx)
+	assertResource(t, rs.Resource())
+}
+
+func TestResourceFunctions(t *testing.T) {
+	rm := pmetric.NewResourceMetrics()
+	newResource().CopyTo(rm.Resource())
+	tCtx := ottlresource.NewTransformContextPtr(rm.Resource(), rm)
+	defer tCtx.Close()
+
+	executeStatements(t, ottlresource.NewParser, ResourceFunctions(), statements, tCtx)
+	assertResource(t, rm.Resource())
+}
+
+func TestScopeFunctions(t *testing.T) {
+	rm := pmetric.NewResourceMetrics()
+	newResource().CopyTo(rm.Resource())
+	sm := rm.ScopeMetrics().AppendEmpty()
+	tCtx := ottlscope.NewTransformContextPtr(sm.Scope(), rm.Resource(), sm, rm)
+	defer tCtx.Close()
+
+	executeStatements(t, ottlscope.NewParser, ScopeFunctions(), statements, tCtx)
+	assertResource(t, rm.Resource())
+}
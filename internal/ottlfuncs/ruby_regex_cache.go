@@ -0,0 +1,131 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+	"weak"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+	rubex "github.com/GoogleCloudPlatform/opentelemetry-operations-collector/third_party/go-oniguruma"
+)
+
+var (
+	// rubyRegexCache holds the compiled patterns so that statements using the
+	// same pattern share the same Regexp, and don't all go through the global
+	// lock of rubex when they are created. The patterns are held weakly, and
+	// removed once no statement uses them anymore, e.g. after a reload of the
+	// configuration.
+	rubyRegexCache      = map[rubyRegexKey]weak.Pointer[rubex.Regexp]{}
+	rubyRegexCacheMutex sync.Mutex
+)
+
+type rubyRegexKey struct {
+	pattern  string
+	params   rubex.MatchParams
+	encoding rubex.Encoding
+}
+
+// compileRubyRegex returns the compiled pattern. If retryLimit is set, a
+// search fails once it has backtracked more than retryLimit times, instead of
+// running for an unbounded time on patterns with catastrophic backtracking.
+// If timeLimit is set, a duration such as "100ms", a search fails once it has
+// run for longer, which also bounds the patterns that are slow without
+// backtracking much. If encoding is set, the subjects are matched as strings
+// of that encoding, e.g. "Shift_JIS", instead of UTF-8.
+func compileRubyRegex(pattern string, retryLimit ottl.Optional[int64], encoding ottl.Optional[string], timeLimit ottl.Optional[string]) (*rubex.Regexp, error) {
+	var params rubex.MatchParams
+	if !retryLimit.IsEmpty() {
+		if retryLimit.Get() <= 0 {
+			return nil, errors.New("the retry limit must be positive")
+		}
+		params.RetryLimitInSearch = uint64(retryLimit.Get())
+	}
+	if !timeLimit.IsEmpty() {
+		d, err := time.ParseDuration(timeLimit.Get())
+		if err != nil {
+			return nil, fmt.Errorf("invalid time limit: %w", err)
+		}
+		if d <= 0 {
+			return nil, errors.New("the time limit must be positive")
+		}
+		params.TimeLimit = d
+	}
+	enc := rubex.EncodingUTF8
+	if !encoding.IsEmpty() {
+		var err error
+		if enc, err = rubex.ParseEncoding(encoding.Get()); err != nil {
+			return nil, err
+		}
+	}
+	return cachedRubyRegex(pattern, params, enc)
+}
+
+// cachedRubyRegex returns the compiled pattern with the given match params
+// and encoding, from the cache if it was already compiled.
+func cachedRubyRegex(pattern string, params rubex.MatchParams, enc rubex.Encoding) (*rubex.Regexp, error) {
+	key := rubyRegexKey{pattern: pattern, params: params, encoding: enc}
+
+	rubyRegexCacheMutex.Lock()
+	defer rubyRegexCacheMutex.Unlock()
+	if r := rubyRegexCache[key].Value(); r != nil {
+		return r, nil
+	}
+	r, err := rubex.NewRegexpEncoding(pattern, rubex.ONIG_OPTION_DEFAULT, enc)
+	if err != nil {
+		// The failed Regexp has no finalizer but holds the error buffers.
+		r.Free()
+		return nil, err
+	}
+	r.SetMatchParams(params)
+	wp := weak.Make(r)
+	rubyRegexCache[key] = wp
+	runtime.AddCleanup(r, removeRubyRegex, rubyRegexCacheEntry{key: key, wp: wp})
+	return r, nil
+}
+
+type rubyRegexCacheEntry struct {
+	key rubyRegexKey
+	wp  weak.Pointer[rubex.Regexp]
+}
+
+// removeRubyRegex removes a collected pattern from the cache, unless it was
+// compiled again since.
+func removeRubyRegex(entry rubyRegexCacheEntry) {
+	rubyRegexCacheMutex.Lock()
+	defer rubyRegexCacheMutex.Unlock()
+	if rubyRegexCache[entry.key] == entry.wp {
+		delete(rubyRegexCache, entry.key)
+	}
+}
+
+// isTranscodedRubyRegex returns whether the subjects of r are in another
+// encoding than UTF-8, and its results must be converted to UTF-8.
+func isTranscodedRubyRegex(r *rubex.Regexp) bool {
+	return r.Encoding() != rubex.EncodingUTF8 && r.Encoding() != rubex.EncodingASCII
+}
+
+// decodeRubyRegexString converts s, which is in the encoding of r, to UTF-8.
+func decodeRubyRegexString(r *rubex.Regexp, s string) (string, error) {
+	if !isTranscodedRubyRegex(r) {
+		return s, nil
+	}
+	return r.Encoding().Decode([]byte(s))
+}
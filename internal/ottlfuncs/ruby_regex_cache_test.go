@@ -0,0 +1,85 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+	rubex "github.com/GoogleCloudPlatform/opentelemetry-operations-collector/third_party/go-oniguruma"
+)
+
+func TestCompileRubyRegex(t *testing.T) {
+	r1, err := compileRubyRegex(`(?<word>\w+)`, ottl.Optional[int64]{}, ottl.Optional[string]{}, ottl.Optional[string]{})
+	require.NoError(t, err)
+	r2, err := compileRubyRegex(`(?<word>\w+)`, ottl.Optional[int64]{}, ottl.Optional[string]{}, ottl.Optional[string]{})
+	require.NoError(t, err)
+	assert.Same(t, r1, r2)
+
+	r3, err := compileRubyRegex(`(?<word>\w+)`, ottl.NewTestingOptional[int64](1000), ottl.Optional[string]{}, ottl.Optional[string]{})
+	require.NoError(t, err)
+	assert.NotSame(t, r1, r3)
+	assert.Equal(t, uint64(1000), r3.MatchParams().RetryLimitInSearch)
+	assert.Zero(t, r1.MatchParams().RetryLimitInSearch)
+
+	_, err = compileRubyRegex(`(`, ottl.Optional[int64]{}, ottl.Optional[string]{}, ottl.Optional[string]{})
+	assert.Error(t, err)
+	_, err = compileRubyRegex(`(?<word>\w+)`, ottl.NewTestingOptional[int64](-1), ottl.Optional[string]{}, ottl.Optional[string]{})
+	assert.Error(t, err)
+
+	r4, err := compileRubyRegex(`(?<word>\w+)`, ottl.Optional[int64]{}, ottl.NewTestingOptional("Shift_JIS"), ottl.Optional[string]{})
+	require.NoError(t, err)
+	assert.NotSame(t, r1, r4)
+	assert.Equal(t, rubex.EncodingShiftJIS, r4.Encoding())
+	assert.Equal(t, rubex.EncodingUTF8, r1.Encoding())
+
+	_, err = compileRubyRegex(`(?<word>\w+)`, ottl.Optional[int64]{}, ottl.NewTestingOptional("EBCDIC"), ottl.Optional[string]{})
+	assert.Error(t, err)
+
+	r5, err := compileRubyRegex(`(?<word>\w+)`, ottl.Optional[int64]{}, ottl.Optional[string]{}, ottl.NewTestingOptional("100ms"))
+	require.NoError(t, err)
+	assert.NotSame(t, r1, r5)
+	assert.Equal(t, 100*time.Millisecond, r5.MatchParams().TimeLimit)
+
+	_, err = compileRubyRegex(`(?<word>\w+)`, ottl.Optional[int64]{}, ottl.Optional[string]{}, ottl.NewTestingOptional("100"))
+	assert.Error(t, err)
+	_, err = compileRubyRegex(`(?<word>\w+)`, ottl.Optional[int64]{}, ottl.Optional[string]{}, ottl.NewTestingOptional("0s"))
+	assert.Error(t, err)
+}
+
+func TestCompileRubyRegexReleasesUnusedPatterns(t *testing.T) {
+	r, err := compileRubyRegex(`(?<unused>\w+)`, ottl.Optional[int64]{}, ottl.Optional[string]{}, ottl.Optional[string]{})
+	require.NoError(t, err)
+	require.True(t, r.MatchString("word"))
+	r = nil
+
+	assert.Eventually(t, func() bool {
+		runtime.GC()
+		rubyRegexCacheMutex.Lock()
+		defer rubyRegexCacheMutex.Unlock()
+		for key := range rubyRegexCache {
+			if key.pattern == `(?<unused>\w+)` {
+				return false
+			}
+		}
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+}
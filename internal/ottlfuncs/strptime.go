@@ -0,0 +1,559 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/elastic/lunes"
+)
+
+// strptimeComposites are the directives which are shorthands for other
+// formats.
+var strptimeComposites = map[byte]string{
+	'c': "%a %b %e %H:%M:%S %Y",
+	'D': "%m/%d/%y",
+	'F': "%Y-%m-%d",
+	'R': "%H:%M",
+	'r': "%I:%M:%S %p",
+	'T': "%H:%M:%S",
+	'v': "%e-%b-%Y",
+	'x': "%m/%d/%y",
+	'X': "%H:%M:%S",
+	'+': "%a %b %e %H:%M:%S %Z %Y",
+}
+
+// strptimeNumeric are the directives reading digits. A directive followed by
+// one of them only reads its default width, like Ruby does.
+const strptimeNumeric = "CdeGgHIjklLmMNQsSuUVwWyY"
+
+// strptimeDirectives are the other supported directives.
+const strptimeDirectives = "aAbBhpPzZ"
+
+// strptimeElem is an element of a compiled strptime format: a literal, a
+// run of whitespace or a directive.
+type strptimeElem struct {
+	literal   string
+	space     bool
+	directive byte
+	width     int
+	colons    int
+}
+
+func (e strptimeElem) numeric() bool {
+	if e.directive != 0 {
+		return strings.IndexByte(strptimeNumeric, e.directive) >= 0
+	}
+	return e.literal != "" && e.literal[0] >= '0' && e.literal[0] <= '9'
+}
+
+// compileStrptime splits a Ruby strptime format into its elements and
+// expands the composite directives.
+func compileStrptime(format string) ([]strptimeElem, error) {
+	var elems []strptimeElem
+	for i := 0; i < len(format); {
+		c := format[i]
+		switch {
+		case isSpace(c):
+			for i < len(format) && isSpace(format[i]) {
+				i++
+			}
+			elems = append(elems, strptimeElem{space: true})
+			continue
+		case c != '%':
+			start := i
+			for i < len(format) && format[i] != '%' && !isSpace(format[i]) {
+				i++
+			}
+			elems = append(elems, strptimeElem{literal: format[start:i]})
+			continue
+		}
+
+		// Flags are only meaningful when formatting and are ignored.
+		i++
+		for i < len(format) && strings.IndexByte("-_0^#", format[i]) >= 0 {
+			i++
+		}
+		elem := strptimeElem{}
+		for i < len(format) && format[i] >= '0' && format[i] <= '9' {
+			elem.width = elem.width*10 + int(format[i]-'0')
+			i++
+		}
+		for i < len(format) && format[i] == ':' {
+			elem.colons++
+			i++
+		}
+		if i == len(format) {
+			return nil, fmt.Errorf("incomplete directive at the end of %q", format)
+		}
+		elem.directive = format[i]
+		i++
+
+		switch {
+		case elem.directive == '%':
+			elems = append(elems, strptimeElem{literal: "%"})
+		case elem.directive == 'n' || elem.directive == 't':
+			elems = append(elems, strptimeElem{space: true})
+		case elem.colons > 0 && elem.directive != 'z':
+			return nil, fmt.Errorf("colons are only supported by %%z in %q", format)
+		case strptimeComposites[elem.directive] != "":
+			expanded, err := compileStrptime(strptimeComposites[elem.directive])
+			if err != nil {
+				return nil, err
+			}
+			elems = append(elems, expanded...)
+		case strings.IndexByte(strptimeNumeric+strptimeDirectives, elem.directive) >= 0:
+			elems = append(elems, elem)
+		default:
+			return nil, fmt.Errorf("unsupported directive %%%c in %q", elem.directive, format)
+		}
+	}
+	return elems, nil
+}
+
+// strptimeNames are the names matched by the %a, %b and %p directives. The
+// locale names are tried before the English ones.
+type strptimeNames struct {
+	days    [][]string
+	months  [][]string
+	periods [][]string
+}
+
+var englishStrptimeNames = strptimeNames{
+	days: [][]string{
+		{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+	},
+	months: [][]string{
+		{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+		{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+	},
+	periods: [][]string{
+		{"AM", "PM"},
+		{"A.M.", "P.M."},
+	},
+}
+
+// newStrptimeNames returns the names of the CLDR locale, such as "fr-FR".
+func newStrptimeNames(locale string) (*strptimeNames, error) {
+	l, err := lunes.NewDefaultLocale(locale)
+	if err != nil {
+		return nil, err
+	}
+	return &strptimeNames{
+		days:    append([][]string{l.LongDayNames(), l.ShortDayNames()}, englishStrptimeNames.days...),
+		months:  append([][]string{l.LongMonthNames(), l.ShortMonthNames()}, englishStrptimeNames.months...),
+		periods: append([][]string{l.DayPeriods()}, englishStrptimeNames.periods...),
+	}, nil
+}
+
+// strptimeFields are the values read by strptime, in the order in which the
+// missing ones are filled from the current time.
+const (
+	fieldYear = iota
+	fieldMonth
+	fieldDay
+	fieldHour
+	fieldMinute
+	fieldSecond
+	fieldNanosecond
+	fieldYearOfCentury
+	fieldCentury
+	fieldYearDay
+	fieldPM
+	numStrptimeFields
+)
+
+type strptimeFields struct {
+	values [numStrptimeFields]int
+	set    [numStrptimeFields]bool
+	loc    *time.Location
+	epoch  *time.Time
+}
+
+func (f *strptimeFields) put(field, value int) {
+	f.values[field] = value
+	f.set[field] = true
+}
+
+// strptime parses value with a compiled Ruby strptime format. Like Ruby's
+// Time.strptime, the fields missing from the format are taken from now up to
+// the most significant one present, and default to their minimum below it.
+// Unlike Ruby, the whole value must be consumed.
+func strptime(elems []strptimeElem, value string, names *strptimeNames, loc *time.Location, now time.Time) (time.Time, error) {
+	if names == nil {
+		names = &englishStrptimeNames
+	}
+	var fields strptimeFields
+	pos := 0
+	for i, elem := range elems {
+		var err error
+		nextNumeric := i+1 < len(elems) && elems[i+1].numeric()
+		pos, err = fields.parse(elem, value, pos, names, nextNumeric)
+		if err != nil {
+			return time.Time{}, err
+		}
+	}
+	if pos < len(value) {
+		return time.Time{}, fmt.Errorf("unexpected %q after the end of the format", value[pos:])
+	}
+	return fields.time(loc, now)
+}
+
+func (f *strptimeFields) parse(elem strptimeElem, value string, pos int, names *strptimeNames, nextNumeric bool) (int, error) {
+	switch {
+	case elem.space:
+		for pos < len(value) && isSpace(value[pos]) {
+			pos++
+		}
+		return pos, nil
+	case elem.literal != "":
+		if !strings.HasPrefix(value[pos:], elem.literal) {
+			return pos, fmt.Errorf("expected %q at position %d", elem.literal, pos)
+		}
+		return pos + len(elem.literal), nil
+	}
+
+	width := func(def, next int) int {
+		switch {
+		case elem.width > 0:
+			return elem.width
+		case nextNumeric:
+			return next
+		}
+		return def
+	}
+
+	var n, end int
+	var err error
+	switch elem.directive {
+	case 'Y', 'G':
+		n, end, err = readStrptimeNumber(value, pos, width(0, 4), true)
+		if elem.directive == 'Y' {
+			f.put(fieldYear, n)
+		}
+	case 'C':
+		n, end, err = readStrptimeNumber(value, pos, width(0, 2), true)
+		f.put(fieldCentury, n)
+	case 'y', 'g':
+		n, end, err = readStrptimeNumber(value, pos, width(2, 2), false)
+		if err == nil && n > 99 {
+			err = fmt.Errorf("invalid year %d", n)
+		}
+		if elem.directive == 'y' {
+			f.put(fieldYearOfCentury, n)
+		}
+	case 'm':
+		n, end, err = readStrptimeNumber(value, pos, width(2, 2), false)
+		if err == nil && (n < 1 || n > 12) {
+			err = fmt.Errorf("invalid month %d", n)
+		}
+		f.put(fieldMonth, n)
+	case 'd', 'e':
+		w := spacePadded(value, &pos, width(2, 2))
+		n, end, err = readStrptimeNumber(value, pos, w, false)
+		if err == nil && (n < 1 || n > 31) {
+			err = fmt.Errorf("invalid day %d", n)
+		}
+		f.put(fieldDay, n)
+	case 'j':
+		n, end, err = readStrptimeNumber(value, pos, width(3, 3), false)
+		if err == nil && (n < 1 || n > 366) {
+			err = fmt.Errorf("invalid day of the year %d", n)
+		}
+		f.put(fieldYearDay, n)
+	case 'H', 'k':
+		w := spacePadded(value, &pos, width(2, 2))
+		n, end, err = readStrptimeNumber(value, pos, w, false)
+		if err == nil && n > 24 {
+			err = fmt.Errorf("invalid hour %d", n)
+		}
+		f.put(fieldHour, n)
+	case 'I', 'l':
+		w := spacePadded(value, &pos, width(2, 2))
+		n, end, err = readStrptimeNumber(value, pos, w, false)
+		if err == nil && (n < 1 || n > 12) {
+			err = fmt.Errorf("invalid hour %d", n)
+		}
+		f.put(fieldHour, n)
+	case 'M':
+		n, end, err = readStrptimeNumber(value, pos, width(2, 2), false)
+		if err == nil && n > 59 {
+			err = fmt.Errorf("invalid minute %d", n)
+		}
+		f.put(fieldMinute, n)
+	case 'S':
+		n, end, err = readStrptimeNumber(value, pos, width(2, 2), false)
+		if err == nil && n > 60 {
+			err = fmt.Errorf("invalid second %d", n)
+		}
+		f.put(fieldSecond, n)
+	case 'L', 'N':
+		// Both read a fraction of second, whatever the number of digits.
+		def := 9
+		if elem.directive == 'L' {
+			def = 3
+		}
+		n, end, err = readStrptimeFraction(value, pos, width(0, def))
+		f.put(fieldNanosecond, n)
+	case 'u', 'w':
+		// The day of the week is redundant with the date.
+		_, end, err = readStrptimeNumber(value, pos, width(1, 1), false)
+	case 'U', 'W', 'V':
+		// Week based dates aren't supported, the week number is ignored.
+		_, end, err = readStrptimeNumber(value, pos, width(2, 2), false)
+	case 's', 'Q':
+		n, end, err = readStrptimeNumber(value, pos, width(0, 0), true)
+		var t time.Time
+		if elem.directive == 's' {
+			t = time.Unix(int64(n), 0)
+		} else {
+			t = time.UnixMilli(int64(n))
+		}
+		f.epoch = &t
+	case 'a', 'A':
+		_, end, err = readStrptimeName(value, pos, names.days, "day")
+	case 'b', 'B', 'h':
+		n, end, err = readStrptimeName(value, pos, names.months, "month")
+		f.put(fieldMonth, n+1)
+	case 'p', 'P':
+		n, end, err = readStrptimeName(value, pos, names.periods, "period of day")
+		f.put(fieldPM, n)
+	case 'z', 'Z':
+		f.loc, end, err = readStrptimeZone(value, pos, elem.directive == 'Z')
+	}
+	return end, err
+}
+
+// spacePadded skips the space padding a one digit number.
+func spacePadded(value string, pos *int, width int) int {
+	if *pos < len(value) && value[*pos] == ' ' {
+		*pos++
+		return 1
+	}
+	return width
+}
+
+// readStrptimeNumber reads a decimal number of at most width digits, or of
+// any length if width is 0.
+func readStrptimeNumber(value string, pos, width int, signed bool) (int, int, error) {
+	start := pos
+	negative := false
+	if signed && pos < len(value) && (value[pos] == '+' || value[pos] == '-') {
+		negative = value[pos] == '-'
+		pos++
+	}
+	digitsStart := pos
+	n := 0
+	for pos < len(value) && value[pos] >= '0' && value[pos] <= '9' && (width == 0 || pos-digitsStart < width) {
+		d := int(value[pos] - '0')
+		if n > (math.MaxInt-d)/10 {
+			return 0, start, fmt.Errorf("the number at position %d is too large", start)
+		}
+		n = n*10 + d
+		pos++
+	}
+	if pos == digitsStart {
+		return 0, start, fmt.Errorf("expected a number at position %d", start)
+	}
+	if negative {
+		n = -n
+	}
+	return n, pos, nil
+}
+
+// readStrptimeFraction reads the digits of a fraction of second, at most
+// width of them or any number if width is 0, and returns it in nanoseconds.
+// The digits after the ninth are read but ignored.
+func readStrptimeFraction(value string, pos, width int) (int, int, error) {
+	start := pos
+	n, digits := 0, 0
+	for pos < len(value) && value[pos] >= '0' && value[pos] <= '9' && (width == 0 || pos-start < width) {
+		if digits < 9 {
+			n = n*10 + int(value[pos]-'0')
+			digits++
+		}
+		pos++
+	}
+	if pos == start {
+		return 0, start, fmt.Errorf("expected a number at position %d", start)
+	}
+	for ; digits < 9; digits++ {
+		n *= 10
+	}
+	return n, pos, nil
+}
+
+// readStrptimeName reads the longest of the names, ignoring case, and
+// returns its index.
+func readStrptimeName(value string, pos int, tables [][]string, kind string) (int, int, error) {
+	index, length := -1, 0
+	for _, table := range tables {
+		for i, name := range table {
+			if name != "" && len(name) > length && len(value)-pos >= len(name) && strings.EqualFold(value[pos:pos+len(name)], name) {
+				index, length = i, len(name)
+			}
+		}
+	}
+	if index < 0 {
+		return 0, pos, fmt.Errorf("expected a %s name at position %d", kind, pos)
+	}
+	return index, pos + length, nil
+}
+
+// strptimeZones are the zone names of RFC 2822, which are the only ones with
+// an unambiguous offset.
+var strptimeZones = map[string]int{
+	"UT":  0,
+	"UTC": 0,
+	"GMT": 0,
+	"Z":   0,
+	"EST": -5 * 3600,
+	"EDT": -4 * 3600,
+	"CST": -6 * 3600,
+	"CDT": -5 * 3600,
+	"MST": -7 * 3600,
+	"MDT": -6 * 3600,
+	"PST": -8 * 3600,
+	"PDT": -7 * 3600,
+}
+
+// readStrptimeZone reads a UTC offset such as "+09", "+0900", "+09:00" or
+// "+09:00:00", or one of strptimeZones. If names is set, IANA time zones
+// such as "Asia/Tokyo" are also accepted.
+func readStrptimeZone(value string, pos int, names bool) (*time.Location, int, error) {
+	end := pos
+	for end < len(value) && (isLetter(value[end]) || (names && end > pos && strings.IndexByte("/_-+0123456789", value[end]) >= 0)) {
+		end++
+	}
+	if name := value[pos:end]; name != "" {
+		if offset, ok := strptimeZones[strings.ToUpper(name)]; ok {
+			return time.FixedZone(strings.ToUpper(name), offset), end, nil
+		}
+		if names && strings.Contains(name, "/") {
+			loc, err := time.LoadLocation(name)
+			if err != nil {
+				return nil, pos, err
+			}
+			return loc, end, nil
+		}
+		return nil, pos, fmt.Errorf("unknown time zone %q", name)
+	}
+	return readUTCOffset(value, pos)
+}
+
+// readUTCOffset reads an offset with hours and optional minutes and seconds,
+// separated by colons or not.
+func readUTCOffset(value string, pos int) (*time.Location, int, error) {
+	start := pos
+	if pos == len(value) || (value[pos] != '+' && value[pos] != '-') {
+		return nil, start, fmt.Errorf("expected a time zone at position %d", start)
+	}
+	sign := 1
+	if value[pos] == '-' {
+		sign = -1
+	}
+	pos++
+	offset := 0
+	for i, unit := range []int{3600, 60, 1} {
+		next := pos
+		if i > 0 && next < len(value) && value[next] == ':' {
+			next++
+		}
+		if i > 0 && (next+2 > len(value) || !isDigit(value[next]) || !isDigit(value[next+1])) {
+			break
+		}
+		n, end, err := readStrptimeNumber(value, next, 2, false)
+		if err != nil || end-next != 2 || (i > 0 && n > 59) {
+			return nil, start, fmt.Errorf("invalid time zone offset at position %d", start)
+		}
+		offset += n * unit
+		pos = end
+	}
+	return time.FixedZone("", sign*offset), pos, nil
+}
+
+// time returns the time of the fields, see strptime.
+func (f *strptimeFields) time(loc *time.Location, now time.Time) (time.Time, error) {
+	if f.loc != nil {
+		loc = f.loc
+	}
+	if f.epoch != nil {
+		t := *f.epoch
+		if f.set[fieldNanosecond] {
+			t = t.Add(time.Duration(f.values[fieldNanosecond]))
+		}
+		return t.In(loc), nil
+	}
+
+	switch {
+	case f.set[fieldYear]:
+	case f.set[fieldYearOfCentury] && f.set[fieldCentury]:
+		f.put(fieldYear, f.values[fieldCentury]*100+f.values[fieldYearOfCentury])
+	case f.set[fieldYearOfCentury] && f.values[fieldYearOfCentury] < 69:
+		f.put(fieldYear, 2000+f.values[fieldYearOfCentury])
+	case f.set[fieldYearOfCentury]:
+		f.put(fieldYear, 1900+f.values[fieldYearOfCentury])
+	case f.set[fieldCentury]:
+		f.put(fieldYear, f.values[fieldCentury]*100)
+	}
+	if f.set[fieldPM] && f.set[fieldHour] {
+		f.values[fieldHour] = f.values[fieldHour]%12 + 12*f.values[fieldPM]
+	}
+	if f.set[fieldYearDay] && !f.set[fieldMonth] && !f.set[fieldDay] {
+		f.put(fieldMonth, 1)
+		f.put(fieldDay, f.values[fieldYearDay])
+	}
+
+	present := -1
+	for field := fieldYear; field <= fieldNanosecond; field++ {
+		if f.set[field] {
+			present = field
+			break
+		}
+	}
+	if present < 0 {
+		return time.Time{}, errors.New("no date or time in the value")
+	}
+	now = now.In(loc)
+	nowValues := []int{now.Year(), int(now.Month()), now.Day(), now.Hour(), now.Minute(), now.Second(), now.Nanosecond()}
+	for field := fieldYear; field < present; field++ {
+		f.put(field, nowValues[field])
+	}
+	for _, field := range []int{fieldMonth, fieldDay} {
+		if !f.set[field] {
+			f.put(field, 1)
+		}
+	}
+
+	v := f.values
+	return time.Date(v[fieldYear], time.Month(v[fieldMonth]), v[fieldDay], v[fieldHour], v[fieldMinute], v[fieldSecond], v[fieldNanosecond], loc), nil
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\v' || c == '\f' || c == '\r'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
@@ -0,0 +1,209 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStrptime(t *testing.T) {
+	now := time.Date(2024, time.June, 15, 10, 20, 30, 400, time.UTC)
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		format   string
+		value    string
+		locale   string
+		expected time.Time
+	}{
+		{
+			name:     "fluentd default",
+			format:   "%Y-%m-%d %H:%M:%S %z",
+			value:    "2023-01-02 03:04:05 +0900",
+			expected: time.Date(2023, time.January, 2, 3, 4, 5, 0, time.FixedZone("", 9*3600)),
+		},
+		{
+			name:     "nanoseconds and colon offset",
+			format:   "%Y-%m-%dT%H:%M:%S.%N%:z",
+			value:    "2023-01-02T03:04:05.123456789-07:00",
+			expected: time.Date(2023, time.January, 2, 3, 4, 5, 123456789, time.FixedZone("", -7*3600)),
+		},
+		{
+			name:     "variable fraction digits",
+			format:   "%Y-%m-%dT%H:%M:%S.%N%::z",
+			value:    "2023-01-02T03:04:05.12+05:30:00",
+			expected: time.Date(2023, time.January, 2, 3, 4, 5, 120000000, time.FixedZone("", 5*3600+30*60)),
+		},
+		{
+			name:     "fraction width",
+			format:   "%H%M%S%3N%6N",
+			value:    "030405123456789",
+			expected: time.Date(2024, time.June, 15, 3, 4, 5, 456789000, time.UTC),
+		},
+		{
+			name:     "more fraction digits than an int holds",
+			format:   "%H:%M:%S.%N",
+			value:    "03:04:05.123456789987654321123456789",
+			expected: time.Date(2024, time.June, 15, 3, 4, 5, 123456789, time.UTC),
+		},
+		{
+			name:     "milliseconds",
+			format:   "%FT%T.%LZ",
+			value:    "2023-01-02T03:04:05.123Z",
+			expected: time.Date(2023, time.January, 2, 3, 4, 5, 123000000, time.UTC),
+		},
+		{
+			name:     "syslog without a year",
+			format:   "%b %e %H:%M:%S",
+			value:    "Mar  7 03:04:05",
+			expected: time.Date(2024, time.March, 7, 3, 4, 5, 0, time.UTC),
+		},
+		{
+			name:     "space padded day",
+			format:   "%b%e",
+			value:    "Mar 7",
+			expected: time.Date(2024, time.March, 7, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "space padded hour",
+			format:   "%Y-%m-%d%k:%M",
+			value:    "2023-01-02 3:04",
+			expected: time.Date(2023, time.January, 2, 3, 4, 0, 0, time.UTC),
+		},
+		{
+			name:     "space padded twelve hour clock",
+			format:   "%d/%m/%Y%l%P",
+			value:    "02/01/2023 7pm",
+			expected: time.Date(2023, time.January, 2, 19, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "apache",
+			format:   "%d/%b/%Y:%H:%M:%S %z",
+			value:    "10/Oct/2000:13:55:36 -0700",
+			expected: time.Date(2000, time.October, 10, 13, 55, 36, 0, time.FixedZone("", -7*3600)),
+		},
+		{
+			name:     "time only",
+			format:   "%R",
+			value:    "03:04",
+			expected: time.Date(2024, time.June, 15, 3, 4, 0, 0, time.UTC),
+		},
+		{
+			name:     "twelve hour clock",
+			format:   "%D %r",
+			value:    "01/02/23 12:04:05 am",
+			expected: time.Date(2023, time.January, 2, 0, 4, 5, 0, time.UTC),
+		},
+		{
+			name:     "names",
+			format:   "%A, %B %d, %Y %l%P",
+			value:    "tuesday, FEBRUARY 28, 1995  7pm",
+			expected: time.Date(1995, time.February, 28, 19, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "century",
+			format:   "%C%y-%j",
+			value:    "1999-032",
+			expected: time.Date(1999, time.February, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "epoch",
+			format:   "%s.%N %z",
+			value:    "1700000000.5 +0100",
+			expected: time.Unix(1700000000, 500000000).In(time.FixedZone("", 3600)),
+		},
+		{
+			name:     "epoch milliseconds",
+			format:   "%Q",
+			value:    "1700000000123",
+			expected: time.UnixMilli(1700000000123).UTC(),
+		},
+		{
+			name:     "zone names",
+			format:   "%Y-%m-%d %H:%M %Z",
+			value:    "2023-01-02 03:04 Asia/Tokyo",
+			expected: time.Date(2023, time.January, 2, 3, 4, 0, 0, tokyo),
+		},
+		{
+			name:     "zone abbreviation",
+			format:   "%c %z",
+			value:    "Mon Jan  2 03:04:05 2023 PST",
+			expected: time.Date(2023, time.January, 2, 3, 4, 5, 0, time.FixedZone("PST", -8*3600)),
+		},
+		{
+			name:     "locale",
+			format:   "%d %B %Y %%",
+			value:    "02 janvier 2023 %",
+			locale:   "fr-FR",
+			expected: time.Date(2023, time.January, 2, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			elems, err := compileStrptime(tt.format)
+			require.NoError(t, err)
+			var names *strptimeNames
+			if tt.locale != "" {
+				names, err = newStrptimeNames(tt.locale)
+				require.NoError(t, err)
+			}
+			actual, err := strptime(elems, tt.value, names, time.UTC, now)
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(actual), "expected %s, got %s", tt.expected, actual)
+			_, expectedOffset := tt.expected.Zone()
+			_, actualOffset := actual.Zone()
+			assert.Equal(t, expectedOffset, actualOffset)
+		})
+	}
+}
+
+func TestStrptimeErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		value  string
+	}{
+		{name: "literal mismatch", format: "%Y-%m", value: "2023/01"},
+		{name: "invalid month", format: "%Y-%m", value: "2023-13"},
+		{name: "unknown month name", format: "%b", value: "Foo"},
+		{name: "trailing characters", format: "%Y", value: "2023 and more"},
+		{name: "unknown time zone", format: "%H %z", value: "12 XYZ"},
+		{name: "invalid offset", format: "%H %z", value: "12 +9"},
+		{name: "no time", format: "%a", value: "Mon"},
+		{name: "epoch overflow", format: "%s", value: "99999999999999999999"},
+		{name: "epoch milliseconds overflow", format: "%Q", value: "-99999999999999999999"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			elems, err := compileStrptime(tt.format)
+			require.NoError(t, err)
+			_, err = strptime(elems, tt.value, nil, time.UTC, time.Now())
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestCompileStrptimeErrors(t *testing.T) {
+	for _, format := range []string{"%Y-%", "%q", "%:H"} {
+		_, err := compileStrptime(format)
+		assert.Error(t, err, format)
+	}
+}
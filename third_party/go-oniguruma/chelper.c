@@ -1,11 +1,80 @@
 #include <stdlib.h>
 #include <stdio.h>
 #include <string.h>
+#include <limits.h>
+#include <time.h>
 #ifdef BENCHMARK_CHELP
 #include <sys/time.h>
 #endif
 #include "chelper.h"
 
+/* retry limit in search of the first attempt of a search with a time limit */
+#define TIME_LIMIT_INITIAL_RETRY_LIMIT 10000
+
+static long long monotonic_nsec() {
+    struct timespec ts;
+    clock_gettime(CLOCK_MONOTONIC, &ts);
+    return (long long) ts.tv_sec * 1000000000LL + ts.tv_nsec;
+}
+
+/*
+ * search_with_limits is onig_search with retry and time limits. Oniguruma has
+ * no time limit, so a search with a time limit is attempted with a retry limit
+ * in search doubling after each attempt, until it completes or the time limit
+ * is exceeded. The time limit is checked between attempts, so a search can
+ * overrun it by the duration of its last attempt.
+ */
+static int search_with_limits(OnigRegex regex, OnigUChar *str_start, OnigUChar *str_end,
+                  OnigUChar *search_start, OnigUChar *search_end, OnigRegion *region, int option,
+                  unsigned long retry_limit_in_match, unsigned long retry_limit_in_search, long long time_limit_nsec) {
+    int ret;
+    int last_attempt;
+    long long deadline;
+    unsigned long attempt_retry_limit;
+    OnigMatchParam *mp;
+
+    if (retry_limit_in_match == 0 && retry_limit_in_search == 0 && time_limit_nsec <= 0) {
+        return onig_search(regex, str_start, str_end, search_start, search_end, region, option);
+    }
+
+    mp = onig_new_match_param();
+    if (mp == NULL) {
+        return ONIGERR_MEMORY;
+    }
+    if (retry_limit_in_match != 0) {
+        onig_set_retry_limit_in_match_of_match_param(mp, retry_limit_in_match);
+    }
+
+    if (time_limit_nsec <= 0) {
+        onig_set_retry_limit_in_search_of_match_param(mp, retry_limit_in_search);
+        ret = onig_search_with_param(regex, str_start, str_end, search_start, search_end, region, option, mp);
+        onig_free_match_param(mp);
+        return ret;
+    }
+
+    deadline = monotonic_nsec() + time_limit_nsec;
+    attempt_retry_limit = TIME_LIMIT_INITIAL_RETRY_LIMIT;
+    for (;;) {
+        last_attempt = retry_limit_in_search != 0 && attempt_retry_limit >= retry_limit_in_search;
+        onig_set_retry_limit_in_search_of_match_param(mp, last_attempt ? retry_limit_in_search : attempt_retry_limit);
+        ret = onig_search_with_param(regex, str_start, str_end, search_start, search_end, region, option, mp);
+        if (ret != ONIGERR_RETRY_LIMIT_IN_SEARCH_OVER || last_attempt) {
+            break;
+        }
+        if (monotonic_nsec() > deadline) {
+            ret = ONIG_HELPER_TIME_LIMIT_OVER;
+            break;
+        }
+        if (attempt_retry_limit > ULONG_MAX / 2) {
+            attempt_retry_limit = ULONG_MAX;
+        } else {
+            attempt_retry_limit *= 2;
+        }
+    }
+    onig_free_match_param(mp);
+    return ret;
+}
+
 int NewOnigRegex( char *pattern, int pattern_length, int option,
                   OnigRegex *regex, OnigEncoding *encoding, OnigErrorInfo **error_info, char **error_buffer) {
     int ret = ONIG_NORMAL;
@@ -36,7 +105,8 @@ int NewOnigRegex( char *pattern, int pattern_length, int option,
 }
 
 int SearchOnigRegex( void *str, int str_length, int offset, int option,
-                  OnigRegex regex, OnigErrorInfo *error_info, char *error_buffer, int *captures, int *numCaptures) {
+                  OnigRegex regex, OnigErrorInfo *error_info, char *error_buffer, int *captures, int *numCaptures,
+                  unsigned long retry_limit_in_match, unsigned long retry_limit_in_search, long long time_limit_nsec) {
     int ret = ONIG_MISMATCH;
     int error_msg_len = 0;
     OnigRegion *region;
@@ -56,15 +126,16 @@ int SearchOnigRegex( void *str, int str_length, int offset, int option,
 
     region = onig_region_new();
 
-    ret = onig_search(regex, str_start, str_end, search_start, search_end, region, option);
-    if (ret < 0 && error_buffer != NULL) {
+    ret = search_with_limits(regex, str_start, str_end, search_start, search_end, region, option,
+                             retry_limit_in_match, retry_limit_in_search, time_limit_nsec);
+    if (ret < 0 && ret != ONIG_HELPER_TIME_LIMIT_OVER && error_buffer != NULL) {
         error_msg_len = onig_error_code_to_str((unsigned char*)(error_buffer), ret, error_info);
         if (error_msg_len >= ONIG_MAX_ERROR_MESSAGE_LEN) {
             error_msg_len = ONIG_MAX_ERROR_MESSAGE_LEN - 1;
         }
         error_buffer[error_msg_len] = '\0';
     }
-    else if (captures != NULL) {
+    else if (ret >= 0 && captures != NULL) {
         int i;
         int count = 0;
         for (i = 0; i < region->num_regs; i++) {
@@ -113,6 +184,11 @@ int MatchOnigRegex(void *str, int str_length, int offset, int option,
     return ret;
 }
 
+/* CharLengthOnigEncoding returns the length of the character starting at str. */
+int CharLengthOnigEncoding(OnigEncoding encoding, void *str) {
+    return ONIGENC_MBC_ENC_LEN(encoding, (OnigUChar *) str);
+}
+
 int LookupOnigCaptureByName(char *name, int name_length,
                   OnigRegex regex) {
     int ret = ONIGERR_UNDEFINED_NAME_REFERENCE;
@@ -142,6 +218,7 @@ typedef struct {
     int bufferOffset;
     int bufferSize;
     int *numbers;
+    int *lengths;
     int numIndex;
 } group_info_t;
 
@@ -157,19 +234,13 @@ int name_callback(const UChar* name, const UChar* name_end,
     nameLen = name_end - name;
     newOffset = offset + nameLen;
 
-    //if there are already names, add a ";"
-    if (offset > 0) {
-        newOffset += 1;
-    }
-
+    //the names are not separated, as any byte can be part of a name in some
+    //encodings, so the length of each name is returned instead
     if (newOffset <= groupInfo->bufferSize) {
-        if (offset > 0) {
-            groupInfo->nameBuffer[offset] = ';';
-            offset += 1;
-        }
         memcpy(&groupInfo->nameBuffer[offset], name, nameLen);
     }
     groupInfo->bufferOffset = newOffset;
+    groupInfo->lengths[groupInfo->numIndex] = nameLen;
     if (ngroup_num > 0) {
         groupInfo->numbers[groupInfo->numIndex] = group_nums[ngroup_num-1];
     } else {
@@ -179,13 +250,14 @@ int name_callback(const UChar* name, const UChar* name_end,
     return 0;  /* 0: continue */
 }
 
-int GetCaptureNames(OnigRegex reg, void *buffer, int bufferSize, int* groupNumbers) {
+int GetCaptureNames(OnigRegex reg, void *buffer, int bufferSize, int* groupNumbers, int* nameLengths) {
     int ret;
     group_info_t groupInfo;
     groupInfo.nameBuffer = (char*)buffer;
     groupInfo.bufferOffset = 0;
     groupInfo.bufferSize = bufferSize;
     groupInfo.numbers = groupNumbers;
+    groupInfo.lengths = nameLengths;
     groupInfo.numIndex = 0;
     onig_foreach_name(reg, name_callback, (void* )&groupInfo);
     return groupInfo.bufferOffset;
@@ -3,12 +3,17 @@
 extern int NewOnigRegex( char *pattern, int pattern_length, int option,
                                   OnigRegex *regex, OnigEncoding *encoding, OnigErrorInfo **error_info, char **error_buffer);
 
+#define ONIG_HELPER_TIME_LIMIT_OVER -10000
+
 extern int SearchOnigRegex( void *str, int str_length, int offset, int option,
-                                  OnigRegex regex, OnigErrorInfo *error_info, char *error_buffer, int *captures, int *numCaptures);
+                                  OnigRegex regex, OnigErrorInfo *error_info, char *error_buffer, int *captures, int *numCaptures,
+                                  unsigned long retry_limit_in_match, unsigned long retry_limit_in_search, long long time_limit_nsec);
 
 extern int MatchOnigRegex( void *str, int str_length, int offset, int option,
                   OnigRegex regex);
 
+extern int CharLengthOnigEncoding(OnigEncoding encoding, void *str);
+
 extern int LookupOnigCaptureByName(char *name, int name_length, OnigRegex regex);
 
-extern int GetCaptureNames(OnigRegex regex, void *buffer, int bufferSize, int* groupNumbers);
+extern int GetCaptureNames(OnigRegex regex, void *buffer, int bufferSize, int* groupNumbers, int* nameLengths);
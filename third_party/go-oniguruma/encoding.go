@@ -0,0 +1,142 @@
+package rubex
+
+/*
+#include "chelper.h"
+*/
+import "C"
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/unicode"
+)
+
+// Encoding is the character encoding of the patterns and subjects of a
+// Regexp.
+type Encoding int
+
+const (
+	EncodingUTF8 Encoding = iota
+	EncodingASCII
+	EncodingISO8859_1
+	EncodingShiftJIS
+	EncodingEUCJP
+	EncodingUTF16LE
+	EncodingUTF16BE
+)
+
+// encodingNames are the names of the encodings, as Ruby names them.
+var encodingNames = map[Encoding]string{
+	EncodingUTF8:      "UTF-8",
+	EncodingASCII:     "US-ASCII",
+	EncodingISO8859_1: "ISO-8859-1",
+	EncodingShiftJIS:  "Shift_JIS",
+	EncodingEUCJP:     "EUC-JP",
+	EncodingUTF16LE:   "UTF-16LE",
+	EncodingUTF16BE:   "UTF-16BE",
+}
+
+// encodingAliases maps the normalized names accepted by ParseEncoding to the
+// encodings.
+var encodingAliases = map[string]Encoding{
+	"utf-8":      EncodingUTF8,
+	"utf8":       EncodingUTF8,
+	"ascii":      EncodingASCII,
+	"us-ascii":   EncodingASCII,
+	"iso-8859-1": EncodingISO8859_1,
+	"latin1":     EncodingISO8859_1,
+	"shift-jis":  EncodingShiftJIS,
+	"sjis":       EncodingShiftJIS,
+	"euc-jp":     EncodingEUCJP,
+	"eucjp":      EncodingEUCJP,
+	"utf-16le":   EncodingUTF16LE,
+	"utf-16be":   EncodingUTF16BE,
+}
+
+// ParseEncoding returns the encoding with the given name, e.g. "Shift_JIS" or
+// "UTF-16LE". Names are case-insensitive, and underscores and hyphens are
+// interchangeable.
+func ParseEncoding(name string) (Encoding, error) {
+	normalized := strings.ReplaceAll(strings.ToLower(name), "_", "-")
+	if e, ok := encodingAliases[normalized]; ok {
+		return e, nil
+	}
+	return 0, fmt.Errorf("unsupported encoding %q", name)
+}
+
+func (e Encoding) String() string {
+	if name, ok := encodingNames[e]; ok {
+		return name
+	}
+	return fmt.Sprintf("Encoding(%d)", int(e))
+}
+
+func (e Encoding) onigEncoding() (C.OnigEncoding, error) {
+	switch e {
+	case EncodingUTF8:
+		return C.ONIG_ENCODING_UTF8, nil
+	case EncodingASCII:
+		return C.ONIG_ENCODING_ASCII, nil
+	case EncodingISO8859_1:
+		return C.ONIG_ENCODING_ISO_8859_1, nil
+	case EncodingShiftJIS:
+		return C.ONIG_ENCODING_SJIS, nil
+	case EncodingEUCJP:
+		return C.ONIG_ENCODING_EUC_JP, nil
+	case EncodingUTF16LE:
+		return C.ONIG_ENCODING_UTF16_LE, nil
+	case EncodingUTF16BE:
+		return C.ONIG_ENCODING_UTF16_BE, nil
+	}
+	return nil, fmt.Errorf("unsupported encoding %v", e)
+}
+
+// transcoder returns the transcoder between e and UTF-8, or nil if the bytes
+// are used as is.
+func (e Encoding) transcoder() encoding.Encoding {
+	switch e {
+	case EncodingISO8859_1:
+		return charmap.ISO8859_1
+	case EncodingShiftJIS:
+		return japanese.ShiftJIS
+	case EncodingEUCJP:
+		return japanese.EUCJP
+	case EncodingUTF16LE:
+		return unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM)
+	case EncodingUTF16BE:
+		return unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM)
+	}
+	return nil
+}
+
+// Encode converts the UTF-8 string s to e. It fails if s has characters that
+// can't be represented in e. UTF-8 and ASCII strings are returned as is.
+func (e Encoding) Encode(s string) ([]byte, error) {
+	t := e.transcoder()
+	if t == nil {
+		return []byte(s), nil
+	}
+	b, err := t.NewEncoder().Bytes([]byte(s))
+	if err != nil {
+		return nil, fmt.Errorf("cannot encode %q to %v: %w", s, e, err)
+	}
+	return b, nil
+}
+
+// Decode converts b from e to a UTF-8 string. Invalid sequences are replaced
+// with U+FFFD. UTF-8 and ASCII bytes are returned as is.
+func (e Encoding) Decode(b []byte) (string, error) {
+	t := e.transcoder()
+	if t == nil {
+		return string(b), nil
+	}
+	d, err := t.NewDecoder().Bytes(b)
+	if err != nil {
+		return "", fmt.Errorf("cannot decode from %v: %w", e, err)
+	}
+	return string(d), nil
+}
@@ -0,0 +1,178 @@
+package rubex
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseEncoding(t *testing.T) {
+	tests := map[string]Encoding{
+		"UTF-8":      EncodingUTF8,
+		"us-ascii":   EncodingASCII,
+		"Shift_JIS":  EncodingShiftJIS,
+		"SJIS":       EncodingShiftJIS,
+		"EUC-JP":     EncodingEUCJP,
+		"utf-16le":   EncodingUTF16LE,
+		"UTF_16BE":   EncodingUTF16BE,
+		"ISO-8859-1": EncodingISO8859_1,
+	}
+	for name, expected := range tests {
+		e, err := ParseEncoding(name)
+		if err != nil {
+			t.Errorf("unexpected error parsing %q: %v", name, err)
+		}
+		if e != expected {
+			t.Errorf("expected %v for %q, got %v", expected, name, e)
+		}
+	}
+
+	if _, err := ParseEncoding("EBCDIC"); err == nil {
+		t.Error("expected an error for an unsupported encoding")
+	}
+}
+
+func TestEncodingRoundTrip(t *testing.T) {
+	for _, e := range []Encoding{EncodingUTF8, EncodingShiftJIS, EncodingEUCJP, EncodingUTF16LE, EncodingUTF16BE} {
+		encoded, err := e.Encode("ログ log")
+		if err != nil {
+			t.Fatalf("unexpected error encoding to %v: %v", e, err)
+		}
+		decoded, err := e.Decode(encoded)
+		if err != nil {
+			t.Fatalf("unexpected error decoding from %v: %v", e, err)
+		}
+		if decoded != "ログ log" {
+			t.Errorf("expected the %v round trip to return the input, got %q", e, decoded)
+		}
+	}
+
+	if _, err := EncodingShiftJIS.Encode("🙂"); err == nil {
+		t.Error("expected an error encoding a character Shift_JIS can't represent")
+	}
+}
+
+func TestRegexpEncoding(t *testing.T) {
+	pattern := `^(?<level>[^ ]+) (?<message>ログ.*)$`
+	for _, e := range []Encoding{EncodingShiftJIS, EncodingEUCJP, EncodingUTF16LE, EncodingUTF16BE} {
+		re, err := NewRegexpEncoding(pattern, ONIG_OPTION_DEFAULT, e)
+		if err != nil {
+			t.Fatalf("unexpected error compiling for %v: %v", e, err)
+		}
+		if re.Encoding() != e {
+			t.Errorf("expected encoding %v, got %v", e, re.Encoding())
+		}
+		if names := re.SubexpNames(); len(names) < 2 || !reflect.DeepEqual(names[:2], []string{"level", "message"}) {
+			t.Errorf("unexpected names for %v: %v", e, re.SubexpNames())
+		}
+
+		subject, err := e.Encode("INFO ログを出力")
+		if err != nil {
+			t.Fatal(err)
+		}
+		matches := re.FindSubmatch(subject)
+		if len(matches) != 3 {
+			t.Fatalf("expected %v to match, got %q", e, matches)
+		}
+		message, err := e.Decode(matches[2])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if message != "ログを出力" {
+			t.Errorf("unexpected %v capture %q", e, message)
+		}
+
+		// The UTF-8 bytes of the subject are not valid in the encoding.
+		if re.MatchString("INFO ログを出力") {
+			t.Errorf("expected the UTF-8 subject not to match in %v", e)
+		}
+	}
+}
+
+func TestRegexpEncodingMultibyteCharacters(t *testing.T) {
+	// The second byte of "ソ" in Shift_JIS is a backslash, which must not be
+	// matched on its own.
+	re, err := NewRegexpEncoding(`\\`, ONIG_OPTION_DEFAULT, EncodingShiftJIS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	subject, err := EncodingShiftJIS.Encode("ソ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if re.Match(subject) {
+		t.Error("expected the trailing byte of a Shift_JIS character not to match")
+	}
+	if !re.MatchString(`\`) {
+		t.Error("expected a backslash to match")
+	}
+}
+
+func TestRegexpEncodingUnsupportedPattern(t *testing.T) {
+	if _, err := NewRegexpEncoding("🙂", ONIG_OPTION_DEFAULT, EncodingShiftJIS); err == nil {
+		t.Error("expected an error for a pattern Shift_JIS can't represent")
+	}
+	if _, err := NewRegexpEncoding("a", ONIG_OPTION_DEFAULT, Encoding(-1)); err == nil {
+		t.Error("expected an error for an unknown encoding")
+	}
+}
+
+func TestRegexpEncodingGroupNames(t *testing.T) {
+	// "主" is U+4E3B, so its UTF-16 encodings contain the byte 0x3B, ';'.
+	for _, e := range []Encoding{EncodingUTF16LE, EncodingUTF16BE} {
+		re, err := NewRegexpEncoding(`(?<主>a)(?<b>b)`, ONIG_OPTION_DEFAULT, e)
+		if err != nil {
+			t.Fatalf("unexpected error compiling for %v: %v", e, err)
+		}
+		if names := re.SubexpNames(); len(names) < 2 || !reflect.DeepEqual(names[:2], []string{"主", "b"}) {
+			t.Errorf("unexpected names for %v: %v", e, re.SubexpNames())
+		}
+
+		subject, err := e.Encode("ab")
+		if err != nil {
+			t.Fatal(err)
+		}
+		matches := re.FindSubmatch(subject)
+		if len(matches) != 3 {
+			t.Fatalf("expected %v to match, got %q", e, matches)
+		}
+		if b, _ := e.Decode(matches[re.namedGroupInfo["b"]]); b != "b" {
+			t.Errorf("unexpected %v capture %q", e, b)
+		}
+	}
+}
+
+func TestRegexpEncodingEmptyMatches(t *testing.T) {
+	const subject = "aあ\nbい"
+	for _, pattern := range []string{`x*`, `\b`, `^`} {
+		// The matches are the same as the UTF-8 ones, at character boundaries.
+		utf8Re := MustCompile(pattern)
+		expectedCount := len(utf8Re.FindAllStringIndex(subject, -1))
+		expected := utf8Re.ReplaceAllString(subject, "-")
+
+		for _, e := range []Encoding{EncodingShiftJIS, EncodingEUCJP, EncodingUTF16LE, EncodingUTF16BE} {
+			re, err := NewRegexpEncoding(pattern, ONIG_OPTION_DEFAULT, e)
+			if err != nil {
+				t.Fatalf("unexpected error compiling %q for %v: %v", pattern, e, err)
+			}
+			encodedSubject, err := e.Encode(subject)
+			if err != nil {
+				t.Fatal(err)
+			}
+			repl, err := e.Encode("-")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if matches := re.FindAllIndex(encodedSubject, -1); len(matches) != expectedCount {
+				t.Errorf("expected %d matches of %q for %v, got %v", expectedCount, pattern, e, matches)
+			}
+			replaced, err := e.Decode(re.ReplaceAll(encodedSubject, repl))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if replaced != expected {
+				t.Errorf("expected %q replacing %q for %v, got %q", expected, pattern, e, replaced)
+			}
+		}
+	}
+}
@@ -7,13 +7,13 @@ package rubex
 import "C"
 
 import (
-	"bytes"
 	"errors"
 	"fmt"
 	"io"
 	"runtime"
 	"strconv"
 	"sync"
+	"time"
 	"unicode/utf8"
 	"unsafe"
 
@@ -22,14 +22,28 @@ import (
 
 const numMatchStartSize = 4
 const numReadBufferStartSize = 256
+const maxPooledReadBufferSize = 64 * 1024
 
 var mutex sync.Mutex
 
+// emptySubject is pointed to when searching empty subjects, which have no
+// first byte.
+var emptySubject byte
+
+// readBufferPool holds the buffers the runes of io.RuneReaders are read into.
+var readBufferPool = sync.Pool{
+	New: func() any {
+		b := make([]byte, 0, numReadBufferStartSize)
+		return &b
+	},
+}
+
 type NamedGroupInfo map[string]int
 
 type Regexp struct {
 	pattern   string
 	regex     C.OnigRegex
+	enc       Encoding
 	encoding  C.OnigEncoding
 	errorInfo *C.OnigErrorInfo
 	errorBuf  *C.char
@@ -37,32 +51,129 @@ type Regexp struct {
 	numCaptures    int32
 	namedGroupInfo NamedGroupInfo
 	subexpnames    []string
+
+	matchParams MatchParams
+
+	// capturesPool holds the buffers Oniguruma writes the captures to.
+	capturesPool sync.Pool
+}
+
+// capturesBuffer holds the captures of a search and their count, which are
+// written by Oniguruma.
+type capturesBuffer struct {
+	captures    []C.int
+	numCaptures C.int
+}
+
+// MatchParams limits the work done by a single search. Zero values keep the
+// Oniguruma defaults: a retry limit in match of 10000000, and no retry limit
+// in search or time limit.
+// Retry limits are clamped to the range of a C unsigned long, which is 32
+// bits wide on Windows.
+type MatchParams struct {
+	// RetryLimitInMatch is the maximum number of backtracking retries of a
+	// match attempt at a single position.
+	RetryLimitInMatch uint64
+	// RetryLimitInSearch is the maximum number of backtracking retries of a
+	// search, across all the positions it tries.
+	RetryLimitInSearch uint64
+	// TimeLimit is the maximum duration of a search. Oniguruma has no time
+	// limit, so the search is attempted with a doubling retry limit in
+	// search until it completes, and the time limit is checked between
+	// attempts. A search can overrun it by the duration of its last attempt.
+	TimeLimit time.Duration
+}
+
+var (
+	ErrRetryLimitInMatchOver  = errors.New("retry-limit-in-match over")
+	ErrRetryLimitInSearchOver = errors.New("retry-limit-in-search over")
+	ErrTimeLimitOver          = errors.New("time limit over")
+)
+
+// SetMatchParams sets the limits applied to the searches of re. It must not
+// be called concurrently with searches.
+func (re *Regexp) SetMatchParams(params MatchParams) {
+	re.matchParams = params
+}
+
+// MatchParams returns the limits applied to the searches of re.
+func (re *Regexp) MatchParams() MatchParams {
+	return re.matchParams
+}
+
+// cULong converts a retry limit to a C unsigned long, clamping it to its range:
+// unsigned long is 32 bits wide on Windows, where larger limits would wrap.
+func cULong(v uint64) C.ulong {
+	if maxULong := ^C.ulong(0); v > uint64(maxULong) {
+		return maxULong
+	}
+	return C.ulong(v)
+}
+
+func searchError(code int) error {
+	switch code {
+	case C.ONIGERR_RETRY_LIMIT_IN_MATCH_OVER:
+		return ErrRetryLimitInMatchOver
+	case C.ONIGERR_RETRY_LIMIT_IN_SEARCH_OVER:
+		return ErrRetryLimitInSearchOver
+	case C.ONIG_HELPER_TIME_LIMIT_OVER:
+		return ErrTimeLimitOver
+	}
+	return fmt.Errorf("search failed with error code %d", code)
 }
 
 // NewRegexp creates and initializes a new Regexp with the given pattern and option.
 func NewRegexp(pattern string, option int) (*Regexp, error) {
-	return initRegexp(&Regexp{pattern: pattern, encoding: C.ONIG_ENCODING_UTF8}, option)
+	return initRegexp(&Regexp{pattern: pattern, enc: EncodingUTF8}, option)
 }
 
 // NewRegexpASCII is equivalent to NewRegexp, but with the encoding restricted to ASCII.
 func NewRegexpASCII(pattern string, option int) (*Regexp, error) {
-	return initRegexp(&Regexp{pattern: pattern, encoding: C.ONIG_ENCODING_ASCII}, option)
+	return initRegexp(&Regexp{pattern: pattern, enc: EncodingASCII}, option)
+}
+
+// NewRegexpEncoding is equivalent to NewRegexp, but with the given encoding.
+// The pattern is converted from UTF-8 to the encoding, and the subjects must
+// be in the encoding. The matches, captures and replacements are in the
+// encoding too, and can be converted back to UTF-8 with Encoding.Decode.
+func NewRegexpEncoding(pattern string, option int, encoding Encoding) (*Regexp, error) {
+	return initRegexp(&Regexp{pattern: pattern, enc: encoding}, option)
 }
 
 func initRegexp(re *Regexp, option int) (*Regexp, error) {
-	patternCharPtr := C.CString(re.pattern)
+	var err error
+	re.encoding, err = re.enc.onigEncoding()
+	if err != nil {
+		return re, err
+	}
+	pattern, err := re.enc.Encode(re.pattern)
+	if err != nil {
+		return re, err
+	}
+
+	patternCharPtr := (*C.char)(C.CBytes(pattern))
 	defer C.free(unsafe.Pointer(patternCharPtr))
 
 	mutex.Lock()
 	defer mutex.Unlock()
 
-	errorCode := C.NewOnigRegex(patternCharPtr, C.int(len(re.pattern)), C.int(option), &re.regex, &re.encoding, &re.errorInfo, &re.errorBuf)
+	errorCode := C.NewOnigRegex(patternCharPtr, C.int(len(pattern)), C.int(option), &re.regex, &re.encoding, &re.errorInfo, &re.errorBuf)
 	if errorCode != C.ONIG_NORMAL {
 		return re, errors.New(C.GoString(re.errorBuf))
 	}
 
 	re.numCaptures = int32(C.onig_number_of_captures(re.regex)) + 1
-	re.namedGroupInfo = re.getNamedGroupInfo()
+	re.namedGroupInfo, err = re.getNamedGroupInfo(len(pattern))
+	if err != nil {
+		return re, err
+	}
+	// captures contains two ints per capture group, its start and end.
+	// The closure must not capture re: the pool is part of re, and the
+	// finalizer of an object in a reference cycle never runs.
+	numCaptureInts := int(re.numCaptures) * 2
+	re.capturesPool.New = func() any {
+		return &capturesBuffer{captures: make([]C.int, numCaptureInts)}
+	}
 
 	runtime.SetFinalizer(re, (*Regexp).Free)
 
@@ -126,72 +237,93 @@ func (re *Regexp) SubexpNames() []string {
 	return re.subexpnames
 }
 
-func (re *Regexp) getNamedGroupInfo() NamedGroupInfo {
+// Encoding returns the encoding of the pattern and subjects of re.
+func (re *Regexp) Encoding() Encoding {
+	return re.enc
+}
+
+// getNamedGroupInfo returns the numbers of the named groups, whose names are
+// converted to UTF-8. patternLength is the length of the encoded pattern.
+func (re *Regexp) getNamedGroupInfo(patternLength int) (NamedGroupInfo, error) {
 	numNamedGroups := int(C.onig_number_of_names(re.regex))
 	// when any named capture exists, there is no numbered capture even if
 	// there are unnamed captures.
 	if numNamedGroups == 0 {
-		return nil
+		return nil, nil
 	}
 
 	namedGroupInfo := make(map[string]int)
 
 	//try to get the names
-	bufferSize := len(re.pattern) * 2
+	bufferSize := patternLength * 2
 	nameBuffer := make([]byte, bufferSize)
 	groupNumbers := make([]int32, numNamedGroups)
+	nameLengths := make([]int32, numNamedGroups)
 	bufferPtr := unsafe.Pointer(&nameBuffer[0])
 	numbersPtr := unsafe.Pointer(&groupNumbers[0])
+	lengthsPtr := unsafe.Pointer(&nameLengths[0])
 
-	length := int(C.GetCaptureNames(re.regex, bufferPtr, (C.int)(bufferSize), (*C.int)(numbersPtr)))
-	if length == 0 {
-		panic(fmt.Errorf("could not get the capture group names from %q", re.String()))
-	}
-
-	namesAsBytes := bytes.Split(nameBuffer[:length], ([]byte)(";"))
-	if len(namesAsBytes) != numNamedGroups {
-		panic(fmt.Errorf(
-			"the number of named groups (%d) does not match the number names found (%d)",
-			numNamedGroups, len(namesAsBytes),
-		))
+	length := int(C.GetCaptureNames(re.regex, bufferPtr, (C.int)(bufferSize), (*C.int)(numbersPtr), (*C.int)(lengthsPtr)))
+	if length == 0 || length > bufferSize {
+		return nil, fmt.Errorf("could not get the capture group names from %q", re.String())
 	}
 
 	re.subexpnames = make([]string, length)
-	for i, nameAsBytes := range namesAsBytes {
-		name := string(nameAsBytes)
+	offset := 0
+	for i, nameLength := range nameLengths {
+		nameAsBytes := nameBuffer[offset : offset+int(nameLength)]
+		offset += int(nameLength)
+		name, err := re.enc.Decode(nameAsBytes)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode the capture group name %q: %w", nameAsBytes, err)
+		}
 		namedGroupInfo[name] = int(groupNumbers[i])
 		re.subexpnames[namedGroupInfo[name]-1] = name
 	}
 
-	return namedGroupInfo
+	return namedGroupInfo, nil
 }
 
-func (re *Regexp) find(b []byte, n int, offset int) []int {
-	match := make([]int, re.numCaptures*2)
+// stringBytes returns the bytes of s without copying them. They must not be
+// modified.
+func stringBytes(s string) []byte {
+	return unsafe.Slice(unsafe.StringData(s), len(s))
+}
 
+// subjectPointer returns the pointer to the first of the n bytes of b to
+// search.
+func subjectPointer(b []byte, n int) unsafe.Pointer {
 	if n == 0 {
-		b = []byte{0}
+		return unsafe.Pointer(&emptySubject)
 	}
+	return unsafe.Pointer(&b[0])
+}
 
-	bytesPtr := unsafe.Pointer(&b[0])
-
-	// captures contains two pairs of ints, start and end, so we need list
-	// twice the size of the capture groups.
-	captures := make([]C.int, re.numCaptures*2)
-	capturesPtr := unsafe.Pointer(&captures[0])
-
-	var numCaptures int32
-	numCapturesPtr := unsafe.Pointer(&numCaptures)
+func (re *Regexp) find(b []byte, n int, offset int) ([]int, error) {
+	return re.search(subjectPointer(b, n), n, offset, nil)
+}
 
+// search searches the n bytes at str from offset. If it matches, the start and
+// end of the match and of each capture group are stored in dst, which is
+// grown if needed, and returned. Otherwise, nil is returned.
+func (re *Regexp) search(str unsafe.Pointer, n int, offset int, dst []int) ([]int, error) {
+	buf := re.capturesPool.Get().(*capturesBuffer)
+	defer re.capturesPool.Put(buf)
+	captures := buf.captures
 	pos := int(C.SearchOnigRegex(
-		bytesPtr, C.int(n), C.int(offset), C.int(ONIG_OPTION_DEFAULT),
-		re.regex, re.errorInfo, (*C.char)(nil), (*C.int)(capturesPtr), (*C.int)(numCapturesPtr),
+		str, C.int(n), C.int(offset), C.int(ONIG_OPTION_DEFAULT),
+		re.regex, re.errorInfo, (*C.char)(nil), (*C.int)(unsafe.Pointer(&captures[0])), &buf.numCaptures,
+		cULong(re.matchParams.RetryLimitInMatch), cULong(re.matchParams.RetryLimitInSearch), C.longlong(re.matchParams.TimeLimit),
 	))
 
+	if pos == ONIG_MISMATCH {
+		return nil, nil
+	}
 	if pos < 0 {
-		return nil
+		return nil, searchError(pos)
 	}
 
+	numCaptures := int32(buf.numCaptures)
 	if numCaptures <= 0 {
 		panic("cannot have 0 captures when processing a match")
 	}
@@ -200,11 +332,15 @@ func (re *Regexp) find(b []byte, n int, offset int) []int {
 		panic(fmt.Errorf("expected %d captures but got %d", re.numCaptures, numCaptures))
 	}
 
+	if cap(dst) < len(captures) {
+		dst = make([]int, len(captures))
+	}
+	dst = dst[:len(captures)]
 	for i := range captures {
-		match[i] = int(captures[i])
+		dst[i] = int(captures[i])
 	}
 
-	return match
+	return dst, nil
 }
 
 func getCapture(b []byte, beg int, end int) []byte {
@@ -215,21 +351,33 @@ func getCapture(b []byte, beg int, end int) []byte {
 	return b[beg:end]
 }
 
-func (re *Regexp) match(b []byte, n int, offset int) bool {
-	if n == 0 {
-		b = []byte{0}
+// getStringCapture returns the capture of s without copying it, or "" if the
+// group didn't participate in the match.
+func getStringCapture(s string, beg int, end int) string {
+	if beg < 0 || end < 0 {
+		return ""
 	}
 
-	bytesPtr := unsafe.Pointer(&b[0])
+	return s[beg:end]
+}
+
+func (re *Regexp) match(b []byte, n int, offset int) (bool, error) {
 	pos := int(C.SearchOnigRegex(
-		bytesPtr, C.int(n), C.int(offset), C.int(ONIG_OPTION_DEFAULT),
+		subjectPointer(b, n), C.int(n), C.int(offset), C.int(ONIG_OPTION_DEFAULT),
 		re.regex, re.errorInfo, nil, nil, nil,
+		cULong(re.matchParams.RetryLimitInMatch), cULong(re.matchParams.RetryLimitInSearch), C.longlong(re.matchParams.TimeLimit),
 	))
 
-	return pos >= 0
+	if pos == ONIG_MISMATCH {
+		return false, nil
+	}
+	if pos < 0 {
+		return false, searchError(pos)
+	}
+	return true, nil
 }
 
-func (re *Regexp) findAll(b []byte, n int) [][]int {
+func (re *Regexp) findAll(b []byte, n int) ([][]int, error) {
 	if n < 0 {
 		n = len(b)
 	}
@@ -237,7 +385,10 @@ func (re *Regexp) findAll(b []byte, n int) [][]int {
 	capture := make([][]int, 0, numMatchStartSize)
 	var offset int
 	for offset <= n {
-		match := re.find(b, n, offset)
+		match, err := re.find(b, n, offset)
+		if err != nil {
+			return nil, err
+		}
 		if match == nil {
 			break
 		}
@@ -252,9 +403,8 @@ func (re *Regexp) findAll(b []byte, n int) [][]int {
 		// the search. we need to exit the loop to avoid getting stuck here.
 		if match[0] == match[1] {
 			if offset < n && offset >= 0 {
-				//there are more bytes, so move offset by a word
-				_, width := utf8.DecodeRune(b[offset:])
-				offset += width
+				//there are more bytes, so move offset by a character
+				offset += re.charLength(b, n, offset)
 			} else {
 				//search is over, exit loop
 				break
@@ -262,11 +412,27 @@ func (re *Regexp) findAll(b []byte, n int) [][]int {
 		}
 	}
 
-	return capture
+	return capture, nil
 }
 
+// charLength returns the length of the character at offset in the n bytes of
+// b, in the encoding of re, so that a search doesn't restart in the middle of
+// a multibyte character. Truncated characters end at n.
+func (re *Regexp) charLength(b []byte, n int, offset int) int {
+	var width int
+	if re.enc == EncodingUTF8 {
+		_, width = utf8.DecodeRune(b[offset:n])
+	} else {
+		width = int(C.CharLengthOnigEncoding(re.encoding, unsafe.Pointer(&b[offset])))
+	}
+	return max(1, min(width, n-offset))
+}
+
+// FindIndex returns the start and end of the leftmost match of re in b, or nil
+// if there is none. A search which exceeds a limit set by SetMatchParams is
+// reported as no match; FindSubmatchIndexInto returns its error.
 func (re *Regexp) FindIndex(b []byte) []int {
-	match := re.find(b, len(b), 0)
+	match, _ := re.find(b, len(b), 0)
 	if len(match) == 0 {
 		return nil
 	}
@@ -274,6 +440,8 @@ func (re *Regexp) FindIndex(b []byte) []int {
 	return match[:2]
 }
 
+// Find returns the leftmost match of re in b, or nil if there is none. A
+// search which exceeds a limit set by SetMatchParams is reported as no match.
 func (re *Regexp) Find(b []byte) []byte {
 	loc := re.FindIndex(b)
 	if loc == nil {
@@ -283,21 +451,28 @@ func (re *Regexp) Find(b []byte) []byte {
 	return getCapture(b, loc[0], loc[1])
 }
 
+// FindString returns the leftmost match of re in s, or "" if there is none. A
+// search which exceeds a limit set by SetMatchParams is reported as no match.
 func (re *Regexp) FindString(s string) string {
-	mb := re.Find([]byte(s))
-	if mb == nil {
+	loc := re.FindStringIndex(s)
+	if loc == nil {
 		return ""
 	}
 
-	return string(mb)
+	return s[loc[0]:loc[1]]
 }
 
+// FindStringIndex is like FindIndex, for a string. A search which exceeds a
+// limit set by SetMatchParams is reported as no match.
 func (re *Regexp) FindStringIndex(s string) []int {
-	return re.FindIndex([]byte(s))
+	return re.FindIndex(stringBytes(s))
 }
 
+// FindAllIndex returns the start and end of at most n successive matches of re
+// in b, or of all of them if n is negative. A search which exceeds a limit set
+// by SetMatchParams is reported as no match.
 func (re *Regexp) FindAllIndex(b []byte, n int) [][]int {
-	matches := re.findAll(b, n)
+	matches, _ := re.findAll(b, n)
 	if len(matches) == 0 {
 		return nil
 	}
@@ -305,6 +480,9 @@ func (re *Regexp) FindAllIndex(b []byte, n int) [][]int {
 	return matches
 }
 
+// FindAll returns at most n successive matches of re in b, or all of them if n
+// is negative. A search which exceeds a limit set by SetMatchParams is
+// reported as no match.
 func (re *Regexp) FindAll(b []byte, n int) [][]byte {
 	matches := re.FindAllIndex(b, n)
 	if matches == nil {
@@ -319,33 +497,35 @@ func (re *Regexp) FindAll(b []byte, n int) [][]byte {
 	return matchBytes
 }
 
+// FindAllString is like FindAll, for a string. A search which exceeds a limit
+// set by SetMatchParams is reported as no match.
 func (re *Regexp) FindAllString(s string, n int) []string {
-	b := []byte(s)
-	matches := re.FindAllIndex(b, n)
+	matches := re.FindAllIndex(stringBytes(s), n)
 	if matches == nil {
 		return nil
 	}
 
 	matchStrings := make([]string, 0, len(matches))
 	for _, match := range matches {
-		m := getCapture(b, match[0], match[1])
-		if m == nil {
-			matchStrings = append(matchStrings, "")
-		} else {
-			matchStrings = append(matchStrings, string(m))
-		}
+		matchStrings = append(matchStrings, getStringCapture(s, match[0], match[1]))
 	}
 
 	return matchStrings
 
 }
 
+// FindAllStringIndex is like FindAllIndex, for a string. A search which
+// exceeds a limit set by SetMatchParams is reported as no match.
 func (re *Regexp) FindAllStringIndex(s string, n int) [][]int {
-	return re.FindAllIndex([]byte(s), n)
+	return re.FindAllIndex(stringBytes(s), n)
 }
 
+// FindSubmatchIndex returns the start and end of the leftmost match of re in b
+// and of its capture groups, or nil if there is none. A search which exceeds a
+// limit set by SetMatchParams is reported as no match; FindSubmatchIndexInto
+// returns its error.
 func (re *Regexp) FindSubmatchIndex(b []byte) []int {
-	match := re.find(b, len(b), 0)
+	match, _ := re.find(b, len(b), 0)
 	if len(match) == 0 {
 		return nil
 	}
@@ -353,6 +533,9 @@ func (re *Regexp) FindSubmatchIndex(b []byte) []int {
 	return match
 }
 
+// FindSubmatch returns the leftmost match of re in b and its capture groups,
+// or nil if there is none. A search which exceeds a limit set by
+// SetMatchParams is reported as no match.
 func (re *Regexp) FindSubmatch(b []byte) [][]byte {
 	match := re.FindSubmatchIndex(b)
 	if match == nil {
@@ -372,37 +555,58 @@ func (re *Regexp) FindSubmatch(b []byte) [][]byte {
 	return results
 }
 
+// FindStringSubmatch is like FindSubmatch, for a string. A search which
+// exceeds a limit set by SetMatchParams is reported as no match;
+// FindStringSubmatchErr returns its error.
 func (re *Regexp) FindStringSubmatch(s string) []string {
-	b := []byte(s)
-	match := re.FindSubmatchIndex(b)
-	if match == nil {
-		return nil
-	}
+	results, _ := re.FindStringSubmatchErr(s)
+	return results
+}
 
-	length := len(match) / 2
-	if length == 0 {
-		return nil
+// FindStringSubmatchErr is like FindStringSubmatch, but returns the error of
+// the search, e.g. when a limit set by SetMatchParams is exceeded.
+func (re *Regexp) FindStringSubmatchErr(s string) ([]string, error) {
+	match, err := re.FindStringSubmatchIndexInto(nil, s)
+	if err != nil || len(match) == 0 {
+		return nil, err
 	}
 
+	length := len(match) / 2
 	results := make([]string, 0, length)
 	for i := 0; i < length; i++ {
-		cap := getCapture(b, match[2*i], match[2*i+1])
-		if cap == nil {
-			results = append(results, "")
-		} else {
-			results = append(results, string(cap))
-		}
+		results = append(results, getStringCapture(s, match[2*i], match[2*i+1]))
 	}
 
-	return results
+	return results, nil
 }
 
+// FindStringSubmatchIndex is like FindSubmatchIndex, for a string. A search
+// which exceeds a limit set by SetMatchParams is reported as no match;
+// FindStringSubmatchIndexInto returns its error.
 func (re *Regexp) FindStringSubmatchIndex(s string) []int {
-	return re.FindSubmatchIndex([]byte(s))
+	return re.FindSubmatchIndex(stringBytes(s))
 }
 
+// FindSubmatchIndexInto is like FindSubmatchIndex, but stores the indexes in
+// dst, which is grown if it is too small, and returns the error of the
+// search. It returns nil if there is no match.
+func (re *Regexp) FindSubmatchIndexInto(dst []int, b []byte) ([]int, error) {
+	return re.search(subjectPointer(b, len(b)), len(b), 0, dst)
+}
+
+// FindStringSubmatchIndexInto is like FindStringSubmatchIndex, but stores the
+// indexes in dst, which is grown if it is too small, and returns the error of
+// the search. It returns nil if there is no match. Reusing dst across calls
+// avoids any allocation.
+func (re *Regexp) FindStringSubmatchIndexInto(dst []int, s string) ([]int, error) {
+	return re.FindSubmatchIndexInto(dst, stringBytes(s))
+}
+
+// FindAllSubmatchIndex is like FindAllIndex, with the start and end of the
+// capture groups of each match. A search which exceeds a limit set by
+// SetMatchParams is reported as no match.
 func (re *Regexp) FindAllSubmatchIndex(b []byte, n int) [][]int {
-	matches := re.findAll(b, n)
+	matches, _ := re.findAll(b, n)
 	if len(matches) == 0 {
 		return nil
 	}
@@ -410,8 +614,10 @@ func (re *Regexp) FindAllSubmatchIndex(b []byte, n int) [][]int {
 	return matches
 }
 
+// FindAllSubmatch is like FindAll, with the capture groups of each match. A
+// search which exceeds a limit set by SetMatchParams is reported as no match.
 func (re *Regexp) FindAllSubmatch(b []byte, n int) [][][]byte {
-	matches := re.findAll(b, n)
+	matches, _ := re.findAll(b, n)
 	if len(matches) == 0 {
 		return nil
 	}
@@ -430,10 +636,10 @@ func (re *Regexp) FindAllSubmatch(b []byte, n int) [][][]byte {
 	return allCapturedBytes
 }
 
+// FindAllStringSubmatch is like FindAllSubmatch, for a string. A search which
+// exceeds a limit set by SetMatchParams is reported as no match.
 func (re *Regexp) FindAllStringSubmatch(s string, n int) [][]string {
-	b := []byte(s)
-
-	matches := re.findAll(b, n)
+	matches, _ := re.findAll(stringBytes(s), n)
 	if len(matches) == 0 {
 		return nil
 	}
@@ -443,12 +649,7 @@ func (re *Regexp) FindAllStringSubmatch(s string, n int) [][]string {
 		length := len(match) / 2
 		capturedStrings := make([]string, 0, length)
 		for i := 0; i < length; i++ {
-			cap := getCapture(b, match[2*i], match[2*i+1])
-			if cap == nil {
-				capturedStrings = append(capturedStrings, "")
-			} else {
-				capturedStrings = append(capturedStrings, string(cap))
-			}
+			capturedStrings = append(capturedStrings, getStringCapture(s, match[2*i], match[2*i+1]))
 		}
 
 		allCapturedStrings = append(allCapturedStrings, capturedStrings)
@@ -457,16 +658,31 @@ func (re *Regexp) FindAllStringSubmatch(s string, n int) [][]string {
 	return allCapturedStrings
 }
 
+// FindAllStringSubmatchIndex is like FindAllSubmatchIndex, for a string. A
+// search which exceeds a limit set by SetMatchParams is reported as no match.
 func (re *Regexp) FindAllStringSubmatchIndex(s string, n int) [][]int {
-	return re.FindAllSubmatchIndex([]byte(s), n)
+	return re.FindAllSubmatchIndex(stringBytes(s), n)
 }
 
+// Match returns whether b contains a match of re. A search which exceeds a
+// limit set by SetMatchParams is reported as no match; MatchStringErr returns
+// its error.
 func (re *Regexp) Match(b []byte) bool {
-	return re.match(b, len(b), 0)
+	matched, _ := re.match(b, len(b), 0)
+	return matched
 }
 
+// MatchString returns whether s contains a match of re. A search which exceeds
+// a limit set by SetMatchParams is reported as no match; MatchStringErr
+// returns its error.
 func (re *Regexp) MatchString(s string) bool {
-	return re.Match([]byte(s))
+	return re.Match(stringBytes(s))
+}
+
+// MatchStringErr is like MatchString, but returns the error of the search,
+// e.g. when a limit set by SetMatchParams is exceeded.
+func (re *Regexp) MatchStringErr(s string) (bool, error) {
+	return re.match(stringBytes(s), len(s), 0)
 }
 
 func (re *Regexp) NumSubexp() int {
@@ -511,11 +727,14 @@ func fillCapturedValues(repl []byte, _ []byte, capturedBytes map[string][]byte)
 	return newRepl
 }
 
-func (re *Regexp) replaceAll(src, repl []byte, replFunc func([]byte, []byte, map[string][]byte) []byte) []byte {
+func (re *Regexp) replaceAll(src, repl []byte, replFunc func([]byte, []byte, map[string][]byte) []byte) ([]byte, error) {
 	srcLen := len(src)
-	matches := re.findAll(src, srcLen)
+	matches, err := re.findAll(src, srcLen)
+	if err != nil {
+		return src, err
+	}
 	if len(matches) == 0 {
-		return src
+		return src, nil
 	}
 
 	dest := make([]byte, 0, srcLen)
@@ -553,78 +772,98 @@ func (re *Regexp) replaceAll(src, repl []byte, replFunc func([]byte, []byte, map
 		dest = append(dest, src[lastEnd:]...)
 	}
 
-	return dest
+	return dest, nil
 }
 
+// ReplaceAll returns b with the matches of re replaced with repl, in which
+// \k<name> and \1 are substituted with the captures of the match. A search
+// which exceeds a limit set by SetMatchParams leaves src unchanged; GsubErr
+// returns its error.
 func (re *Regexp) ReplaceAll(src, repl []byte) []byte {
-	return re.replaceAll(src, repl, fillCapturedValues)
+	replaced, _ := re.replaceAll(src, repl, fillCapturedValues)
+	return replaced
 }
 
+// ReplaceAllFunc returns src with the matches of re replaced with the result
+// of repl. A search which exceeds a limit set by SetMatchParams leaves src
+// unchanged; GsubFuncErr returns its error.
 func (re *Regexp) ReplaceAllFunc(src []byte, repl func([]byte) []byte) []byte {
-	return re.replaceAll(src, nil, func(_ []byte, matchBytes []byte, _ map[string][]byte) []byte {
+	replaced, _ := re.replaceAll(src, nil, func(_ []byte, matchBytes []byte, _ map[string][]byte) []byte {
 		return repl(matchBytes)
 	})
+	return replaced
 }
 
+// ReplaceAllString is like ReplaceAll, for strings. A search which exceeds a
+// limit set by SetMatchParams leaves src unchanged; GsubErr returns its
+// error.
 func (re *Regexp) ReplaceAllString(src, repl string) string {
-	return string(re.ReplaceAll([]byte(src), []byte(repl)))
+	return string(re.ReplaceAll(stringBytes(src), stringBytes(repl)))
 }
 
+// ReplaceAllStringFunc is like ReplaceAllFunc, for strings. A search which
+// exceeds a limit set by SetMatchParams leaves src unchanged; GsubFuncErr
+// returns its error.
 func (re *Regexp) ReplaceAllStringFunc(src string, repl func(string) string) string {
-	return string(re.replaceAll([]byte(src), nil, func(_ []byte, matchBytes []byte, _ map[string][]byte) []byte {
+	replaced, _ := re.replaceAll(stringBytes(src), nil, func(_ []byte, matchBytes []byte, _ map[string][]byte) []byte {
 		return []byte(repl(string(matchBytes)))
-	}))
+	})
+	return string(replaced)
 }
 
 func (re *Regexp) String() string {
 	return re.pattern
 }
 
-func growBuffer(b []byte, offset int, n int) []byte {
-	if offset+n > cap(b) {
-		buf := make([]byte, 2*cap(b)+n)
-		copy(buf, b[:offset])
-		return buf
-	}
-
-	return b
-}
-
-func fromReader(r io.RuneReader) []byte {
-	b := make([]byte, numReadBufferStartSize)
-
-	var offset int
+// fromReader reads all the runes of r into a pooled buffer, which must be
+// released with releaseReadBuffer once the search is done. Oniguruma only
+// searches complete subjects, so the Reader functions don't stream: the whole
+// input is held in memory, and the pool only saves allocating the buffer.
+func fromReader(r io.RuneReader) *[]byte {
+	buf := readBufferPool.Get().(*[]byte)
+	b := (*buf)[:0]
 	for {
-		rune, runeWidth, err := r.ReadRune()
+		rune, _, err := r.ReadRune()
 		if err != nil {
 			break
 		}
-
-		b = growBuffer(b, offset, runeWidth)
-		writeWidth := utf8.EncodeRune(b[offset:], rune)
-		if runeWidth != writeWidth {
-			panic("reading rune width not equal to the written rune width")
-		}
-
-		offset += writeWidth
+		b = utf8.AppendRune(b, rune)
 	}
+	*buf = b
+	return buf
+}
 
-	return b[:offset]
+func releaseReadBuffer(buf *[]byte) {
+	if cap(*buf) <= maxPooledReadBufferSize {
+		readBufferPool.Put(buf)
+	}
 }
 
+// FindReaderIndex is like FindIndex, for the runes read from r. r is read to
+// the end and buffered before the search. A search which exceeds a limit set
+// by SetMatchParams is reported as no match.
 func (re *Regexp) FindReaderIndex(r io.RuneReader) []int {
 	b := fromReader(r)
-	return re.FindIndex(b)
+	defer releaseReadBuffer(b)
+	return re.FindIndex(*b)
 }
 
+// FindReaderSubmatchIndex is like FindSubmatchIndex, for the runes read from
+// r. r is read to the end and buffered before the search. A search which
+// exceeds a limit set by SetMatchParams is reported as no match.
 func (re *Regexp) FindReaderSubmatchIndex(r io.RuneReader) []int {
 	b := fromReader(r)
-	return re.FindSubmatchIndex(b)
+	defer releaseReadBuffer(b)
+	return re.FindSubmatchIndex(*b)
 }
 
+// MatchReader is like Match, for the runes read from r. r is read to the end
+// and buffered before the search. A search which exceeds a limit set by
+// SetMatchParams is reported as no match.
 func (re *Regexp) MatchReader(r io.RuneReader) bool {
 	b := fromReader(r)
-	return re.Match(b)
+	defer releaseReadBuffer(b)
+	return re.Match(*b)
 }
 
 func (re *Regexp) LiteralPrefix() (prefix string, complete bool) {
@@ -641,12 +880,33 @@ func MatchString(pattern string, s string) (matched bool, error error) {
 	return re.MatchString(s), nil
 }
 
+// Gsub is like ReplaceAllString. A search which exceeds a limit set by
+// SetMatchParams leaves src unchanged; GsubErr returns its error.
 func (re *Regexp) Gsub(src, repl string) string {
-	return string(re.replaceAll([]byte(src), []byte(repl), fillCapturedValues))
+	replaced, _ := re.GsubErr(src, repl)
+	return replaced
+}
+
+// GsubErr is like Gsub, but returns the error of the search, e.g. when a
+// limit set by SetMatchParams is exceeded, with src unchanged.
+func (re *Regexp) GsubErr(src, repl string) (string, error) {
+	replaced, err := re.replaceAll(stringBytes(src), stringBytes(repl), fillCapturedValues)
+	return string(replaced), err
 }
 
+// GsubFunc returns src with the matches of re replaced with the result of
+// replFunc, which is called with the match and its captures by name or
+// number. A search which exceeds a limit set by SetMatchParams leaves src
+// unchanged; GsubFuncErr returns its error.
 func (re *Regexp) GsubFunc(src string, replFunc func(string, map[string]string) string) string {
-	replaced := re.replaceAll([]byte(src), nil,
+	replaced, _ := re.GsubFuncErr(src, replFunc)
+	return replaced
+}
+
+// GsubFuncErr is like GsubFunc, but returns the error of the search, e.g.
+// when a limit set by SetMatchParams is exceeded, with src unchanged.
+func (re *Regexp) GsubFuncErr(src string, replFunc func(string, map[string]string) string) (string, error) {
+	replaced, err := re.replaceAll(stringBytes(src), nil,
 		func(_ []byte, matchBytes []byte, capturedBytes map[string][]byte) []byte {
 			capturedStrings := make(map[string]string)
 			for name, capBytes := range capturedBytes {
@@ -657,5 +917,16 @@ func (re *Regexp) GsubFunc(src string, replFunc func(string, map[string]string)
 		},
 	)
 
-	return string(replaced)
+	return string(replaced), err
+}
+
+// Expand returns repl with its \k<name> and \1 back-references substituted
+// with the given captures, in the same way as Gsub. It can be used with the
+// captures passed to the GsubFunc callback.
+func Expand(repl string, captures map[string]string) string {
+	capturedBytes := make(map[string][]byte, len(captures))
+	for name, capture := range captures {
+		capturedBytes[name] = []byte(capture)
+	}
+	return string(fillCapturedValues([]byte(repl), nil, capturedBytes))
 }
@@ -7,9 +7,12 @@ package rubex
 import (
 	"errors"
 	"fmt"
+	"reflect"
 	"runtime"
 	"strings"
 	"testing"
+	"time"
+	"weak"
 )
 
 var good_re = []string{
@@ -411,6 +414,114 @@ func TestGsubFunc2(t *testing.T) {
 	}
 }
 
+/*
+* "The lamb was sure to go.".gsub(/(?<word>[^\s\.]+)(?<white_space>\s)/) {
+*   "#{$~[:word]}y#{$~[:white_space]}"
+* }
+ */
+func TestGsubFuncExpand(t *testing.T) {
+	input := "The lamb was sure to go."
+	pattern := "(?<word>[^\\s\\.]+)(?<white_space>\\s)"
+	expected := "They lamby wasy surey toy go."
+	re, err := Compile(pattern)
+	if err != nil {
+		t.Errorf("Unexpected error compiling %q: %v", pattern, err)
+		return
+	}
+	actual := re.GsubFunc(input, func(_ string, captures map[string]string) string {
+		return Expand("\\k<word>y\\k<white_space>", captures)
+	})
+	if actual != expected {
+		t.Errorf("expected %q, actual %q\n", expected, actual)
+	}
+
+	re = MustCompile("h(.*)llo")
+	actual = re.GsubFunc("hallo", func(_ string, captures map[string]string) string {
+		return Expand("e\\1", captures)
+	})
+	if actual != "ea" {
+		t.Errorf("expected %q, actual %q\n", "ea", actual)
+	}
+}
+
+func TestMatchParams(t *testing.T) {
+	catastrophic := strings.Repeat("a", 30)
+
+	tests := []struct {
+		name     string
+		pattern  string
+		input    string
+		params   MatchParams
+		expected error
+	}{
+		{
+			name:     "retry limit in match",
+			pattern:  "(a+)+b",
+			input:    catastrophic,
+			params:   MatchParams{RetryLimitInMatch: 1000},
+			expected: ErrRetryLimitInMatchOver,
+		},
+		{
+			name:     "retry limit in search",
+			pattern:  "(a+)+b",
+			input:    catastrophic,
+			params:   MatchParams{RetryLimitInSearch: 1000},
+			expected: ErrRetryLimitInSearchOver,
+		},
+		{
+			name:     "time limit",
+			pattern:  "(a+)+b",
+			input:    catastrophic,
+			params:   MatchParams{TimeLimit: time.Millisecond},
+			expected: ErrTimeLimitOver,
+		},
+		{
+			name:     "retry limit in search before time limit",
+			pattern:  "(a+)+b",
+			input:    catastrophic,
+			params:   MatchParams{RetryLimitInSearch: 100000, TimeLimit: time.Minute},
+			expected: ErrRetryLimitInSearchOver,
+		},
+		{
+			name:    "within limits",
+			pattern: "(a+)+b",
+			input:   strings.Repeat("a", 10),
+			params:  MatchParams{RetryLimitInMatch: 100000, RetryLimitInSearch: 100000, TimeLimit: time.Minute},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			re := MustCompile(test.pattern)
+			re.SetMatchParams(test.params)
+
+			matched, err := re.MatchStringErr(test.input)
+			if !errors.Is(err, test.expected) {
+				t.Errorf("MatchStringErr: expected error %v, actual %v", test.expected, err)
+			}
+			if matched {
+				t.Errorf("MatchStringErr: unexpected match")
+			}
+			if re.MatchString(test.input) {
+				t.Errorf("MatchString: unexpected match")
+			}
+			if _, err := re.FindStringSubmatchErr(test.input); !errors.Is(err, test.expected) {
+				t.Errorf("FindStringSubmatchErr: expected error %v, actual %v", test.expected, err)
+			}
+			if _, err := re.GsubErr(test.input, "z"); !errors.Is(err, test.expected) {
+				t.Errorf("GsubErr: expected error %v, actual %v", test.expected, err)
+			}
+			if test.expected != nil {
+				if replaced := re.Gsub(test.input, "z"); replaced != test.input {
+					t.Errorf("Gsub: expected %q unchanged, actual %q", test.input, replaced)
+				}
+				if loc := re.FindStringIndex(test.input); loc != nil {
+					t.Errorf("FindStringIndex: unexpected match %v", loc)
+				}
+			}
+		})
+	}
+}
+
 /* how to match $ as itself */
 func TestPattern1(t *testing.T) {
 	re := MustCompile(`b\$a`)
@@ -931,6 +1042,76 @@ func TestFindReaderSubmatchIndex(t *testing.T) {
 	}
 }
 
+func TestFindSubmatchIndexInto(t *testing.T) {
+	for _, test := range findTests {
+		result, err := MustCompile(test.pat).FindSubmatchIndexInto(nil, []byte(test.text))
+		if err != nil {
+			t.Errorf("unexpected error %v: %s", err, test)
+		}
+		testFindSubmatchIndex(&test, result, t)
+	}
+}
+
+func TestFindStringSubmatchIndexInto(t *testing.T) {
+	var dst []int
+	for _, test := range findTests {
+		result, err := MustCompile(test.pat).FindStringSubmatchIndexInto(dst, test.text)
+		if err != nil {
+			t.Errorf("unexpected error %v: %s", err, test)
+		}
+		testFindSubmatchIndex(&test, result, t)
+		if result != nil {
+			dst = result
+		}
+	}
+}
+
+func TestFindStringSubmatchIndexIntoReusesDst(t *testing.T) {
+	re := MustCompile(`(a)(b)?`)
+	dst := make([]int, 0, 6)
+
+	result, err := re.FindStringSubmatchIndexInto(dst, "xab")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(result, []int{1, 3, 1, 2, 2, 3}) {
+		t.Errorf("unexpected indexes %v", result)
+	}
+	if &result[:cap(result)][0] != &dst[:cap(dst)][0] {
+		t.Error("expected dst to be reused")
+	}
+
+	result, err = re.FindStringSubmatchIndexInto(dst[:1], "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(result, []int{0, 1, 0, 1, -1, -1}) {
+		t.Errorf("unexpected indexes %v", result)
+	}
+
+	result, err = re.FindStringSubmatchIndexInto(dst, "")
+	if err != nil || result != nil {
+		t.Errorf("expected no match, got %v, %v", result, err)
+	}
+
+	result, err = re.FindStringSubmatchIndexInto(make([]int, 0, 2), "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(result, []int{0, 1, 0, 1, -1, -1}) {
+		t.Errorf("unexpected indexes when growing dst %v", result)
+	}
+}
+
+func TestFindStringSubmatchIndexIntoRetryLimit(t *testing.T) {
+	re := MustCompile(`(a+)+b`)
+	re.SetMatchParams(MatchParams{RetryLimitInSearch: 1000})
+	_, err := re.FindStringSubmatchIndexInto(nil, strings.Repeat("a", 30))
+	if !errors.Is(err, ErrRetryLimitInSearchOver) {
+		t.Errorf("expected %v, got %v", ErrRetryLimitInSearchOver, err)
+	}
+}
+
 // Now come the monster AllSubmatch cases.
 
 func TestFindAllSubmatch(t *testing.T) {
@@ -1121,3 +1302,103 @@ func BenchmarkAnchoredLongMatch(b *testing.B) {
 		re.Match(x)
 	}
 }
+
+// logLine and logPattern are representative of the records parsed by
+// ExtractPatternsRubyRegex.
+const (
+	logLine    = `127.0.0.1 - frank [10/Oct/2000:13:55:36 -0700] "GET /apache_pb.gif HTTP/1.0" 200 2326 "http://www.example.com/start.html" "Mozilla/4.08 [en] (Win98; I ;Nav)"`
+	logPattern = `^(?<host>[^ ]*) [^ ]* (?<user>[^ ]*) \[(?<time>[^\]]*)\] "(?<method>\S+)(?: +(?<path>[^ ]*) +\S*)?" (?<code>[^ ]*) (?<size>[^ ]*)(?: "(?<referer>[^\"]*)" "(?<agent>[^\"]*)")?$`
+)
+
+func BenchmarkLogMatchString(b *testing.B) {
+	re := MustCompile(logPattern)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if !re.MatchString(logLine) {
+			b.Fatal("no match")
+		}
+	}
+}
+
+func BenchmarkLogFindStringSubmatch(b *testing.B) {
+	re := MustCompile(logPattern)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if re.FindStringSubmatch(logLine) == nil {
+			b.Fatal("no match")
+		}
+	}
+}
+
+func BenchmarkLogFindStringSubmatchIndex(b *testing.B) {
+	re := MustCompile(logPattern)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if re.FindStringSubmatchIndex(logLine) == nil {
+			b.Fatal("no match")
+		}
+	}
+}
+
+func BenchmarkLogFindReaderSubmatchIndex(b *testing.B) {
+	re := MustCompile(logPattern)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if re.FindReaderSubmatchIndex(strings.NewReader(logLine)) == nil {
+			b.Fatal("no match")
+		}
+	}
+}
+
+func BenchmarkLogFindStringSubmatchIndexInto(b *testing.B) {
+	re := MustCompile(logPattern)
+	var dst []int
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var err error
+		dst, err = re.FindStringSubmatchIndexInto(dst[:0], logLine)
+		if err != nil || dst == nil {
+			b.Fatal("no match")
+		}
+	}
+}
+
+// BenchmarkLogFindSubmatchIndexCopy copies the line into a byte slice before
+// searching it, as the string functions used to.
+func BenchmarkLogFindSubmatchIndexCopy(b *testing.B) {
+	re := MustCompile(logPattern)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if re.FindSubmatchIndex([]byte(logLine)) == nil {
+			b.Fatal("no match")
+		}
+	}
+}
+
+func TestRegexpIsFinalized(t *testing.T) {
+	const count = 100
+	pointers := make([]weak.Pointer[Regexp], 0, count)
+	for i := 0; i < count; i++ {
+		re := MustCompile(fmt.Sprintf(`(a+)(b%d)`, i))
+		// Matching fills the captures pool, whose buffers must not keep re alive.
+		if !re.MatchString(fmt.Sprintf("aab%d", i)) {
+			t.Fatalf("regexp %d did not match", i)
+		}
+		re.FindStringSubmatch(fmt.Sprintf("aab%d", i))
+		pointers = append(pointers, weak.Make(re))
+	}
+
+	for i := 0; i < 5; i++ {
+		runtime.GC()
+	}
+
+	alive := 0
+	for _, p := range pointers {
+		if p.Value() != nil {
+			alive++
+		}
+	}
+	if alive != 0 {
+		t.Errorf("%d of %d regexps are still alive after GC", alive, count)
+	}
+}